@@ -20,6 +20,9 @@ func executeNewCommand(ctx context.Context, cmd command.Command, args []string)
 	// Add logging flags (same as existing system)
 	loggingOpts := cli.AddLoggingFlags(fs)
 
+	// Add ticket index flag
+	cacheOpts := cli.AddCacheFlags(fs)
+
 	// Parse flags
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -30,6 +33,8 @@ func executeNewCommand(ctx context.Context, cmd command.Command, args []string)
 		return err
 	}
 
+	cli.ConfigureCache(cacheOpts)
+
 	// Validate arguments
 	if err := cmd.Validate(cmdFlags, fs.Args()); err != nil {
 		return err
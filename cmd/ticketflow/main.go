@@ -112,6 +112,139 @@ func init() {
 		// This should never happen in practice but we handle it gracefully
 		fmt.Fprintf(os.Stderr, "Warning: failed to register workflow command: %v\n", err)
 	}
+
+	// Register tag command
+	if err := commandRegistry.Register(commands.NewTagCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register tag command: %v\n", err)
+	}
+
+	// Register link command
+	if err := commandRegistry.Register(commands.NewLinkCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register link command: %v\n", err)
+	}
+
+	// Register unlink command
+	if err := commandRegistry.Register(commands.NewUnlinkCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register unlink command: %v\n", err)
+	}
+
+	// Register next command
+	if err := commandRegistry.Register(commands.NewNextCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register next command: %v\n", err)
+	}
+
+	// Register move command
+	if err := commandRegistry.Register(commands.NewMoveCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register move command: %v\n", err)
+	}
+
+	// Register cancel command
+	if err := commandRegistry.Register(commands.NewCancelCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register cancel command: %v\n", err)
+	}
+
+	// Register reopen command
+	if err := commandRegistry.Register(commands.NewReopenCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register reopen command: %v\n", err)
+	}
+
+	// Register archive command
+	if err := commandRegistry.Register(commands.NewArchiveCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register archive command: %v\n", err)
+	}
+
+	// Register task command
+	if err := commandRegistry.Register(commands.NewTaskCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register task command: %v\n", err)
+	}
+
+	// Register log command
+	if err := commandRegistry.Register(commands.NewLogCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register log command: %v\n", err)
+	}
+
+	// Register doctor command
+	if err := commandRegistry.Register(commands.NewDoctorCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register doctor command: %v\n", err)
+	}
+
+	// Register search command
+	if err := commandRegistry.Register(commands.NewSearchCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register search command: %v\n", err)
+	}
+
+	// Register views command
+	if err := commandRegistry.Register(commands.NewViewsCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register views command: %v\n", err)
+	}
+
+	// Register tree command
+	if err := commandRegistry.Register(commands.NewTreeCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register tree command: %v\n", err)
+	}
+
+	// Register finish command
+	if err := commandRegistry.Register(commands.NewFinishCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register finish command: %v\n", err)
+	}
+
+	// Register pr-body command
+	if err := commandRegistry.Register(commands.NewPRBodyCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register pr-body command: %v\n", err)
+	}
+
+	// Register hooks command
+	if err := commandRegistry.Register(commands.NewHooksCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register hooks command: %v\n", err)
+	}
+
+	// Register which command
+	if err := commandRegistry.Register(commands.NewWhichCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register which command: %v\n", err)
+	}
+
+	// Register sync command
+	if err := commandRegistry.Register(commands.NewSyncCommand()); err != nil {
+		// Log error but continue - allow program to run with degraded functionality
+		// This should never happen in practice but we handle it gracefully
+		fmt.Fprintf(os.Stderr, "Warning: failed to register sync command: %v\n", err)
+	}
 }
 
 func main() {
@@ -167,7 +300,7 @@ func runTUI() {
 	g = git.NewWithTimeout(".", cfg.GetGitTimeout())
 
 	// Create ticket manager
-	manager := ticket.NewManager(cfg, root)
+	manager := cli.NewTicketManager(loadCtx, cfg, root)
 
 	// Create and run TUI
 	model := ui.New(cfg, manager, g, root)
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// ArchiveTickets moves done tickets closed before now minus olderThan into the
+// archive directory, grouped by closing year, and commits the moves.
+// Done tickets without a closed_at timestamp are skipped.
+func (app *App) ArchiveTickets(ctx context.Context, olderThan time.Duration, dryRun bool) (*ArchiveResult, error) {
+	logger := log.Global().WithOperation("archive_tickets")
+
+	tickets, err := app.Manager.List(ctx, ticket.StatusFilterDone)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	cutoff := time.Now().Add(-olderThan)
+	archivePath := app.Config.GetArchivePath(app.ProjectRoot)
+	result := &ArchiveResult{
+		OlderThan: olderThan,
+		Cutoff:    cutoff,
+		DryRun:    dryRun,
+	}
+
+	for i := range tickets {
+		t := &tickets[i]
+		if t.ClosedAt.Time == nil {
+			result.Skipped++
+			continue
+		}
+		if t.ClosedAt.Time.After(cutoff) {
+			continue
+		}
+		yearDir := filepath.Join(archivePath, t.ClosedAt.Time.Format("2006"))
+		result.Tickets = append(result.Tickets, ArchivedTicket{
+			Ticket: t,
+			From:   t.Path,
+			To:     filepath.Join(yearDir, filepath.Base(t.Path)),
+		})
+	}
+
+	if dryRun || len(result.Tickets) == 0 {
+		return result, nil
+	}
+
+	// Move every file first so a failure can be rolled back before anything is committed
+	for i, a := range result.Tickets {
+		if err := os.MkdirAll(filepath.Dir(a.To), 0755); err != nil {
+			app.rollbackArchive(result.Tickets[:i])
+			return nil, fmt.Errorf("failed to create archive directory: %w", err)
+		}
+		if err := os.Rename(a.From, a.To); err != nil {
+			app.rollbackArchive(result.Tickets[:i])
+			return nil, fmt.Errorf("failed to archive ticket %s: %w", a.Ticket.ID, err)
+		}
+		a.Ticket.Path = a.To
+	}
+
+	donePath := app.Config.GetDonePath(app.ProjectRoot)
+	if err := app.Git.Add(ctx, "-A", donePath, archivePath); err != nil {
+		app.rollbackArchive(result.Tickets)
+		app.unstageArchive(ctx, donePath, archivePath)
+		return nil, fmt.Errorf("failed to stage archived tickets: %w", err)
+	}
+	if err := app.Git.Commit(ctx, fmt.Sprintf("Archive %d done ticket(s)", len(result.Tickets))); err != nil {
+		app.rollbackArchive(result.Tickets)
+		app.unstageArchive(ctx, donePath, archivePath)
+		return nil, fmt.Errorf("failed to commit archived tickets: %w", err)
+	}
+
+	logger.Info("archived tickets", "count", len(result.Tickets), "skipped", result.Skipped)
+
+	return result, nil
+}
+
+// rollbackArchive moves already archived ticket files back to where they came from
+func (app *App) rollbackArchive(moved []ArchivedTicket) {
+	for _, a := range moved {
+		if err := os.Rename(a.To, a.From); err != nil {
+			log.Global().WithTicket(a.Ticket.ID).WithError(err).Warn("failed to roll back archived ticket")
+			continue
+		}
+		a.Ticket.Path = a.From
+	}
+}
+
+// unstageArchive resets the index for the ticket directories after a failed
+// archive, so the staged moves do not outlive the rolled back files
+func (app *App) unstageArchive(ctx context.Context, paths ...string) {
+	args := append([]string{git.SubcmdReset, git.FlagQuiet, "--"}, paths...)
+	if _, err := app.Git.Exec(ctx, args...); err != nil {
+		log.Global().WithError(err).Warn("failed to unstage archived tickets")
+	}
+}
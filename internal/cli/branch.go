@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// AssignTicketBranch records the branch a ticket being started is worked on,
+// rendered from git.branch_template. Tickets that already have a branch keep
+// it; tickets started before branches were recorded keep using their ID.
+func (app *App) AssignTicketBranch(ctx context.Context, t *ticket.Ticket) error {
+	if t.Branch != "" || t.Status() == ticket.StatusDoing {
+		return nil
+	}
+
+	name, err := app.Config.Git.BranchName(config.BranchNameData{ID: t.ID, Slug: t.Slug, User: config.CurrentUserName()})
+	if err != nil {
+		return NewError(ErrConfigInvalid, "Invalid branch template", err.Error(),
+			[]string{"Check git.branch_template in .ticketflow.yaml"})
+	}
+
+	owner, err := app.ticketForBranch(ctx, name)
+	if err != nil {
+		return err
+	}
+	if owner != nil && owner.ID != t.ID {
+		return NewError(ErrValidation, "Branch already in use",
+			fmt.Sprintf("Branch '%s' belongs to ticket %s", name, owner.ID),
+			[]string{"Include {{.ID}} in git.branch_template so that every ticket gets its own branch"})
+	}
+
+	t.Branch = name
+	return nil
+}
+
+// ticketForBranch returns the ticket worked on in branch, or nil when the
+// branch belongs to no ticket
+func (app *App) ticketForBranch(ctx context.Context, branch string) (*ticket.Ticket, error) {
+	if branch == "" || branch == app.Config.Git.DefaultBranch {
+		return nil, nil
+	}
+
+	// Branches named after the ticket ID need no scan
+	if _, _, err := ticket.ParseID(branch); err == nil {
+		if t, err := app.Manager.Get(ctx, branch); err == nil && t.BranchName() == branch {
+			return t, nil
+		}
+	}
+
+	tickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	return ticketsByBranch(tickets)[branch], nil
+}
+
+// ticketsByBranch indexes tickets by the branch they are worked on
+func ticketsByBranch(tickets []ticket.Ticket) map[string]*ticket.Ticket {
+	byBranch := make(map[string]*ticket.Ticket, len(tickets))
+	for i := range tickets {
+		byBranch[tickets[i].BranchName()] = &tickets[i]
+	}
+	return byBranch
+}
+
+// startBase is the ref a ticket's branch is created from
+type startBase struct {
+	ref     string
+	commit  string // Empty when ref is the current branch
+	current bool
+}
+
+// startPoint returns the ref to create the branch at, or "" to create it at
+// HEAD, after the start commit
+func (b startBase) startPoint() string {
+	if b.current {
+		return ""
+	}
+	return b.ref
+}
+
+// resolveStartBase picks the ref a ticket's branch is created from: base, or
+// git.start_base when base is empty. Refs other than the current branch
+// must exist.
+func (app *App) resolveStartBase(ctx context.Context, t *ticket.Ticket, base, currentBranch string) (startBase, error) {
+	if base == "" {
+		base = app.Config.Git.StartBase
+	}
+
+	ref := base
+	switch base {
+	case "", config.StartBaseCurrent:
+		ref = currentBranch
+	case config.StartBaseDefault:
+		ref = app.Config.Git.DefaultBranch
+	case config.StartBaseParent:
+		ref = app.Config.Git.DefaultBranch
+		if parentID := ExtractParentID(t); parentID != "" {
+			parent, err := app.Manager.Get(ctx, parentID)
+			if err != nil {
+				return startBase{}, ConvertError(err)
+			}
+			ref = parent.BranchName()
+		}
+	}
+
+	if ref == currentBranch {
+		return startBase{ref: ref, current: true}, nil
+	}
+
+	commit, err := app.Git.GetBranchCommit(ctx, ref)
+	if err != nil {
+		return startBase{}, NewError(ErrValidation, "Invalid start base",
+			fmt.Sprintf("Cannot create the branch of %s from '%s': %v", t.ID, ref, err),
+			[]string{
+				"Pass an existing branch or tag: ticketflow start --base origin/main <ticket-id>",
+				"Fetch remote branches first: git fetch",
+			})
+	}
+	return startBase{ref: ref, commit: commit}, nil
+}
+
+// TicketStartPoint returns the ref the branch of t is created from under
+// git.start_base, or "" to create it at HEAD
+func (app *App) TicketStartPoint(ctx context.Context, t *ticket.Ticket, currentBranch string) (string, error) {
+	base, err := app.resolveStartBase(ctx, t, "", currentBranch)
+	if err != nil {
+		return "", err
+	}
+	return base.startPoint(), nil
+}
+
+// CommitStartedTicketInWorktree commits the started ticket in a worktree
+// whose branch was created from a base without the start commit
+func (app *App) CommitStartedTicketInWorktree(ctx context.Context, worktreePath string, t *ticket.Ticket) error {
+	rel, err := filepath.Rel(app.ProjectRoot, t.Path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve ticket path: %w", err)
+	}
+	target := filepath.Join(worktreePath, rel)
+	if _, err := os.Stat(target); err == nil {
+		// The base already has the started ticket
+		return nil
+	}
+
+	data, err := t.ToBytes()
+	if err != nil {
+		return fmt.Errorf("failed to serialize ticket: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create doing directory in worktree: %w", err)
+	}
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		return fmt.Errorf("failed to write ticket to worktree: %w", err)
+	}
+
+	// Drop the copy of the ticket in its previous state
+	ticketsDir, err := filepath.Rel(app.ProjectRoot, app.Config.GetTicketsPath(app.ProjectRoot))
+	if err != nil {
+		return fmt.Errorf("failed to resolve tickets directory: %w", err)
+	}
+	copies, _ := filepath.Glob(filepath.Join(worktreePath, ticketsDir, "*", filepath.Base(t.Path)))
+	for _, path := range copies {
+		if path != target {
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("failed to remove old ticket file from worktree: %w", err)
+			}
+		}
+	}
+
+	if _, err := app.Git.RunInWorktree(ctx, worktreePath, git.SubcmdAdd, "-A", "--", ticketsDir); err != nil {
+		return fmt.Errorf("failed to stage ticket in worktree: %w", err)
+	}
+	if _, err := app.Git.RunInWorktree(ctx, worktreePath, git.SubcmdCommit, git.FlagMessage, fmt.Sprintf("Start ticket: %s", t.ID)); err != nil {
+		return fmt.Errorf("failed to commit ticket in worktree: %w", err)
+	}
+	return nil
+}
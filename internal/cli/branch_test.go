@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/mocks"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestResolveStartBase(t *testing.T) {
+	t.Parallel()
+	child := &ticket.Ticket{ID: "250101-120000-child", Related: []string{"parent:250101-110000-parent"}}
+	orphan := &ticket.Ticket{ID: "250101-120000-orphan"}
+	parent := &ticket.Ticket{ID: "250101-110000-parent", Branch: "feature/parent"}
+
+	tests := []struct {
+		name       string
+		ticket     *ticket.Ticket
+		base       string
+		startBase  string
+		setup      func(*mocks.MockGitClient, *mocks.MockTicketManager)
+		wantRef    string
+		wantPoint  string
+		wantCommit string
+		wantErr    string
+	}{
+		{name: "defaults to the current branch", ticket: orphan, wantRef: "work", wantPoint: ""},
+		{name: "current keyword", ticket: orphan, base: "current", startBase: "default", wantRef: "work", wantPoint: ""},
+		{
+			name: "default branch from config", ticket: orphan, startBase: "default",
+			setup: func(g *mocks.MockGitClient, _ *mocks.MockTicketManager) {
+				g.On("GetBranchCommit", mock.Anything, "main").Return("abc123", nil)
+			},
+			wantRef: "main", wantPoint: "main", wantCommit: "abc123",
+		},
+		{
+			name: "parent ticket branch", ticket: child, base: "parent",
+			setup: func(g *mocks.MockGitClient, m *mocks.MockTicketManager) {
+				m.On("Get", mock.Anything, parent.ID).Return(parent, nil)
+				g.On("GetBranchCommit", mock.Anything, "feature/parent").Return("def456", nil)
+			},
+			wantRef: "feature/parent", wantPoint: "feature/parent", wantCommit: "def456",
+		},
+		{
+			name: "parent falls back to the default branch", ticket: orphan, base: "parent",
+			setup: func(g *mocks.MockGitClient, _ *mocks.MockTicketManager) {
+				g.On("GetBranchCommit", mock.Anything, "main").Return("abc123", nil)
+			},
+			wantRef: "main", wantPoint: "main", wantCommit: "abc123",
+		},
+		{
+			name: "flag overrides config", ticket: orphan, base: "origin/main", startBase: "default",
+			setup: func(g *mocks.MockGitClient, _ *mocks.MockTicketManager) {
+				g.On("GetBranchCommit", mock.Anything, "origin/main").Return("fed789", nil)
+			},
+			wantRef: "origin/main", wantPoint: "origin/main", wantCommit: "fed789",
+		},
+		{
+			name: "unknown ref", ticket: orphan, base: "missing",
+			setup: func(g *mocks.MockGitClient, _ *mocks.MockTicketManager) {
+				g.On("GetBranchCommit", mock.Anything, "missing").Return("", errors.New("unknown revision"))
+			},
+			wantErr: "Invalid start base",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockGit := new(mocks.MockGitClient)
+			mockManager := new(mocks.MockTicketManager)
+			if tt.setup != nil {
+				tt.setup(mockGit, mockManager)
+			}
+
+			cfg := config.Default()
+			cfg.Git.DefaultBranch = "main"
+			cfg.Git.StartBase = tt.startBase
+			app := &App{Config: cfg, Git: mockGit, Manager: mockManager}
+
+			base, err := app.resolveStartBase(context.Background(), tt.ticket, tt.base, "work")
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRef, base.ref)
+			assert.Equal(t, tt.wantPoint, base.startPoint())
+			assert.Equal(t, tt.wantCommit, base.commit)
+			mockGit.AssertExpectations(t)
+			mockManager.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// CacheOptions holds command-line ticket index configuration
+type CacheOptions struct {
+	Disabled bool
+}
+
+var (
+	// indexCacheDisabled turns off the ticket index for this process
+	indexCacheDisabled bool
+	cacheMutex         sync.RWMutex
+)
+
+// AddCacheFlags adds the ticket index flag to a flag set
+func AddCacheFlags(fs *flag.FlagSet) *CacheOptions {
+	opts := &CacheOptions{}
+
+	fs.BoolVar(&opts.Disabled, "no-cache", false, "Read every ticket file instead of using the ticket index")
+
+	return opts
+}
+
+// ConfigureCache applies the command-line cache options
+func ConfigureCache(opts *CacheOptions) {
+	SetIndexCacheEnabled(!opts.Disabled)
+}
+
+// SetIndexCacheEnabled turns the ticket index on or off for this process
+func SetIndexCacheEnabled(enabled bool) {
+	cacheMutex.Lock()
+	defer cacheMutex.Unlock()
+	indexCacheDisabled = !enabled
+}
+
+// IndexCacheEnabled reports whether ticket managers should use the ticket index.
+// Setting TICKETFLOW_NO_CACHE=true disables it, including in the TUI.
+func IndexCacheEnabled() bool {
+	cacheMutex.RLock()
+	defer cacheMutex.RUnlock()
+	return !indexCacheDisabled && os.Getenv("TICKETFLOW_NO_CACHE") != "true"
+}
+
+// NewTicketManager creates a ticket manager for projectRoot. When the cache is
+// enabled, parsed tickets are kept in an index inside the worktree's git dir.
+func NewTicketManager(ctx context.Context, cfg *config.Config, projectRoot string) *ticket.Manager {
+	manager := ticket.NewManager(cfg, projectRoot)
+	if !IndexCacheEnabled() {
+		return manager
+	}
+
+	gitDir, err := git.FindGitDir(ctx, projectRoot)
+	if err != nil {
+		// The cache is optional; fall back to reading every ticket
+		log.Debug("Ticket index disabled", "error", err)
+		return manager
+	}
+	manager.SetIndex(ticket.NewIndex(filepath.Join(gitDir, IndexDir, IndexFile)))
+	return manager
+}
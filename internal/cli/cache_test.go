@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexCacheEnabled(t *testing.T) {
+	t.Cleanup(func() { SetIndexCacheEnabled(true) })
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	opts := AddCacheFlags(fs)
+	require.NoError(t, fs.Parse([]string{}))
+	ConfigureCache(opts)
+	assert.True(t, IndexCacheEnabled())
+
+	require.NoError(t, fs.Parse([]string{"--no-cache"}))
+	ConfigureCache(opts)
+	assert.False(t, IndexCacheEnabled())
+
+	SetIndexCacheEnabled(true)
+	t.Setenv("TICKETFLOW_NO_CACHE", "true")
+	assert.False(t, IndexCacheEnabled())
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+// CancelTicket cancels a todo or doing ticket and moves it to the cancelled directory.
+// Unlike closing, cancelling never requires being in the ticket's worktree, so tickets
+// that were never started can be cancelled too. Any worktree is left for cleanup.
+func (app *App) CancelTicket(ctx context.Context, ticketID, reason string) (*CancelTicketResult, error) {
+	logger := log.Global().WithOperation("cancel_ticket").WithTicket(ticketID)
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, NewError(ErrValidation, "Reason required",
+			"Cancelling a ticket requires a reason",
+			[]string{fmt.Sprintf("Provide a reason: ticketflow cancel %s --reason \"explanation\"", ticketID)})
+	}
+
+	t, err := app.validateTicketByID(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	originalStatus := t.State()
+
+	// Remember whether the ticket is the current one before its file moves
+	current, _ := app.Manager.GetCurrentTicket(ctx)
+	isCurrent := current != nil && current.ID == t.ID
+
+	var worktreePath string
+	if app.Config.Worktree.Enabled {
+		wt, err := app.Git.FindWorktreeByBranch(ctx, t.BranchName())
+		if err == nil && wt != nil {
+			worktreePath = wt.Path
+		}
+	}
+
+	if err := t.CloseWithReason(reason); err != nil {
+		return nil, fmt.Errorf("failed to cancel ticket: %w", err)
+	}
+
+	if err := app.relocateTicket(ctx, t, config.StateCancelled,
+		fmt.Sprintf("Cancel ticket: %s (%s)", t.ID, reason)); err != nil {
+		return nil, err
+	}
+
+	if isCurrent {
+		if err := app.Manager.SetCurrentTicket(ctx, nil); err != nil {
+			return nil, fmt.Errorf("failed to remove current ticket link: %w", err)
+		}
+	}
+
+	logger.Info("ticket cancelled", "reason", reason, "original_status", originalStatus)
+
+	return &CancelTicketResult{
+		Ticket:         t,
+		Reason:         reason,
+		OriginalStatus: originalStatus,
+		WorktreePath:   worktreePath,
+	}, nil
+}
@@ -98,10 +98,10 @@ func (app *App) cleanOrphanedWorktrees(ctx context.Context, dryRun bool) (int, e
 		return 0, fmt.Errorf("failed to list active tickets: %w", err)
 	}
 
-	// Create map of active ticket IDs
+	// Create map of the branches of active tickets
 	activeMap := make(map[string]bool)
 	for _, t := range activeTickets {
-		activeMap[t.ID] = true
+		activeMap[t.BranchName()] = true
 	}
 
 	cleaned := 0
@@ -156,10 +156,10 @@ func (app *App) cleanStaleBranches(ctx context.Context, dryRun bool) (int, error
 		return 0, fmt.Errorf("failed to list tickets: %w", err)
 	}
 
-	// Create map of ticket IDs and their status
+	// Create map of ticket branches and their status
 	ticketStatus := make(map[string]ticket.Status)
 	for _, t := range allTickets {
-		ticketStatus[t.ID] = t.Status()
+		ticketStatus[t.BranchName()] = t.Status()
 	}
 
 	cleaned := 0
@@ -215,7 +215,7 @@ func (app *App) CleanupStats(ctx context.Context) error {
 		if err == nil {
 			activeMap := make(map[string]bool)
 			for _, t := range activeTickets {
-				activeMap[t.ID] = true
+				activeMap[t.BranchName()] = true
 			}
 
 			orphaned := 0
@@ -236,7 +236,7 @@ func (app *App) CleanupStats(ctx context.Context) error {
 
 		ticketStatus := make(map[string]ticket.Status)
 		for _, t := range allTickets {
-			ticketStatus[t.ID] = t.Status()
+			ticketStatus[t.BranchName()] = t.Status()
 		}
 
 		stale := 0
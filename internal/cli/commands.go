@@ -9,6 +9,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/mattn/go-shellwords"
@@ -31,8 +32,15 @@ type StartTicketResult struct {
 	Ticket *ticket.Ticket
 	// WorktreePath is the filesystem path to the created worktree (empty if worktrees disabled)
 	WorktreePath string
-	// ParentBranch is the branch that was checked out from (typically "main")
+	// ParentBranch is the branch of the parent ticket the ticket was started from
+	// (empty when started from the default branch)
 	ParentBranch string
+	// ParentTicket is the ID of the ticket ParentBranch belongs to
+	ParentTicket string
+	// Base is the ref the ticket's branch was created from
+	Base string
+	// BaseCommit is the commit Base pointed to, when it was not the current branch
+	BaseCommit string
 	// InitCommandsExecuted indicates whether initialization commands were successfully run
 	InitCommandsExecuted bool
 	// OriginalStatus is the ticket's status before the start operation
@@ -144,7 +152,7 @@ func NewAppWithOptions(ctx context.Context, opts ...AppOption) (*App, error) {
 		app.Git = git.NewWithTimeout(projectRoot, app.Config.GetGitTimeout())
 	}
 	if app.Manager == nil {
-		app.Manager = ticket.NewManager(cfg, projectRoot)
+		app.Manager = NewTicketManager(ctx, cfg, projectRoot)
 	}
 	if app.Output == nil {
 		app.Output = NewOutputWriter(nil, nil, FormatText)
@@ -203,12 +211,13 @@ func InitCommandWithWorkingDir(ctx context.Context, workingDir string) error {
 
 	// Create directory structure
 	ticketsDir := filepath.Join(projectRoot, cfg.Tickets.Dir)
-	todoDir := filepath.Join(ticketsDir, cfg.Tickets.TodoDir)
-	doingDir := filepath.Join(ticketsDir, cfg.Tickets.DoingDir)
-	doneDir := filepath.Join(ticketsDir, cfg.Tickets.DoneDir)
+	dirs := []string{ticketsDir}
+	for _, state := range cfg.GetStates() {
+		dirs = append(dirs, filepath.Join(ticketsDir, state.Dir))
+	}
 
 	// Create all directories
-	for _, dir := range []string{ticketsDir, todoDir, doingDir, doneDir} {
+	for _, dir := range dirs {
 		if err := os.MkdirAll(dir, 0755); err != nil {
 			logger.WithError(err).Error("failed to create directory", "dir", dir)
 			return fmt.Errorf("failed to create directory %s: %w", dir, err)
@@ -258,39 +267,49 @@ func (app *App) warnIfParentDone(parentTicket *ticket.Ticket, parentID string) {
 
 // checkCircularDependency checks if adding newTicketID as a child of parentID would create a circular dependency
 func (app *App) checkCircularDependency(ctx context.Context, parentID, newTicketID string) error {
+	parentOf := func(id string) string {
+		currentTicket, err := app.Manager.Get(ctx, id)
+		if err != nil {
+			// If we can't get the ticket, assume no circular dependency
+			return ""
+		}
+		return app.extractParentTicketID(currentTicket)
+	}
+
 	// Check if the parent ticket has the new ticket as an ancestor
-	currentID := parentID
+	if hasAncestor(parentID, newTicketID, parentOf) {
+		return NewError(ErrTicketInvalid, "Circular dependency detected",
+			fmt.Sprintf("Creating this relationship would form a circular dependency: %s → %s", newTicketID, parentID),
+			[]string{
+				"Choose a different parent ticket",
+				"Check the ticket hierarchy with 'ticketflow tree'",
+			})
+	}
+
+	return nil
+}
+
+// hasAncestor reports whether ancestorID is id itself or is reached by
+// following parent links up from id. parentOf returns "" for a ticket without
+// a (known) parent.
+func hasAncestor(id, ancestorID string, parentOf func(id string) string) bool {
+	currentID := id
 	visited := make(map[string]bool)
 
 	for currentID != "" {
 		// Prevent infinite loops in case of existing circular dependencies
 		if visited[currentID] {
-			break
+			return false
 		}
 		visited[currentID] = true
 
-		// Check if we've reached the new ticket ID
-		if currentID == newTicketID {
-			return NewError(ErrTicketInvalid, "Circular dependency detected",
-				fmt.Sprintf("Creating this relationship would form a circular dependency: %s → %s", newTicketID, parentID),
-				[]string{
-					"Choose a different parent ticket",
-					"Check the ticket hierarchy with 'ticketflow show'",
-				})
+		if currentID == ancestorID {
+			return true
 		}
-
-		// Get the current ticket to check its parent
-		currentTicket, err := app.Manager.Get(ctx, currentID)
-		if err != nil {
-			// If we can't get the ticket, assume no circular dependency
-			break
-		}
-
-		// Extract parent from related field
-		currentID = app.extractParentTicketID(currentTicket)
+		currentID = parentOf(currentID)
 	}
 
-	return nil
+	return false
 }
 
 // validateExplicitParent validates an explicitly provided parent ticket
@@ -342,11 +361,11 @@ func (app *App) detectImplicitParent(ctx context.Context) (string, error) {
 
 	if currentBranch != app.Config.Git.DefaultBranch {
 		// Check if current branch is a ticket
-		if parentTicket, err := app.Manager.Get(ctx, currentBranch); err == nil {
+		if parentTicket, err := app.ticketForBranch(ctx, currentBranch); err == nil && parentTicket != nil {
 			app.Output.Printf("Creating ticket in branch: %s\n", currentBranch)
 			// Warn if parent ticket is done (but still allow it)
-			app.warnIfParentDone(parentTicket, currentBranch)
-			return currentBranch, nil
+			app.warnIfParentDone(parentTicket, parentTicket.ID)
+			return parentTicket.ID, nil
 		}
 	}
 
@@ -364,13 +383,35 @@ func (app *App) resolveParentTicket(ctx context.Context, explicitParent, slug st
 // outputTicketCreated outputs the result of ticket creation
 // NewTicket creates a new ticket
 func (app *App) NewTicket(ctx context.Context, slug string, explicitParent string) (*ticket.Ticket, error) {
+	return app.NewTicketWithOptions(ctx, slug, NewTicketOptions{Parent: explicitParent})
+}
+
+// NewTicketOptions holds optional settings for creating a ticket
+type NewTicketOptions struct {
+	// Parent is the explicit parent ticket ID (empty to auto-detect)
+	Parent string
+	// Tags are attached to the new ticket
+	Tags []string
+	// Template is the name of the ticket template to use (empty for the default)
+	Template string
+}
+
+// NewTicketWithOptions creates a new ticket with the given options
+func (app *App) NewTicketWithOptions(ctx context.Context, slug string, opts NewTicketOptions) (*ticket.Ticket, error) {
 	logger := log.Global().WithOperation("new_ticket")
+	explicitParent := opts.Parent
 
 	// Validate slug
 	if err := app.validateSlug(slug); err != nil {
 		return nil, err
 	}
 
+	// Validate tags before creating anything
+	tags, err := normalizeTags(opts.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("creating new ticket", slog.String("slug", slug), slog.String("explicit_parent", explicitParent))
 
 	// Resolve parent ticket
@@ -379,6 +420,18 @@ func (app *App) NewTicket(ctx context.Context, slug string, explicitParent strin
 		return nil, err
 	}
 
+	// Resolve the template and render it once up front so that a broken
+	// template is reported before the ticket file is written
+	tmpl, err := app.resolveTemplate(opts.Template)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl != nil {
+		if _, err := tmpl.Render(app.templateData(ctx, ticket.GenerateID(slug), slug, parentTicketID)); err != nil {
+			return nil, NewError(ErrValidation, "Invalid ticket template", err.Error(), nil)
+		}
+	}
+
 	// Create ticket
 	t, err := app.Manager.Create(ctx, slug)
 	if err != nil {
@@ -387,16 +440,34 @@ func (app *App) NewTicket(ctx context.Context, slug string, explicitParent strin
 	}
 	logger.Info("created ticket", "ticket_id", t.ID, "path", t.Path)
 
-	// If this is a sub-ticket, update its metadata
-	if parentTicketID != "" {
-		logger.Debug("creating sub-ticket", "parent", parentTicketID)
-		// Add parent relationship
-		t.Related = append(t.Related, fmt.Sprintf("parent:%s", parentTicketID))
+	if tmpl != nil {
+		rendered, err := tmpl.Render(app.templateData(ctx, t.ID, slug, parentTicketID))
+		if err != nil {
+			return nil, NewError(ErrValidation, "Invalid ticket template", err.Error(), nil)
+		}
+		t.Content = rendered.Content
+		t.Description = rendered.Description
+		if rendered.Priority != 0 {
+			t.Priority = rendered.Priority
+		}
+		logger.Debug("applied ticket template", "ticket_id", t.ID, "template", tmpl.Name)
+	}
+
+	// If this is a sub-ticket, has tags or uses a template, update its metadata
+	if parentTicketID != "" || len(tags) > 0 || tmpl != nil {
+		if parentTicketID != "" {
+			logger.Debug("creating sub-ticket", "parent", parentTicketID)
+			// Add parent relationship
+			t.Related = append(t.Related, fmt.Sprintf("parent:%s", parentTicketID))
+		}
+		t.Tags = tags
 		if err := app.Manager.Update(ctx, t); err != nil {
 			logger.WithError(err).Error("failed to update ticket metadata", slog.String("ticket_id", t.ID), slog.String("parent", parentTicketID))
 			return nil, fmt.Errorf("failed to update ticket metadata: %w", err)
 		}
-		logger.Info("created sub-ticket", "ticket_id", t.ID, "parent", parentTicketID)
+		if parentTicketID != "" {
+			logger.Info("created sub-ticket", "ticket_id", t.ID, "parent", parentTicketID)
+		}
 	}
 
 	// Return ticket (output is handled by command layer)
@@ -405,21 +476,77 @@ func (app *App) NewTicket(ctx context.Context, slug string, explicitParent strin
 
 // ListTickets lists tickets
 func (app *App) ListTickets(ctx context.Context, status ticket.Status, count int, format OutputFormat) error {
-	// Convert Status to StatusFilter
-	var statusFilter ticket.StatusFilter
+	return app.ListTicketsWithOptions(ctx, ListOptions{Status: status, Count: count})
+}
+
+// ListOptions holds the filters for listing tickets
+type ListOptions struct {
+	// Status filters by ticket status (empty for active tickets)
+	Status ticket.Status
+	// Count limits the number of tickets shown (0 for no limit)
+	Count int
+	// Tags only includes tickets that have all of the given tags
+	Tags []string
+	// IncludeArchived also lists archived tickets when the status filter covers done tickets
+	IncludeArchived bool
+	// Filter is a filter expression (see ticket.ParseQuery)
+	Filter string
+	// Sort is a comma-separated list of sort fields, "-" prefixed for descending order
+	Sort string
+	// Offset skips the first tickets after filtering and sorting
+	Offset int
+	// View names a saved view from the configuration (see applyView)
+	View string
+	// Tree shows the tickets as a parent/sub-ticket tree; Offset and Count
+	// then apply to the top-level tickets
+	Tree bool
+}
+
+// statusFilter converts a --status value to a status filter.
+// Any configured workflow state is accepted; empty means active tickets.
+func (app *App) statusFilter(status ticket.Status) (ticket.StatusFilter, error) {
 	switch status {
 	case "":
-		statusFilter = ticket.StatusFilterActive
-	case ticket.StatusTodo:
-		statusFilter = ticket.StatusFilterTodo
-	case ticket.StatusDoing:
-		statusFilter = ticket.StatusFilterDoing
-	case ticket.StatusDone:
-		statusFilter = ticket.StatusFilterDone
+		return ticket.StatusFilterActive, nil
 	case StatusAll:
-		statusFilter = ticket.StatusFilterAll
-	default:
-		return fmt.Errorf("invalid status filter: %s", status)
+		return ticket.StatusFilterAll, nil
+	}
+	if _, ok := app.Config.GetState(string(status)); !ok {
+		return "", NewError(ErrValidation, "Invalid status filter",
+			fmt.Sprintf("Status '%s' is not a configured workflow state", status),
+			[]string{fmt.Sprintf("Use one of: %s, all", strings.Join(app.Config.GetStateNames(), ", "))})
+	}
+	return ticket.StatusFilter(status), nil
+}
+
+// ListTicketsWithOptions lists tickets matching the given options
+func (app *App) ListTicketsWithOptions(ctx context.Context, opts ListOptions) error {
+	if opts.View != "" {
+		var err error
+		if opts, err = app.applyView(opts); err != nil {
+			return err
+		}
+	}
+
+	status := opts.Status
+	count := opts.Count
+
+	query, err := ticket.ParseQuery(opts.Filter)
+	if err != nil {
+		return NewError(ErrValidation, "Invalid filter expression", err.Error(), []string{
+			"Combine field terms with and/or/not, e.g. 'priority<=1 and slug~auth and created>2025-01-01'",
+			"Fields: id, slug, description, status, priority, created, started, closed, tag, content, reason, has, parent and other relation types",
+		})
+	}
+	sortKeys, err := ticket.ParseSortKeys(opts.Sort)
+	if err != nil {
+		return NewError(ErrValidation, "Invalid sort order", err.Error(),
+			[]string{"Separate fields with commas and prefix with '-' for descending order, e.g. 'priority,-created'"})
+	}
+
+	statusFilter, err := app.statusFilter(status)
+	if err != nil {
+		return err
 	}
 
 	tickets, err := app.Manager.List(ctx, statusFilter)
@@ -427,28 +554,65 @@ func (app *App) ListTickets(ctx context.Context, status ticket.Status, count int
 		return err
 	}
 
-	// Limit count
-	if count > 0 && len(tickets) > count {
-		tickets = tickets[:count]
+	// Archived tickets are done tickets, so only add them when done tickets are listed
+	if opts.IncludeArchived && (statusFilter == ticket.StatusFilterAll || statusFilter == ticket.StatusFilterDone) {
+		archived, err := app.Manager.List(ctx, ticket.StatusFilterArchived)
+		if err != nil {
+			return err
+		}
+		tickets = append(tickets, archived...)
+		sort.SliceStable(tickets, func(i, j int) bool {
+			if tickets[i].Priority != tickets[j].Priority {
+				return tickets[i].Priority < tickets[j].Priority
+			}
+			return tickets[i].CreatedAt.After(tickets[j].CreatedAt.Time)
+		})
 	}
 
+	// Filter by tags
+	tags, err := normalizeTags(opts.Tags)
+	if err != nil {
+		return err
+	}
+	if len(tags) > 0 {
+		tickets = filterTicketsByTags(tickets, tags)
+	}
+
+	tickets = query.Filter(tickets)
+	ticket.SortTickets(tickets, sortKeys)
+
 	// Calculate summary counts
 	allTickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
 	if err != nil {
 		return err
 	}
 
-	todoCount, doingCount, doneCount := app.countTicketsByStatus(allTickets)
+	if opts.Tree {
+		roots, _ := buildTicketTree(tickets)
+		roots = roots[min(opts.Offset, len(roots)):]
+		if count > 0 && len(roots) > count {
+			roots = roots[:count]
+		}
+		return app.Output.PrintResult(&TicketTreeResult{
+			Roots: roots,
+			Count: app.countTicketsByState(allTickets),
+		})
+	}
+
+	// Skip offset
+	if opts.Offset > 0 {
+		tickets = tickets[min(opts.Offset, len(tickets)):]
+	}
+
+	// Limit count
+	if count > 0 && len(tickets) > count {
+		tickets = tickets[:count]
+	}
 
 	// Create TicketListResult
 	result := &TicketListResult{
 		Tickets: tickets,
-		Count: map[string]int{
-			"total": len(allTickets),
-			"todo":  todoCount,
-			"doing": doingCount,
-			"done":  doneCount,
-		},
+		Count:   app.countTicketsByState(allTickets),
 	}
 
 	return app.Output.PrintResult(result)
@@ -456,6 +620,21 @@ func (app *App) ListTickets(ctx context.Context, status ticket.Status, count int
 
 // StartTicket starts working on a ticket
 func (app *App) StartTicket(ctx context.Context, ticketID string, force bool) (*StartTicketResult, error) {
+	return app.StartTicketWithOptions(ctx, ticketID, StartTicketOptions{Force: force})
+}
+
+// StartTicketOptions holds optional settings for starting a ticket
+type StartTicketOptions struct {
+	// Force recreates the ticket's worktree if it already exists
+	Force bool
+	// Base is where the ticket's branch is created from: default, current,
+	// parent or a ref (empty for git.start_base)
+	Base string
+}
+
+// StartTicketWithOptions starts working on a ticket with the given options
+func (app *App) StartTicketWithOptions(ctx context.Context, ticketID string, opts StartTicketOptions) (*StartTicketResult, error) {
+	force := opts.Force
 	logger := log.Global().WithOperation("start_ticket").WithTicket(ticketID)
 	logger.Info("starting ticket")
 
@@ -479,14 +658,25 @@ func (app *App) StartTicket(ctx context.Context, ticketID string, force bool) (*
 		return nil, err
 	}
 
+	// Name the ticket's branch
+	if err := app.AssignTicketBranch(ctx, t); err != nil {
+		return nil, err
+	}
+
 	// Get current branch and detect parent
-	currentBranch, parentBranch, err := app.detectParentBranch(ctx)
+	currentBranch, parentBranch, parentID, err := app.detectParentBranch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Choose where the ticket's branch starts
+	base, err := app.resolveStartBase(ctx, t, opts.Base, currentBranch)
 	if err != nil {
 		return nil, err
 	}
 
 	// Setup branch for the ticket
-	if err := app.setupTicketBranch(ctx, t, currentBranch); err != nil {
+	if err := app.setupTicketBranch(ctx, t, base.startPoint()); err != nil {
 		return nil, err
 	}
 
@@ -501,14 +691,15 @@ func (app *App) StartTicket(ctx context.Context, ticketID string, force bool) (*
 	}
 
 	// Move ticket to doing status (skip if already in doing and using force)
-	if t.Status() != ticket.StatusDoing {
+	startCommitted := t.Status() != ticket.StatusDoing
+	if startCommitted {
 		if err := app.moveTicketToDoing(ctx, t, currentBranch); err != nil {
 			return nil, err
 		}
 	}
 
 	// Now create worktree AFTER committing (for worktree mode)
-	worktreePath, err := app.createAndSetupWorktree(ctx, t)
+	worktreePath, err := app.createAndSetupWorktree(ctx, t, base.startPoint(), startCommitted)
 	if err != nil {
 		return nil, err
 	}
@@ -520,6 +711,9 @@ func (app *App) StartTicket(ctx context.Context, ticketID string, force bool) (*
 		Ticket:               t,
 		WorktreePath:         worktreePath,
 		ParentBranch:         parentBranch,
+		ParentTicket:         parentID,
+		Base:                 base.ref,
+		BaseCommit:           base.commit,
 		InitCommandsExecuted: initCommandsExecuted,
 		OriginalStatus:       originalStatus,
 		IsRecreatingWorktree: isRecreatingWorktree,
@@ -596,11 +790,11 @@ func (app *App) CloseTicketWithReason(ctx context.Context, reason string, force
 }
 
 // checkBranchMerged checks if a branch has been merged to the default branch
-func (app *App) checkBranchMerged(ctx context.Context, ticketID string) (bool, error) {
+func (app *App) checkBranchMerged(ctx context.Context, branch string) (bool, error) {
 	if app.Config.Git.DefaultBranch == "" {
 		return false, nil
 	}
-	return app.Git.IsBranchMerged(ctx, ticketID, app.Config.Git.DefaultBranch)
+	return app.Git.IsBranchMerged(ctx, branch, app.Config.Git.DefaultBranch)
 }
 
 // validateTicketByID validates that a ticket can be closed by ID
@@ -697,7 +891,7 @@ func (app *App) CloseTicketByID(ctx context.Context, ticketID, reason string, fo
 
 	// Check if branch is merged
 	branchMerged := false
-	merged, err := app.checkBranchMerged(ctx, ticketID)
+	merged, err := app.checkBranchMerged(ctx, ticket.BranchName())
 	if err != nil {
 		logger.WithError(err).Warn("failed to check if branch is merged, assuming not merged")
 	} else {
@@ -717,7 +911,7 @@ func (app *App) CloseTicketByID(ctx context.Context, ticketID, reason string, fo
 	// Check for worktree
 	var worktreePath string
 	if app.Config.Worktree.Enabled {
-		wt, err := app.Git.FindWorktreeByBranch(ctx, ticketID)
+		wt, err := app.Git.FindWorktreeByBranch(ctx, ticket.BranchName())
 		if err == nil && wt != nil {
 			worktreePath = wt.Path
 		}
@@ -743,9 +937,9 @@ func (app *App) RestoreCurrentTicket(ctx context.Context) (*ticket.Ticket, error
 		return nil, fmt.Errorf("failed to get current branch: %w", err)
 	}
 
-	// Try to get ticket by branch name
-	t, err := app.Manager.Get(ctx, branch)
-	if err != nil {
+	// Find the ticket worked on in this branch
+	t, err := app.ticketForBranch(ctx, branch)
+	if err != nil || t == nil {
 		return nil, ConvertError(fmt.Errorf("no ticket found for branch %s", branch))
 	}
 
@@ -778,12 +972,10 @@ func (app *App) Status(ctx context.Context, format OutputFormat) error {
 		return err
 	}
 
-	todoCount, doingCount, doneCount := app.countTicketsByStatus(allTickets)
-
 	// Get worktree path if applicable
 	var worktreePath string
 	if current != nil && app.Config.Worktree.Enabled {
-		wt, _ := app.Git.FindWorktreeByBranch(ctx, current.ID)
+		wt, _ := app.Git.FindWorktreeByBranch(ctx, current.BranchName())
 		if wt != nil {
 			worktreePath = wt.Path
 		}
@@ -794,13 +986,9 @@ func (app *App) Status(ctx context.Context, format OutputFormat) error {
 		CurrentBranch: branch,
 		CurrentTicket: current,
 		WorktreePath:  worktreePath,
-		Summary: map[string]int{
-			"total": len(allTickets),
-			"todo":  todoCount,
-			"doing": doingCount,
-			"done":  doneCount,
-		},
-		TotalTickets: len(allTickets),
+		Summary:       app.countTicketsByState(allTickets),
+		States:        app.Config.GetStateNames(),
+		TotalTickets:  len(allTickets),
 	}
 
 	return app.Output.PrintResult(result)
@@ -870,10 +1058,10 @@ func (app *App) CleanWorktrees(ctx context.Context) (*CleanWorktreesResult, erro
 
 	result.ActiveTickets = len(activeTickets)
 
-	// Create a map of active ticket IDs
+	// Create a map of the branches of active tickets
 	activeMap := make(map[string]bool)
 	for _, t := range activeTickets {
-		activeMap[t.ID] = true
+		activeMap[t.BranchName()] = true
 	}
 
 	// Count total worktrees (excluding main)
@@ -945,7 +1133,7 @@ func (app *App) CleanupTicket(ctx context.Context, ticketID string, force bool)
 	}
 
 	// Check if worktree exists
-	wt, err := app.Git.FindWorktreeByBranch(ctx, t.ID)
+	wt, err := app.Git.FindWorktreeByBranch(ctx, t.BranchName())
 	if err != nil {
 		return nil, fmt.Errorf("failed to find worktree: %w", err)
 	}
@@ -957,7 +1145,7 @@ func (app *App) CleanupTicket(ctx context.Context, ticketID string, force bool)
 	if wt != nil {
 		app.Output.Printf("  • Remove worktree: %s\n", wt.Path)
 	}
-	app.Output.Printf("  • Delete local branch: %s\n", t.ID)
+	app.Output.Printf("  • Delete local branch: %s\n", t.BranchName())
 
 	// Confirmation prompt if not forced
 	if !force {
@@ -983,10 +1171,10 @@ func (app *App) CleanupTicket(ctx context.Context, ticketID string, force bool)
 	}
 
 	// Delete local branch
-	app.Output.Printf("🌿 Deleting local branch: %s\n", t.ID)
-	if _, err := app.Git.Exec(ctx, "branch", "-D", t.ID); err != nil {
+	app.Output.Printf("🌿 Deleting local branch: %s\n", t.BranchName())
+	if _, err := app.Git.Exec(ctx, "branch", "-D", t.BranchName()); err != nil {
 		// Branch might not exist locally, which is fine
-		app.Output.Printf("⚠️  Note: Local branch %s not found or already deleted\n", t.ID)
+		app.Output.Printf("⚠️  Note: Local branch %s not found or already deleted\n", t.BranchName())
 	}
 
 	app.Output.Printf("\n✅ Cleanup completed successfully!\n")
@@ -1020,7 +1208,7 @@ func (app *App) validateTicketForStart(ctx context.Context, ticketID string, for
 			}
 		} else {
 			suggestions = []string{
-				fmt.Sprintf("Switch to the existing branch: git checkout %s", t.ID),
+				fmt.Sprintf("Switch to the existing branch: git checkout %s", t.BranchName()),
 				"Use 'ticketflow status' to see current ticket",
 			}
 		}
@@ -1053,21 +1241,23 @@ func (app *App) checkWorkspaceForStart(ctx context.Context) error {
 	return nil
 }
 
-// detectParentBranch detects if we're starting from a parent ticket branch
-func (app *App) detectParentBranch(ctx context.Context) (currentBranch string, parentBranch string, err error) {
+// detectParentBranch detects if we're starting from a parent ticket branch,
+// returning the branch and the ID of its ticket
+func (app *App) detectParentBranch(ctx context.Context) (currentBranch, parentBranch, parentID string, err error) {
 	currentBranch, err = app.Git.CurrentBranch(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get current branch: %w", err)
+		return "", "", "", fmt.Errorf("failed to get current branch: %w", err)
 	}
 
 	// Check if we're starting from a worktree (for sub-tickets)
 	if currentBranch != app.Config.Git.DefaultBranch {
 		// Verify this is a valid ticket branch
-		if _, err := app.Manager.Get(ctx, currentBranch); err == nil {
+		if parent, err := app.ticketForBranch(ctx, currentBranch); err == nil && parent != nil {
 			// This is a sub-ticket being created from a parent ticket
 			parentBranch = currentBranch
+			parentID = parent.ID
 		} else {
-			return "", "", NewError(ErrTicketInvalid, "Invalid branch for starting ticket",
+			return "", "", "", NewError(ErrTicketInvalid, "Invalid branch for starting ticket",
 				fmt.Sprintf("Currently on branch '%s', which is not a ticket branch", currentBranch),
 				[]string{
 					fmt.Sprintf("Switch to default branch: git checkout %s", app.Config.Git.DefaultBranch),
@@ -1076,15 +1266,22 @@ func (app *App) detectParentBranch(ctx context.Context) (currentBranch string, p
 		}
 	}
 
-	return currentBranch, parentBranch, nil
+	return currentBranch, parentBranch, parentID, nil
 }
 
-// setupTicketBranch creates and sets up the branch for the ticket
-func (app *App) setupTicketBranch(ctx context.Context, t *ticket.Ticket, currentBranch string) error {
+// setupTicketBranch creates and sets up the branch for the ticket, at
+// startPoint or at HEAD when startPoint is empty
+func (app *App) setupTicketBranch(ctx context.Context, t *ticket.Ticket, startPoint string) error {
 	// For non-worktree mode, create and checkout branch immediately
 	if !app.Config.Worktree.Enabled {
-		if err := app.Git.CreateBranch(ctx, t.ID); err != nil {
-			return fmt.Errorf("failed to create branch %s: %w", t.ID, err)
+		var err error
+		if startPoint == "" {
+			err = app.Git.CreateBranch(ctx, t.BranchName())
+		} else {
+			err = app.Git.CreateBranchFrom(ctx, t.BranchName(), startPoint)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create branch %s: %w", t.BranchName(), err)
 		}
 	}
 	return nil
@@ -1131,9 +1328,14 @@ func (app *App) moveTicketToDoing(ctx context.Context, t *ticket.Ticket, current
 	// Store the old path before moving
 	oldPath := t.Path
 
-	// Move the file
+	// Move the file. A branch created from another base may not have the
+	// ticket yet; then Update below writes it to the new path.
+	paths := []string{filepath.Dir(oldPath), filepath.Dir(newPath)}
 	if err := os.Rename(t.Path, newPath); err != nil {
-		return fmt.Errorf("failed to move ticket to doing: %w", err)
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to move ticket to doing: %w", err)
+		}
+		paths = paths[1:]
 	}
 
 	// Update ticket path
@@ -1145,7 +1347,7 @@ func (app *App) moveTicketToDoing(ctx context.Context, t *ticket.Ticket, current
 	}
 
 	// Stage and commit the move - use -A to handle the rename properly
-	if err := app.Git.Add(ctx, "-A", filepath.Dir(oldPath), filepath.Dir(newPath)); err != nil {
+	if err := app.Git.Add(ctx, append([]string{"-A"}, paths...)...); err != nil {
 		return fmt.Errorf("failed to stage ticket move: %w", err)
 	}
 
@@ -1164,7 +1366,7 @@ func (app *App) moveTicketToDoing(ctx context.Context, t *ticket.Ticket, current
 
 	// In worktree mode, switch back to original branch
 	// In non-worktree mode, stay on the ticket branch to work on it
-	if app.Config.Worktree.Enabled && currentBranch != t.ID {
+	if app.Config.Worktree.Enabled && currentBranch != t.BranchName() {
 		if err := app.Git.Checkout(ctx, currentBranch); err != nil {
 			return fmt.Errorf("failed to switch back to original branch: %w", err)
 		}
@@ -1213,7 +1415,7 @@ func (app *App) validateTicketForClose(ctx context.Context, force bool) (*ticket
 
 	if app.Config.Worktree.Enabled {
 		// Check if a worktree exists for this ticket
-		wt, err := app.Git.FindWorktreeByBranch(ctx, current.ID)
+		wt, err := app.Git.FindWorktreeByBranch(ctx, current.BranchName())
 		if err != nil {
 			return nil, "", fmt.Errorf("failed to find worktree: %w", err)
 		}
@@ -1265,8 +1467,8 @@ func (app *App) validateTicketForClose(ctx context.Context, force bool) (*ticket
 		}
 
 		// Ensure we're on the ticket branch
-		if currentBranch != current.ID {
-			return nil, "", fmt.Errorf("not on ticket branch, expected %s but on %s", current.ID, currentBranch)
+		if currentBranch != current.BranchName() {
+			return nil, "", fmt.Errorf("not on ticket branch, expected %s but on %s", current.BranchName(), currentBranch)
 		}
 	}
 
@@ -1340,10 +1542,10 @@ func (app *App) checkExistingWorktree(ctx context.Context, t *ticket.Ticket, for
 		return nil
 	}
 
-	if exists, err := app.Git.HasWorktree(ctx, t.ID); err != nil {
+	if exists, err := app.Git.HasWorktree(ctx, t.BranchName()); err != nil {
 		return fmt.Errorf("failed to check worktree: %w", err)
 	} else if exists {
-		worktreePath := worktree.GetPath(ctx, app.Git, app.Config, app.RepoRoot, t.ID)
+		worktreePath := worktree.GetPath(ctx, app.Git, app.Config, app.RepoRoot, t.ID, t.BranchName())
 		if !force {
 			return NewError(ErrWorktreeExists, "Worktree already exists",
 				fmt.Sprintf("Worktree for ticket %s already exists at: %s", t.ID, worktreePath), nil)
@@ -1360,8 +1562,11 @@ func (app *App) checkExistingWorktree(ctx context.Context, t *ticket.Ticket, for
 	return nil
 }
 
-// createAndSetupWorktree creates a worktree and runs initialization commands
-func (app *App) createAndSetupWorktree(ctx context.Context, t *ticket.Ticket) (string, error) {
+// createAndSetupWorktree creates a worktree and runs initialization commands.
+// A new branch is created at startPoint, or at HEAD when startPoint is empty.
+// startCommitted tells whether HEAD is the start commit of the ticket, which is
+// dropped when the worktree cannot be set up.
+func (app *App) createAndSetupWorktree(ctx context.Context, t *ticket.Ticket, startPoint string, startCommitted bool) (string, error) {
 	logger := log.Global()
 
 	if !app.Config.Worktree.Enabled {
@@ -1372,7 +1577,16 @@ func (app *App) createAndSetupWorktree(ctx context.Context, t *ticket.Ticket) (s
 	baseDir := app.Config.GetWorktreePath(app.RepoRoot)
 	worktreePath := filepath.Join(baseDir, t.ID)
 
-	err := app.Git.AddWorktree(ctx, worktreePath, t.ID)
+	branchExisted, err := app.Git.BranchExists(ctx, t.BranchName())
+	if err != nil {
+		return "", fmt.Errorf("failed to check branch %s: %w", t.BranchName(), err)
+	}
+
+	if startPoint == "" {
+		err = app.Git.AddWorktree(ctx, worktreePath, t.BranchName())
+	} else {
+		err = app.Git.AddWorktreeFrom(ctx, worktreePath, t.BranchName(), startPoint)
+	}
 	if err != nil {
 		// Check if this is a branch divergence error
 		var divergenceErr *ticketerrors.BranchDivergenceError
@@ -1384,10 +1598,16 @@ func (app *App) createAndSetupWorktree(ctx context.Context, t *ticket.Ticket) (s
 			}
 		} else {
 			// Other error - rollback
-			if _, rollbackErr := app.Git.Exec(ctx, "reset", "--hard", "HEAD^"); rollbackErr != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to rollback after worktree creation failure: %v\n", rollbackErr)
-			}
-			return "", fmt.Errorf("failed to create worktree at %s for branch %s: %w", worktreePath, t.ID, err)
+			app.rollbackWorktreeStart(ctx, t, "", false, startCommitted)
+			return "", fmt.Errorf("failed to create worktree at %s for branch %s: %w", worktreePath, t.BranchName(), err)
+		}
+	}
+
+	// The start commit is only in the worktree when its branch started at HEAD
+	if startPoint != "" {
+		if err := app.CommitStartedTicketInWorktree(ctx, worktreePath, t); err != nil {
+			app.rollbackWorktreeStart(ctx, t, worktreePath, !branchExisted, startCommitted)
+			return "", err
 		}
 	}
 
@@ -1406,6 +1626,27 @@ func (app *App) createAndSetupWorktree(ctx context.Context, t *ticket.Ticket) (s
 	return worktreePath, nil
 }
 
+// rollbackWorktreeStart undoes a start whose worktree could not be set up: it
+// removes the worktree when one was created, deletes the branch when this start
+// created it, and drops the start commit so the ticket file is restored
+func (app *App) rollbackWorktreeStart(ctx context.Context, t *ticket.Ticket, worktreePath string, deleteBranch, startCommitted bool) {
+	if worktreePath != "" {
+		if err := app.Git.RemoveWorktree(ctx, worktreePath); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to remove worktree %s during rollback: %v\n", worktreePath, err)
+		}
+	}
+	if deleteBranch {
+		if _, err := app.Git.Exec(ctx, git.SubcmdBranch, git.FlagDeleteForce, t.BranchName()); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to delete branch %s during rollback: %v\n", t.BranchName(), err)
+		}
+	}
+	if startCommitted {
+		if _, err := app.Git.Exec(ctx, git.SubcmdReset, git.FlagHard, "HEAD^"); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to rollback after worktree creation failure: %v\n", err)
+		}
+	}
+}
+
 // handleBranchDivergence handles the case when a branch has diverged
 func (app *App) handleBranchDivergence(ctx context.Context, t *ticket.Ticket, worktreePath string,
 	divergenceErr *ticketerrors.BranchDivergenceError) (string, error) {
@@ -1431,8 +1672,8 @@ func (app *App) handleBranchDivergence(ctx context.Context, t *ticket.Ticket, wo
 	switch choice {
 	case "u":
 		// Use existing branch
-		app.Output.Printf("Using existing branch '%s'...\n", t.ID)
-		_, err = app.Git.Exec(ctx, git.SubcmdWorktree, git.WorktreeAdd, worktreePath, t.ID)
+		app.Output.Printf("Using existing branch '%s'...\n", t.BranchName())
+		_, err = app.Git.Exec(ctx, git.SubcmdWorktree, git.WorktreeAdd, worktreePath, t.BranchName())
 		if err != nil {
 			return "", fmt.Errorf("failed to create worktree with existing branch: %w", err)
 		}
@@ -1440,20 +1681,20 @@ func (app *App) handleBranchDivergence(ctx context.Context, t *ticket.Ticket, wo
 
 	case "r":
 		// Delete and recreate branch
-		app.Output.Printf("Recreating branch '%s' at current HEAD...\n", t.ID)
+		app.Output.Printf("Recreating branch '%s' at current HEAD...\n", t.BranchName())
 
 		// First, delete the branch
-		_, err = app.Git.Exec(ctx, git.SubcmdBranch, git.FlagDeleteForce, t.ID)
+		_, err = app.Git.Exec(ctx, git.SubcmdBranch, git.FlagDeleteForce, t.BranchName())
 		if err != nil {
 			return "", fmt.Errorf("failed to delete branch: %w", err)
 		}
 
 		// Now create worktree with new branch
 		_, err = app.Git.Exec(ctx, git.SubcmdWorktree, git.WorktreeAdd, worktreePath,
-			git.FlagBranch, t.ID)
+			git.FlagBranch, t.BranchName())
 		if err != nil {
 			// Try to recover by recreating the branch we just deleted
-			if recoverErr := app.Git.CreateBranch(ctx, t.ID); recoverErr != nil {
+			if recoverErr := app.Git.CreateBranch(ctx, t.BranchName()); recoverErr != nil {
 				return "", fmt.Errorf("failed to create worktree with new branch and could not recover: %w (recovery error: %v)", err, recoverErr)
 			}
 			return "", fmt.Errorf("failed to create worktree with new branch: %w", err)
@@ -1589,17 +1830,15 @@ func (app *App) printCloseSuccessMessage(t *ticket.Ticket, duration, parentTicke
 	}
 }
 
-// countTicketsByStatus counts tickets by their status
-func (app *App) countTicketsByStatus(tickets []ticket.Ticket) (todoCount, doingCount, doneCount int) {
+// countTicketsByState counts tickets by their workflow state.
+// Every configured state is present in the result, along with the total.
+func (app *App) countTicketsByState(tickets []ticket.Ticket) map[string]int {
+	counts := map[string]int{"total": len(tickets)}
+	for _, name := range app.Config.GetStateNames() {
+		counts[name] = 0
+	}
 	for _, t := range tickets {
-		switch t.Status() {
-		case ticket.StatusTodo:
-			todoCount++
-		case ticket.StatusDoing:
-			doingCount++
-		case ticket.StatusDone:
-			doneCount++
-		}
+		counts[string(t.State())]++
 	}
-	return todoCount, doingCount, doneCount
+	return counts
 }
@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// defaultArchiveAge is how long a ticket must have been closed before it is archived
+const defaultArchiveAge = "90d"
+
+// ArchiveCommand implements the archive command using the new Command interface
+type ArchiveCommand struct{}
+
+// NewArchiveCommand creates a new archive command
+func NewArchiveCommand() command.Command {
+	return &ArchiveCommand{}
+}
+
+// Name returns the command name
+func (c *ArchiveCommand) Name() string {
+	return "archive"
+}
+
+// Aliases returns alternative names for this command
+func (c *ArchiveCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *ArchiveCommand) Description() string {
+	return "Move old done tickets into the archive directory"
+}
+
+// Usage returns the usage string for the command
+func (c *ArchiveCommand) Usage() string {
+	return "archive [--older-than AGE] [--dry-run] [--format text|json]"
+}
+
+// archiveFlags holds the flags for the archive command
+type archiveFlags struct {
+	olderThan string
+	dryRun    bool
+	format    string
+}
+
+// SetupFlags configures flags for the command
+func (c *ArchiveCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &archiveFlags{}
+	fs.StringVar(&flags.olderThan, "older-than", defaultArchiveAge, "Only archive tickets closed longer ago than this (e.g. 90d, 12w, 720h)")
+	fs.BoolVar(&flags.dryRun, "dry-run", false, "Show what would be archived without making changes")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *ArchiveCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("archive takes no arguments, got %v", args)
+	}
+
+	f, err := AssertFlags[archiveFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if _, err := parseAge(f.olderThan); err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the archive command
+func (c *ArchiveCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[archiveFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	olderThan, err := parseAge(f.olderThan)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.ArchiveTickets(ctx, olderThan, f.dryRun)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
+
+// parseAge parses an age such as "90d" or "12w", falling back to Go durations like "720h"
+func parseAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("invalid age: value is empty")
+	}
+
+	var unit time.Duration
+	switch s[len(s)-1] {
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	}
+	if unit != 0 {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age: %q (use a number of days or weeks such as '90d' or '12w')", s)
+		}
+		return time.Duration(n) * unit, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age: %q (use a number of days or weeks such as '90d' or '12w')", s)
+	}
+	return d, nil
+}
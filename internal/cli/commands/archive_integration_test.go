@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestArchiveCommand_Execute_Integration(t *testing.T) {
+	const (
+		oldID    = "240101-120000-old-ticket"
+		recentID = "250101-120000-recent-ticket"
+	)
+
+	setup := func(t *testing.T) *testharness.TestEnvironment {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.CreateTicket(oldID, ticket.StatusDone)
+		env.CreateTicket(recentID, ticket.StatusDone)
+
+		// Backdate the old ticket's closure
+		closed := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		path := filepath.Join(env.RootDir, env.TicketPath("done", oldID+".md"))
+		tk, err := ticket.Parse([]byte(env.ReadFile(env.TicketPath("done", oldID+".md"))))
+		require.NoError(t, err)
+		tk.ClosedAt = ticket.NewRFC3339TimePtr(&closed)
+		data, err := tk.ToBytes()
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, data, 0644))
+
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Add tickets")
+		return env
+	}
+
+	run := func(t *testing.T, flags *archiveFlags) {
+		cmd := NewArchiveCommand()
+		require.NoError(t, cmd.Validate(flags, nil))
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		require.NoError(t, cmd.Execute(ctx, flags, nil))
+	}
+
+	t.Run("archives tickets older than the cutoff", func(t *testing.T) {
+		env := setup(t)
+		run(t, &archiveFlags{olderThan: "90d", format: FormatText})
+
+		assert.False(t, env.FileExists(env.TicketPath("done", oldID+".md")))
+		assert.True(t, env.FileExists(filepath.Join("tickets", "done", "archive", "2024", oldID+".md")))
+		assert.True(t, env.FileExists(env.TicketPath("done", recentID+".md")))
+		assert.Equal(t, "Archive 1 done ticket(s)", env.LastCommitMessage())
+		assert.False(t, env.HasUncommittedChanges())
+
+		// Archived tickets still resolve by ID
+		app, err := cli.NewApp(context.Background())
+		require.NoError(t, err)
+		got, err := app.Manager.Get(context.Background(), oldID)
+		require.NoError(t, err)
+		assert.True(t, got.IsArchived())
+	})
+
+	t.Run("restores tickets when the commit fails", func(t *testing.T) {
+		env := setup(t)
+		hook := filepath.Join(env.RootDir, ".git", "hooks", "pre-commit")
+		require.NoError(t, os.MkdirAll(filepath.Dir(hook), 0755))
+		require.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\nexit 1\n"), 0755))
+
+		cmd := NewArchiveCommand()
+		flags := &archiveFlags{olderThan: "90d", format: FormatText}
+		require.NoError(t, cmd.Validate(flags, nil))
+		require.Error(t, cmd.Execute(context.Background(), flags, nil))
+
+		assert.True(t, env.FileExists(env.TicketPath("done", oldID+".md")))
+		assert.False(t, env.FileExists(filepath.Join("tickets", "done", "archive", "2024", oldID+".md")))
+		assert.Equal(t, "Add tickets", env.LastCommitMessage())
+		assert.False(t, env.HasUncommittedChanges())
+	})
+
+	t.Run("dry run leaves tickets in place", func(t *testing.T) {
+		env := setup(t)
+		run(t, &archiveFlags{olderThan: "90d", dryRun: true, format: FormatJSON})
+
+		assert.True(t, env.FileExists(env.TicketPath("done", oldID+".md")))
+		assert.Equal(t, "Add tickets", env.LastCommitMessage())
+	})
+
+	t.Run("zero age archives every closed ticket", func(t *testing.T) {
+		env := setup(t)
+		run(t, &archiveFlags{olderThan: "0d", format: FormatText})
+
+		year := time.Now().Format("2006")
+		assert.True(t, env.FileExists(filepath.Join("tickets", "done", "archive", year, recentID+".md")))
+		assert.Equal(t, "Archive 2 done ticket(s)", env.LastCommitMessage())
+	})
+}
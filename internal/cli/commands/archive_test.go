@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewArchiveCommand()
+
+	assert.Equal(t, "archive", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Move old done tickets into the archive directory", cmd.Description())
+	assert.Equal(t, "archive [--older-than AGE] [--dry-run] [--format text|json]", cmd.Usage())
+}
+
+func TestArchiveCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewArchiveCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*archiveFlags)
+
+	assert.Equal(t, "90d", flags.olderThan)
+	assert.False(t, flags.dryRun)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--older-than", "30d", "--dry-run", "-o", "json"}))
+	assert.Equal(t, "30d", flags.olderThan)
+	assert.True(t, flags.dryRun)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestArchiveCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *archiveFlags
+		args        []string
+		errContains string
+	}{
+		{name: "defaults", flags: &archiveFlags{olderThan: "90d", format: FormatText}},
+		{name: "dry run json", flags: &archiveFlags{olderThan: "12w", dryRun: true, format: FormatJSON}},
+		{name: "invalid age", flags: &archiveFlags{olderThan: "soon", format: FormatText}, errContains: "invalid age"},
+		{name: "unexpected args", flags: &archiveFlags{olderThan: "90d", format: FormatText}, args: []string{"extra"}, errContains: "takes no arguments"},
+		{name: "invalid format", flags: &archiveFlags{olderThan: "90d", format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewArchiveCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "90d", want: 90 * 24 * time.Hour},
+		{input: "0d", want: 0},
+		{input: "2w", want: 14 * 24 * time.Hour},
+		{input: "36h", want: 36 * time.Hour},
+		{input: "", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "-5d", wantErr: true},
+		{input: "-1h", wantErr: true},
+		{input: "ninety", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseAge(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
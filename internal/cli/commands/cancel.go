@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// CancelCommand implements the cancel command using the new Command interface
+type CancelCommand struct{}
+
+// NewCancelCommand creates a new cancel command
+func NewCancelCommand() command.Command {
+	return &CancelCommand{}
+}
+
+// Name returns the command name
+func (c *CancelCommand) Name() string {
+	return "cancel"
+}
+
+// Aliases returns alternative names for this command
+func (c *CancelCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *CancelCommand) Description() string {
+	return "Cancel a ticket and move it to the cancelled directory"
+}
+
+// Usage returns the usage string for the command
+func (c *CancelCommand) Usage() string {
+	return "cancel --reason <message> [--format text|json] <ticket-id>"
+}
+
+// cancelFlags holds the flags for the cancel command
+type cancelFlags struct {
+	reason string
+	format string
+}
+
+// SetupFlags configures flags for the command
+func (c *CancelCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &cancelFlags{}
+	fs.StringVarP(&flags.reason, "reason", "r", "", "Reason for cancelling the ticket (required)")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *CancelCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[cancelFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(f.reason) == "" {
+		return fmt.Errorf("--reason is required when cancelling a ticket")
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the cancel command
+func (c *CancelCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[cancelFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.CancelTicket(ctx, args[0], f.reason)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
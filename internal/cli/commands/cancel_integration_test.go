@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestCancelCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-cancel-me"
+
+	tests := []struct {
+		name          string
+		status        ticket.Status
+		reason        string
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name:   "cancel todo ticket without worktree",
+			status: ticket.StatusTodo,
+			reason: "No longer needed",
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.False(t, env.FileExists(env.TicketPath("todo", ticketID+".md")))
+				content := env.ReadFile(env.TicketPath("cancelled", ticketID+".md"))
+				parsed, err := ticket.Parse([]byte(content))
+				require.NoError(t, err)
+				assert.Nil(t, parsed.StartedAt.Time)
+				assert.NotNil(t, parsed.ClosedAt.Time)
+				assert.Equal(t, "No longer needed", parsed.ClosureReason)
+				assert.Contains(t, content, "**Reason**: No longer needed")
+				assert.Equal(t, "Cancel ticket: "+ticketID+" (No longer needed)", env.LastCommitMessage())
+			},
+		},
+		{
+			name:   "cancel doing ticket clears current ticket link",
+			status: ticket.StatusDoing,
+			reason: "Superseded",
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.True(t, env.FileExists(env.TicketPath("cancelled", ticketID+".md")))
+				_, err := os.Lstat(filepath.Join(env.RootDir, "current-ticket.md"))
+				assert.True(t, os.IsNotExist(err))
+			},
+		},
+		{
+			name:          "cannot cancel closed ticket",
+			status:        ticket.StatusDone,
+			reason:        "Too late",
+			errorContains: "already closed",
+		},
+		{
+			name:          "ticket not found",
+			reason:        "Missing",
+			errorContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			if tt.status != "" {
+				env.CreateTicket(ticketID, tt.status)
+			}
+
+			cmd := NewCancelCommand()
+			flags := &cancelFlags{reason: tt.reason, format: FormatText}
+			args := []string{ticketID}
+			require.NoError(t, cmd.Validate(flags, args))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			err = cmd.Execute(ctx, flags, args)
+
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
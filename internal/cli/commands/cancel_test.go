@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewCancelCommand()
+
+	assert.Equal(t, "cancel", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Cancel a ticket and move it to the cancelled directory", cmd.Description())
+	assert.Equal(t, "cancel --reason <message> [--format text|json] <ticket-id>", cmd.Usage())
+}
+
+func TestCancelCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewCancelCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*cancelFlags)
+
+	assert.Empty(t, flags.reason)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-r", "out of scope", "-o", "json"}))
+	assert.Equal(t, "out of scope", flags.reason)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestCancelCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *cancelFlags
+		args        []string
+		errContains string
+	}{
+		{name: "ticket and reason", flags: &cancelFlags{reason: "duplicate", format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "json format", flags: &cancelFlags{reason: "duplicate", format: FormatJSON}, args: []string{"250101-120000-test"}},
+		{name: "missing ticket", flags: &cancelFlags{reason: "duplicate", format: FormatText}, errContains: "missing ticket ID"},
+		{name: "missing reason", flags: &cancelFlags{format: FormatText}, args: []string{"250101-120000-test"}, errContains: "--reason is required"},
+		{name: "blank reason", flags: &cancelFlags{reason: "   ", format: FormatText}, args: []string{"250101-120000-test"}, errContains: "--reason is required"},
+		{name: "extra args", flags: &cancelFlags{reason: "duplicate", format: FormatText}, args: []string{"250101-120000-test", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &cancelFlags{reason: "duplicate", format: "xml"}, args: []string{"250101-120000-test"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewCancelCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
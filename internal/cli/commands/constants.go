@@ -5,4 +5,14 @@ package commands
 const (
 	FormatText = "text" // Maps to cli.FormatText
 	FormatJSON = "json" // Maps to cli.FormatJSON
+
+	// Additional formats of the commands that print tickets and worktrees
+	FormatYAML           = "yaml"      // Maps to cli.FormatYAML
+	FormatCSV            = "csv"       // Maps to cli.FormatCSV
+	FormatMarkdown       = "markdown"  // Maps to cli.FormatMarkdown
+	FormatNDJSON         = "ndjson"    // Maps to cli.FormatNDJSON
+	FormatTemplatePrefix = "template=" // Maps to cli.FormatTemplatePrefix
+
+	// extendedFormatsHelp describes the formats accepted by ValidateExtendedFormat
+	extendedFormatsHelp = "text|json|yaml|csv|markdown|ndjson|template=TEMPLATE"
 )
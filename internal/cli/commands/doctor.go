@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// DoctorCommand implements the doctor command
+type DoctorCommand struct{}
+
+// NewDoctorCommand creates a new doctor command
+func NewDoctorCommand() command.Command {
+	return &DoctorCommand{}
+}
+
+// Name returns the command name
+func (c *DoctorCommand) Name() string {
+	return "doctor"
+}
+
+// Aliases returns alternative names for this command
+func (c *DoctorCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *DoctorCommand) Description() string {
+	return "Check tickets, branches and worktrees for problems"
+}
+
+// Usage returns the usage string for the command
+func (c *DoctorCommand) Usage() string {
+	return "doctor [--fix] [--format text|json]"
+}
+
+// doctorFlags holds the flags for the doctor command
+type doctorFlags struct {
+	fix    bool
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *DoctorCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &doctorFlags{}
+	fs.BoolVar(&flags.fix, "fix", false, "Repair the problems that can be fixed safely")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *DoctorCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	f, err := AssertFlags[doctorFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *DoctorCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[doctorFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.Doctor(ctx, f.fix)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestDoctorCommand_Execute_Integration(t *testing.T) {
+	const (
+		noBranchID = "250101-120000-no-branch"
+		doneID     = "250101-120001-done"
+		childID    = "250101-120002-child"
+		misplaced  = "250101-120003-misplaced"
+	)
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.CreateTicket(noBranchID, ticket.StatusDoing)
+	env.CreateTicket(doneID, ticket.StatusDone)
+	env.CreateTicket(childID, ticket.StatusTodo, testharness.WithParent("250101-000000-gone"))
+	env.RunGit("branch", doneID)
+	env.CreateWorktree("experiment")
+
+	// A doing ticket moved back to todo by hand: started_at is left behind and
+	// current-ticket.md now points to a file that no longer exists
+	env.CreateTicket(misplaced, ticket.StatusDoing)
+	require.NoError(t, os.Rename(
+		filepath.Join(env.RootDir, env.TicketPath("doing", misplaced+".md")),
+		filepath.Join(env.RootDir, env.TicketPath("todo", misplaced+".md"))))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, format := range []string{FormatText, FormatJSON} {
+		flags := &doctorFlags{format: format}
+		require.NoError(t, NewDoctorCommand().Validate(flags, nil))
+		require.NoError(t, NewDoctorCommand().Execute(ctx, flags, nil))
+	}
+
+	app, err := cli.NewApp(ctx)
+	require.NoError(t, err)
+	result, err := app.Doctor(ctx, false)
+	require.NoError(t, err)
+
+	codes := make(map[string]string)
+	for _, issue := range result.Issues {
+		codes[issue.Code] = issue.TicketID
+		assert.False(t, issue.Fixed)
+	}
+	assert.Equal(t, noBranchID, codes[cli.DoctorMissingBranch])
+	assert.Equal(t, doneID, codes[cli.DoctorStaleBranch])
+	assert.Equal(t, childID, codes[cli.DoctorDanglingRelation])
+	assert.Equal(t, "experiment", codes[cli.DoctorOrphanedWorktree])
+	assert.Equal(t, misplaced, codes[cli.DoctorTimestampsInconsistent])
+	assert.Equal(t, misplaced, codes[cli.DoctorBrokenCurrentTicket])
+	assert.False(t, result.Healthy())
+
+	// Fix the repairable issues
+	require.NoError(t, NewDoctorCommand().Execute(ctx, &doctorFlags{fix: true, format: FormatText}, nil))
+
+	assert.Contains(t, env.ReadFile(env.TicketPath("todo", misplaced+".md")), "started_at: null")
+	_, err = os.Lstat(filepath.Join(env.RootDir, ticket.CurrentTicketFile))
+	assert.True(t, os.IsNotExist(err), "broken current-ticket.md should be removed")
+
+	result, err = app.Doctor(ctx, false)
+	require.NoError(t, err)
+	for _, issue := range result.Issues {
+		assert.False(t, issue.Fixable, "fixable issue %s remains", issue.Code)
+	}
+	assert.Len(t, result.Issues, 4)
+}
+
+func TestDoctorCommand_Execute_ClosedTicketTimestamps(t *testing.T) {
+	const (
+		closedFromTodo = "250101-120000-closed-from-todo"
+		cancelled      = "250101-120001-cancelled"
+	)
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	// Closed with 'close <id> --reason' straight from todo: never started
+	closedPath := env.TicketPath("done", closedFromTodo+".md")
+	env.WriteFile(closedPath, `---
+priority: 2
+description: Closed without starting
+created_at: 2025-01-01T12:00:00Z
+started_at: null
+closed_at: 2025-01-02T12:00:00Z
+closure_reason: Not needed
+---
+
+# Closed from todo
+`)
+	// Cancelled before closed_at was recorded
+	cancelledPath := env.TicketPath("cancelled", cancelled+".md")
+	env.WriteFile(cancelledPath, `---
+priority: 2
+description: Cancelled long ago
+created_at: 2025-01-01T12:00:01Z
+started_at: null
+closed_at: null
+---
+
+# Cancelled
+`)
+	closedBefore := env.ReadFile(closedPath)
+	cancelledBefore := env.ReadFile(cancelledPath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	app, err := cli.NewApp(ctx)
+	require.NoError(t, err)
+	result, err := app.Doctor(ctx, false)
+	require.NoError(t, err)
+
+	require.Len(t, result.Issues, 1)
+	issue := result.Issues[0]
+	assert.Equal(t, cli.DoctorTimestampsInconsistent, issue.Code)
+	assert.Equal(t, cli.DoctorSeverityInfo, issue.Severity)
+	assert.Equal(t, cancelled, issue.TicketID)
+	assert.False(t, issue.Fixable)
+	assert.True(t, result.Healthy())
+
+	require.NoError(t, NewDoctorCommand().Execute(ctx, &doctorFlags{fix: true, format: FormatText}, nil))
+
+	assert.Equal(t, closedBefore, env.ReadFile(closedPath))
+	assert.Equal(t, cancelledBefore, env.ReadFile(cancelledPath))
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoctorCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewDoctorCommand()
+
+	assert.Equal(t, "doctor", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Check tickets, branches and worktrees for problems", cmd.Description())
+	assert.Equal(t, "doctor [--fix] [--format text|json]", cmd.Usage())
+}
+
+func TestDoctorCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewDoctorCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*doctorFlags)
+
+	assert.False(t, flags.fix)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--fix", "-o", "json"}))
+	assert.True(t, flags.fix)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestDoctorCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *doctorFlags
+		args        []string
+		errContains string
+	}{
+		{name: "no flags", flags: &doctorFlags{format: FormatText}},
+		{name: "fix with json", flags: &doctorFlags{fix: true, format: FormatJSON}},
+		{name: "unexpected args", flags: &doctorFlags{format: FormatText}, args: []string{"extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &doctorFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewDoctorCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
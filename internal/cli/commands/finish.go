@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// FinishCommand implements the finish command using the new Command interface
+type FinishCommand struct{}
+
+// NewFinishCommand creates a new finish command
+func NewFinishCommand() command.Command {
+	return &FinishCommand{}
+}
+
+// Name returns the command name
+func (c *FinishCommand) Name() string {
+	return "finish"
+}
+
+// Aliases returns alternative names for this command
+func (c *FinishCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *FinishCommand) Description() string {
+	return "Squash-merge a ticket branch into the default branch and close the ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *FinishCommand) Usage() string {
+	return "finish [--cleanup] [--dry-run] [--format text|json] <ticket-id>"
+}
+
+// finishFlags holds the flags for the finish command
+type finishFlags struct {
+	cleanup bool
+	dryRun  bool
+	format  string
+}
+
+// SetupFlags configures flags for the command
+func (c *FinishCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &finishFlags{}
+	fs.BoolVar(&flags.cleanup, "cleanup", false, "Remove the ticket's worktree and branch after merging")
+	fs.BoolVar(&flags.dryRun, "dry-run", false, "Show what would be merged without making changes")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *FinishCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[finishFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the finish command
+func (c *FinishCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[finishFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.FinishTicket(ctx, args[0], f.cleanup, f.dryRun)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
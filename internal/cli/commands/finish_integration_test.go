@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestFinishCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-add-feature"
+
+	// setup creates a started ticket whose worktree has one commit adding feature.txt
+	setup := func(t *testing.T) (*testharness.TestEnvironment, string) {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.WriteFile(".gitignore", "current-ticket.md\n")
+		env.CreateTicket(ticketID, ticket.StatusDoing, testharness.WithDescription("Add the feature"))
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start ticket")
+
+		env.CreateWorktree(ticketID)
+		wtPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "feature.txt"), []byte("feature\n"), 0644))
+		env.RunGit("-C", wtPath, "add", "feature.txt")
+		env.RunGit("-C", wtPath, "commit", "-m", "Implement feature")
+		return env, wtPath
+	}
+
+	run := func(t *testing.T, flags *finishFlags) error {
+		cmd := NewFinishCommand()
+		require.NoError(t, cmd.Validate(flags, []string{ticketID}))
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return cmd.Execute(ctx, flags, []string{ticketID})
+	}
+
+	t.Run("squash-merges the branch and closes the ticket", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, run(t, &finishFlags{format: FormatText}))
+
+		assert.Equal(t, "main", env.GetCurrentBranch())
+		assert.True(t, env.FileExists("feature.txt"))
+		assert.True(t, env.FileExists(env.TicketPath("done", ticketID+".md")))
+		assert.False(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+		assert.Equal(t, "Close ticket: "+ticketID, env.LastCommitMessage())
+		assert.Equal(t, "Add the feature\n\nTicket: "+ticketID,
+			strings.TrimSpace(env.RunGit("log", "-1", "--pretty=%B", "HEAD^")))
+		assert.False(t, env.HasUncommittedChanges())
+
+		// Without --cleanup the worktree and branch are kept
+		assert.True(t, env.WorktreeExists(ticketID))
+		assert.DirExists(t, wtPath)
+		assert.Contains(t, env.RunGit("branch", "--list", ticketID), ticketID)
+	})
+
+	t.Run("cleanup removes the worktree and branch", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, run(t, &finishFlags{cleanup: true, format: FormatText}))
+
+		assert.True(t, env.FileExists("feature.txt"))
+		assert.False(t, env.WorktreeExists(ticketID))
+		assert.NoDirExists(t, wtPath)
+		assert.Empty(t, strings.TrimSpace(env.RunGit("branch", "--list", ticketID)))
+	})
+
+	t.Run("dry run makes no changes", func(t *testing.T) {
+		env, _ := setup(t)
+		head := env.RunGit("rev-parse", "HEAD")
+
+		output := testharness.CaptureOutput(t, func() {
+			require.NoError(t, run(t, &finishFlags{cleanup: true, dryRun: true, format: FormatJSON}))
+		})
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "dry_run", true)
+		testharness.AssertJSONField(t, jsonData, "target_branch", "main")
+		testharness.AssertJSONField(t, jsonData, "commits", float64(1))
+		testharness.AssertJSONField(t, jsonData, "commit_message", "Add the feature\n\nTicket: "+ticketID)
+
+		assert.Equal(t, head, env.RunGit("rev-parse", "HEAD"))
+		assert.False(t, env.FileExists("feature.txt"))
+		assert.True(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+		assert.True(t, env.WorktreeExists(ticketID))
+	})
+
+	t.Run("conflicting merge is rolled back", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "README.md"), []byte("# Branch\n"), 0644))
+		env.RunGit("-C", wtPath, "commit", "-am", "Change README on branch")
+		env.WriteFile("README.md", "# Main\n")
+		env.RunGit("commit", "-am", "Change README on main")
+		head := env.RunGit("rev-parse", "HEAD")
+
+		err := run(t, &finishFlags{format: FormatText})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Merge failed")
+
+		assert.Equal(t, head, env.RunGit("rev-parse", "HEAD"))
+		assert.Equal(t, "main", env.GetCurrentBranch())
+		assert.False(t, env.HasUncommittedChanges())
+		assert.Equal(t, "# Main\n", env.ReadFile("README.md"))
+		assert.True(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+	})
+
+	t.Run("uncommitted changes in the worktree are rejected", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "feature.txt"), []byte("changed\n"), 0644))
+		head := env.RunGit("rev-parse", "HEAD")
+
+		err := run(t, &finishFlags{format: FormatText})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Uncommitted changes in worktree")
+		assert.Equal(t, head, env.RunGit("rev-parse", "HEAD"))
+	})
+}
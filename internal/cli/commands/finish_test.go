@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFinishCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewFinishCommand()
+
+	assert.Equal(t, "finish", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Squash-merge a ticket branch into the default branch and close the ticket", cmd.Description())
+	assert.Equal(t, "finish [--cleanup] [--dry-run] [--format text|json] <ticket-id>", cmd.Usage())
+}
+
+func TestFinishCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewFinishCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*finishFlags)
+
+	assert.False(t, flags.cleanup)
+	assert.False(t, flags.dryRun)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--cleanup", "--dry-run", "-o", "json"}))
+	assert.True(t, flags.cleanup)
+	assert.True(t, flags.dryRun)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestFinishCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *finishFlags
+		args        []string
+		errContains string
+	}{
+		{name: "ticket id", flags: &finishFlags{format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "dry run json", flags: &finishFlags{dryRun: true, format: FormatJSON}, args: []string{"250101-120000-test"}},
+		{name: "missing ticket id", flags: &finishFlags{format: FormatText}, errContains: "missing ticket ID"},
+		{name: "unexpected args", flags: &finishFlags{format: FormatText}, args: []string{"250101-120000-test", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &finishFlags{format: "xml"}, args: []string{"250101-120000-test"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewFinishCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
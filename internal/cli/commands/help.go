@@ -131,43 +131,148 @@ func (c *HelpCommand) showGeneralHelp() error {
 	fmt.Println("    --log-level LEVEL   Log level (debug, info, warn, error)")
 	fmt.Println("    --log-format FORMAT Log format (text, json)")
 	fmt.Println("    --log-output OUTPUT Log output (stderr, stdout, or file path)")
+	fmt.Println("  and the ticket index option:")
+	fmt.Println("    --no-cache          Read every ticket file instead of using the ticket index")
 	fmt.Println()
 	fmt.Println("  new:")
 	fmt.Println("    --parent TICKET    Specify parent ticket ID")
 	fmt.Println("    -p TICKET          Short form of --parent")
+	fmt.Println("    --tag TAG          Add a tag (repeatable, or comma-separated)")
+	fmt.Println("    --template NAME    Create the ticket from a named template")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
 	fmt.Println("  list:")
-	fmt.Println("    --status STATUS    Filter by status (todo|doing|done)")
+	fmt.Println("    --view NAME        Show a saved view defined under 'views:' in the config")
+	fmt.Println("    --status STATE     Filter by workflow state (todo|doing|done|<custom>|all)")
+	fmt.Println("    --tag TAG          Only show tickets with this tag (repeatable)")
+	fmt.Println("    --include-archived Also list archived done tickets")
+	fmt.Println("    --filter EXPR      Filter expression, e.g. 'priority<=1 and has:worktree'")
+	fmt.Println("    --sort FIELDS      Sort fields, '-' for descending (e.g. priority,-created)")
+	fmt.Println("    --tree             Show tickets as a parent/sub-ticket tree")
+	fmt.Println("    --offset N         Skip the first N tickets")
 	fmt.Println("    --count N          Maximum number of tickets to show (default: 20)")
+	fmt.Println("    --format FORMAT    Output format: text|json|yaml|csv|markdown|ndjson|template=TMPL (default: text)")
+	fmt.Println()
+	fmt.Println("  views:")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
-	fmt.Println("  show:")
+	fmt.Println("  tree [<ticket>]:")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  search <query>:")
+	fmt.Println("    --status STATE     Only search this workflow state (default: all)")
+	fmt.Println("    --regex, -E        Treat the query as a regular expression")
+	fmt.Println("    --include-archived Also search archived tickets")
+	fmt.Println("    --count N          Maximum number of tickets to show (default: all)")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
+	fmt.Println("  show:")
+	fmt.Println("    --format FORMAT    Output format: text|json|yaml|csv|markdown|ndjson|template=TMPL (default: text)")
+	fmt.Println()
 	fmt.Println("  start:")
 	fmt.Println("    --force            Force recreate worktree if it already exists")
+	fmt.Println("    --base REF         Create the branch from default, current, parent or REF")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
 	fmt.Println("  close:")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
+	fmt.Println("  cancel <ticket>:")
+	fmt.Println("    --reason MESSAGE   Reason for cancelling (required)")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  reopen <ticket>:")
+	fmt.Println("    --start            Start the ticket right after reopening it")
+	fmt.Println("    --force            Force recreate worktree (with --start)")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  log <ticket>:")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
 	fmt.Println("  status:")
+	fmt.Println("    --format FORMAT    Output format: text|json|yaml|csv|markdown|ndjson|template=TMPL (default: text)")
+	fmt.Println()
+	fmt.Println("  next (alias: ready):")
+	fmt.Println("    --count N          Maximum number of tickets to show (default: all)")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  move <ticket> <state>:")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
+	fmt.Println("  link / unlink:")
+	fmt.Println("    --blocks ID        This ticket blocks ID")
+	fmt.Println("    --blocked-by ID    This ticket is blocked by ID")
+	fmt.Println("    --duplicates ID    This ticket duplicates ID")
+	fmt.Println("    --relates ID       This ticket relates to ID")
+	fmt.Println()
 	fmt.Println("  worktree:")
 	fmt.Println("    remove <ticket>    Remove worktree for a specific ticket")
-	fmt.Println("    list               List all worktrees")
+	fmt.Println("    list               List all worktrees (--format as for list)")
+	fmt.Println()
+	fmt.Println("  finish <ticket>:")
+	fmt.Println("    --cleanup          Remove the ticket's worktree and branch after merging")
+	fmt.Println("    --dry-run          Preview the merge without making changes")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  pr-body [<ticket>]:")
+	fmt.Println("    --template FILE    Render FILE instead of the configured template")
+	fmt.Println("    --file FILE        Write the description to FILE instead of stdout")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  which <rev|file:line>:")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  sync [<ticket>]:")
+	fmt.Println("    --all              Sync every ticket in progress")
+	fmt.Println("    --strategy NAME    Bring in the default branch with merge|rebase (default: sync.strategy)")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  hooks install:")
+	fmt.Println("    --validate         Reject commits for a ticket without a Ticket trailer")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
+	fmt.Println("  archive:")
+	fmt.Println("    --older-than AGE   Archive tickets closed longer ago than AGE (default: 90d)")
+	fmt.Println("    --dry-run          Preview archiving without making changes")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
 	fmt.Println("  cleanup:")
 	fmt.Println("    --dry-run          Preview cleanup without making changes")
 	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
 	fmt.Println()
+	fmt.Println("  doctor:")
+	fmt.Println("    --fix              Repair the problems that can be fixed safely")
+	fmt.Println("    --format FORMAT    Output format: text|json (default: text)")
+	fmt.Println()
 	fmt.Println("EXAMPLES:")
 	fmt.Println("  ticketflow new feature-xyz --parent TASK-123")
+	fmt.Println("  ticketflow new login-crash --template bug")
 	fmt.Println("  ticketflow list --status doing")
+	fmt.Println("  ticketflow list --tag backend")
+	fmt.Println("  ticketflow list --status done --include-archived")
+	fmt.Println("  ticketflow list --status all --filter 'slug~auth and created>2025-01-01' --sort -created")
+	fmt.Println("  ticketflow list --view mine-urgent")
+	fmt.Println("  ticketflow tree 250101-120000-epic")
+	fmt.Println("  ticketflow list --status all --format csv > tickets.csv")
+	fmt.Println("  ticketflow list --format 'template={{.id}} {{.status}}'")
+	fmt.Println("  ticketflow search \"login page\" --status done")
+	fmt.Println("  ticketflow search --regex 'TODO|FIXME' --format json")
+	fmt.Println("  ticketflow next --count 1 --format json")
+	fmt.Println("  ticketflow move feature-xyz review")
 	fmt.Println("  ticketflow start feature-xyz")
+	fmt.Println("  ticketflow start --base origin/main feature-xyz")
 	fmt.Println("  ticketflow close")
+	fmt.Println("  ticketflow finish feature-xyz --cleanup")
+	fmt.Println("  ticketflow pr-body --file pr.md")
+	fmt.Println("  ticketflow hooks install --validate")
+	fmt.Println("  ticketflow which internal/app.go:42")
+	fmt.Println("  ticketflow sync --all --strategy rebase")
+	fmt.Println("  ticketflow cancel feature-xyz --reason \"Superseded by feature-abc\"")
+	fmt.Println("  ticketflow reopen feature-xyz --start")
+	fmt.Println("  ticketflow archive --older-than 180d --dry-run")
+	fmt.Println("  ticketflow task check feature-xyz 2")
+	fmt.Println("  ticketflow log feature-xyz --format json")
+	fmt.Println("  ticketflow doctor --fix")
 	fmt.Println()
 	fmt.Println("For more information about a command, use:")
 	fmt.Println("  ticketflow help <command>")
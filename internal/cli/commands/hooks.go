@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+const (
+	// errUnknownHooksSubcommand is the error message for unknown hooks subcommands
+	errUnknownHooksSubcommand = "unknown hooks subcommand: %s"
+)
+
+// HooksCommand implements the hooks parent command using the new Command interface
+type HooksCommand struct {
+	subcommands map[string]command.Command
+}
+
+// NewHooksCommand creates a new hooks command with its subcommands
+func NewHooksCommand() command.Command {
+	return &HooksCommand{
+		subcommands: map[string]command.Command{
+			"install":   NewHooksInstallCommand(),
+			"uninstall": NewHooksUninstallCommand(),
+			"run":       NewHooksRunCommand(),
+		},
+	}
+}
+
+// Name returns the command name
+func (c *HooksCommand) Name() string {
+	return "hooks"
+}
+
+// Aliases returns alternative names for this command
+func (c *HooksCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *HooksCommand) Description() string {
+	return "Install or uninstall git hooks that tag commits with the ticket ID"
+}
+
+// Usage returns the usage string for the command
+func (c *HooksCommand) Usage() string {
+	return "hooks <install|uninstall> [options]"
+}
+
+// SetupFlags configures the flag set for this command
+func (c *HooksCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	// No flags for the parent command. Flags after the subcommand name are
+	// left unparsed, so the subcommand can parse its own flags.
+	fs.SetInterspersed(false)
+	return nil
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *HooksCommand) Validate(flags interface{}, args []string) error {
+	// Subcommands handle their own validation
+	return nil
+}
+
+// Execute runs the command with the given context
+func (c *HooksCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(args) == 0 {
+		c.printUsage()
+		return nil
+	}
+
+	subcmdName := args[0]
+	subcmd, ok := c.subcommands[subcmdName]
+	if !ok {
+		c.printUsage()
+		return fmt.Errorf(errUnknownHooksSubcommand, subcmdName)
+	}
+
+	// Parse flags for the subcommand
+	fs := flag.NewFlagSet(fmt.Sprintf("hooks %s", subcmdName), flag.ContinueOnError)
+	subcmdFlags := subcmd.SetupFlags(fs)
+
+	// Parse remaining arguments
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	// Validate the subcommand
+	if err := subcmd.Validate(subcmdFlags, fs.Args()); err != nil {
+		return err
+	}
+
+	// Execute the subcommand
+	return subcmd.Execute(ctx, subcmdFlags, fs.Args())
+}
+
+// printUsage prints the usage information for the hooks command
+func (c *HooksCommand) printUsage() {
+	fmt.Println(`TicketFlow Git Hooks
+
+USAGE:
+  ticketflow hooks install [--validate]   Install the prepare-commit-msg and commit-msg hooks
+  ticketflow hooks uninstall              Remove the hooks and restore the previous ones
+
+DESCRIPTION:
+  The hooks add a "Ticket: <id>" trailer to commits made for a ticket. The
+  ticket is taken from the branch name, or from current-ticket.md. Commits on
+  the default branch are left alone.
+
+  Hooks are installed where git looks for them, so core.hooksPath is
+  respected. A hook that is already present is kept as <hook>.pre-ticketflow
+  and run before ticketflow's.
+
+  With --validate, the commit-msg hook rejects commits for a ticket whose
+  message lacks the trailer instead of adding it.
+
+EXAMPLES:
+  # Tag every commit in ticket worktrees
+  ticketflow hooks install
+
+  # Also reject commits whose Ticket trailer was removed
+  ticketflow hooks install --validate`)
+}
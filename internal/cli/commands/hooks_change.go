@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// HooksChangeCommand implements the hooks install and hooks uninstall subcommands
+type HooksChangeCommand struct {
+	install bool
+}
+
+// NewHooksInstallCommand creates a new hooks install command
+func NewHooksInstallCommand() command.Command {
+	return &HooksChangeCommand{install: true}
+}
+
+// NewHooksUninstallCommand creates a new hooks uninstall command
+func NewHooksUninstallCommand() command.Command {
+	return &HooksChangeCommand{}
+}
+
+// Name returns the command name
+func (c *HooksChangeCommand) Name() string {
+	if c.install {
+		return "install"
+	}
+	return "uninstall"
+}
+
+// Aliases returns alternative names for this command
+func (c *HooksChangeCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *HooksChangeCommand) Description() string {
+	if c.install {
+		return "Install git hooks that add a Ticket trailer to commits"
+	}
+	return "Remove the git hooks installed by ticketflow"
+}
+
+// Usage returns the usage string for the command
+func (c *HooksChangeCommand) Usage() string {
+	if c.install {
+		return "hooks install [--validate] [--format text|json]"
+	}
+	return "hooks uninstall [--format text|json]"
+}
+
+// hooksChangeFlags holds the flags for the hooks install/uninstall commands
+type hooksChangeFlags struct {
+	validate bool
+	format   string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *HooksChangeCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &hooksChangeFlags{}
+	if c.install {
+		fs.BoolVar(&flags.validate, "validate", false, "Reject commits for a ticket without a Ticket trailer")
+	}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *HooksChangeCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("hooks %s takes no arguments, got %v", c.Name(), args)
+	}
+
+	f, err := AssertFlags[hooksChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *HooksChangeCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[hooksChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var result *cli.HooksResult
+	if c.install {
+		result, err = app.InstallHooks(ctx, f.validate)
+	} else {
+		result, err = app.UninstallHooks(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
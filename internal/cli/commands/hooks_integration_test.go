@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestHooksCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-add-feature"
+
+	setup := func(t *testing.T) *testharness.TestEnvironment {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+		return env
+	}
+
+	run := func(t *testing.T, args ...string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return NewHooksCommand().Execute(ctx, nil, args)
+	}
+
+	// runHook runs a hook on a commit message file and returns the updated message
+	runHook := func(t *testing.T, env *testharness.TestEnvironment, msg string, args ...string) (string, error) {
+		env.WriteFile("COMMIT_EDITMSG", msg)
+		path := filepath.Join(env.RootDir, "COMMIT_EDITMSG")
+		err := run(t, append(append([]string{"run"}, args...), "--", path)...)
+		data, readErr := os.ReadFile(path)
+		require.NoError(t, readErr)
+		return string(data), err
+	}
+
+	t.Run("install chains an existing hook and uninstall restores it", func(t *testing.T) {
+		env := setup(t)
+		hooksDir := filepath.Join(env.RootDir, ".git", "hooks")
+		previous := "#!/bin/sh\necho previous\n"
+		require.NoError(t, os.WriteFile(filepath.Join(hooksDir, "commit-msg"), []byte(previous), 0755))
+
+		require.NoError(t, run(t, "install", "--validate"))
+
+		for _, name := range []string{"prepare-commit-msg", "commit-msg"} {
+			info, err := os.Stat(filepath.Join(hooksDir, name))
+			require.NoError(t, err)
+			assert.NotZero(t, info.Mode()&0100, "%s should be executable", name)
+		}
+		data, err := os.ReadFile(filepath.Join(hooksDir, "commit-msg"))
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "ticketflow hooks run commit-msg --validate")
+		chained, err := os.ReadFile(filepath.Join(hooksDir, "commit-msg.pre-ticketflow"))
+		require.NoError(t, err)
+		assert.Equal(t, previous, string(chained))
+
+		// Installing again keeps the chained hook
+		require.NoError(t, run(t, "install"))
+		assert.FileExists(t, filepath.Join(hooksDir, "commit-msg.pre-ticketflow"))
+
+		require.NoError(t, run(t, "uninstall"))
+		assert.NoFileExists(t, filepath.Join(hooksDir, "prepare-commit-msg"))
+		assert.NoFileExists(t, filepath.Join(hooksDir, "commit-msg.pre-ticketflow"))
+		data, err = os.ReadFile(filepath.Join(hooksDir, "commit-msg"))
+		require.NoError(t, err)
+		assert.Equal(t, previous, string(data))
+	})
+
+	t.Run("install respects core.hooksPath", func(t *testing.T) {
+		env := setup(t)
+		env.RunGit("config", "core.hooksPath", ".githooks")
+
+		require.NoError(t, run(t, "install"))
+		assert.FileExists(t, filepath.Join(env.RootDir, ".githooks", "commit-msg"))
+		assert.FileExists(t, filepath.Join(env.RootDir, ".githooks", "prepare-commit-msg"))
+		assert.NoFileExists(t, filepath.Join(env.RootDir, ".git", "hooks", "commit-msg"))
+	})
+
+	t.Run("uninstall leaves foreign hooks alone", func(t *testing.T) {
+		env := setup(t)
+		foreign := filepath.Join(env.RootDir, ".git", "hooks", "commit-msg")
+		require.NoError(t, os.WriteFile(foreign, []byte("#!/bin/sh\n"), 0755))
+
+		require.NoError(t, run(t, "uninstall"))
+		assert.FileExists(t, foreign)
+	})
+
+	t.Run("adds the trailer on a ticket branch", func(t *testing.T) {
+		env := setup(t)
+		env.CreateTicket(ticketID, ticket.StatusDoing)
+		env.RunGit("checkout", "-b", ticketID)
+
+		msg, err := runHook(t, env, "Implement feature\n", "prepare-commit-msg")
+		require.NoError(t, err)
+		assert.Equal(t, "Implement feature\n\nTicket: "+ticketID+"\n", msg)
+
+		// commit-msg does not add a second trailer
+		msg, err = runHook(t, env, msg, "commit-msg", "--validate")
+		require.NoError(t, err)
+		assert.Equal(t, "Implement feature\n\nTicket: "+ticketID+"\n", msg)
+	})
+
+	t.Run("validation rejects a message without the trailer", func(t *testing.T) {
+		env := setup(t)
+		env.CreateTicket(ticketID, ticket.StatusDoing)
+		env.RunGit("checkout", "-b", ticketID)
+
+		msg, err := runHook(t, env, "Implement feature\n", "commit-msg", "--validate")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Missing Ticket trailer")
+		assert.Equal(t, "Implement feature\n", msg)
+
+		// Without validation the trailer is added instead
+		msg, err = runHook(t, env, "Implement feature\n", "commit-msg")
+		require.NoError(t, err)
+		assert.Equal(t, "Implement feature\n\nTicket: "+ticketID+"\n", msg)
+	})
+
+	t.Run("leaves commits on the default branch alone", func(t *testing.T) {
+		env := setup(t)
+
+		msg, err := runHook(t, env, "Update docs\n", "commit-msg", "--validate")
+		require.NoError(t, err)
+		assert.Equal(t, "Update docs\n", msg)
+	})
+}
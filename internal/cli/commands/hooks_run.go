@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+// HooksRunCommand implements the hooks run subcommand, which the installed
+// hook scripts call
+type HooksRunCommand struct{}
+
+// NewHooksRunCommand creates a new hooks run command
+func NewHooksRunCommand() command.Command {
+	return &HooksRunCommand{}
+}
+
+// Name returns the command name
+func (c *HooksRunCommand) Name() string {
+	return "run"
+}
+
+// Aliases returns alternative names for this command
+func (c *HooksRunCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *HooksRunCommand) Description() string {
+	return "Run a ticketflow git hook (called by the installed hooks)"
+}
+
+// Usage returns the usage string for the command
+func (c *HooksRunCommand) Usage() string {
+	return "hooks run <prepare-commit-msg|commit-msg> [--validate] -- <hook-args>..."
+}
+
+// hooksRunFlags holds the flags for the hooks run command
+type hooksRunFlags struct {
+	validate bool
+}
+
+// SetupFlags configures the flag set for this command
+func (c *HooksRunCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &hooksRunFlags{}
+	fs.BoolVar(&flags.validate, "validate", false, "Reject commit messages without a Ticket trailer")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *HooksRunCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing hook name argument")
+	}
+
+	_, err := AssertFlags[hooksRunFlags](flags)
+	return err
+}
+
+// Execute runs the command with the given context
+func (c *HooksRunCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[hooksRunFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	app, err := cli.NewApp(ctx)
+	if err != nil {
+		// Never block a commit because the repository is not set up for ticketflow
+		log.Global().WithError(err).Debug("skipping hook outside a ticketflow project", "hook", args[0])
+		return nil
+	}
+
+	return app.RunHook(ctx, args[0], args[1:], f.validate)
+}
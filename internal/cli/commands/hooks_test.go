@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHooksCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewHooksCommand()
+
+	assert.Equal(t, "hooks", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Install or uninstall git hooks that tag commits with the ticket ID", cmd.Description())
+	assert.Equal(t, "hooks <install|uninstall> [options]", cmd.Usage())
+}
+
+func TestHooksCommand_Execute(t *testing.T) {
+	t.Parallel()
+	cmd := NewHooksCommand()
+
+	// No subcommand shows usage
+	err := cmd.Execute(context.Background(), nil, []string{})
+	assert.NoError(t, err)
+
+	err = cmd.Execute(context.Background(), nil, []string{"enable"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown hooks subcommand")
+}
+
+func TestHooksChangeCommand_Interface(t *testing.T) {
+	t.Parallel()
+	install := NewHooksInstallCommand()
+	assert.Equal(t, "install", install.Name())
+	assert.Equal(t, "Install git hooks that add a Ticket trailer to commits", install.Description())
+	assert.Equal(t, "hooks install [--validate] [--format text|json]", install.Usage())
+
+	uninstall := NewHooksUninstallCommand()
+	assert.Equal(t, "uninstall", uninstall.Name())
+	assert.Equal(t, "Remove the git hooks installed by ticketflow", uninstall.Description())
+	assert.Equal(t, "hooks uninstall [--format text|json]", uninstall.Usage())
+}
+
+func TestHooksChangeCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := NewHooksInstallCommand().SetupFlags(fs).(*hooksChangeFlags)
+	assert.False(t, flags.validate)
+	assert.Equal(t, FormatText, flags.format)
+	require.NoError(t, fs.Parse([]string{"--validate", "-o", "json"}))
+	assert.True(t, flags.validate)
+	assert.Equal(t, FormatJSON, flags.format)
+
+	// uninstall has nothing to validate
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	NewHooksUninstallCommand().SetupFlags(fs)
+	assert.Error(t, fs.Parse([]string{"--validate"}))
+}
+
+func TestHooksChangeCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *hooksChangeFlags
+		args        []string
+		errContains string
+	}{
+		{name: "defaults", flags: &hooksChangeFlags{format: FormatText}},
+		{name: "validate json", flags: &hooksChangeFlags{validate: true, format: FormatJSON}},
+		{name: "unexpected args", flags: &hooksChangeFlags{format: FormatText}, args: []string{"commit-msg"}, errContains: "takes no arguments"},
+		{name: "invalid format", flags: &hooksChangeFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewHooksInstallCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHooksRunCommand_Validate(t *testing.T) {
+	t.Parallel()
+	cmd := NewHooksRunCommand()
+	assert.Equal(t, "hooks run <prepare-commit-msg|commit-msg> [--validate] -- <hook-args>...", cmd.Usage())
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*hooksRunFlags)
+	require.NoError(t, fs.Parse([]string{"commit-msg", "--validate", "--", ".git/COMMIT_EDITMSG"}))
+	assert.True(t, flags.validate)
+	assert.Equal(t, []string{"commit-msg", ".git/COMMIT_EDITMSG"}, fs.Args())
+
+	assert.NoError(t, cmd.Validate(flags, fs.Args()))
+	assert.ErrorContains(t, cmd.Validate(flags, nil), "missing hook name")
+}
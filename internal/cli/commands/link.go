@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// LinkCommand implements the link and unlink commands using the new Command interface
+type LinkCommand struct {
+	unlink bool
+}
+
+// NewLinkCommand creates a new link command
+func NewLinkCommand() command.Command {
+	return &LinkCommand{}
+}
+
+// NewUnlinkCommand creates a new unlink command
+func NewUnlinkCommand() command.Command {
+	return &LinkCommand{unlink: true}
+}
+
+// Name returns the command name
+func (c *LinkCommand) Name() string {
+	if c.unlink {
+		return "unlink"
+	}
+	return "link"
+}
+
+// Aliases returns alternative names for this command
+func (c *LinkCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *LinkCommand) Description() string {
+	if c.unlink {
+		return "Remove a relation between two tickets"
+	}
+	return "Add a relation between two tickets"
+}
+
+// Usage returns the usage string for the command
+func (c *LinkCommand) Usage() string {
+	return c.Name() + " <ticket-id> (--blocks|--blocked-by|--duplicates|--relates) <other-id> [--format text|json]"
+}
+
+// linkFlags holds the flags for the link and unlink commands
+type linkFlags struct {
+	blocks     string
+	blockedBy  string
+	duplicates string
+	relates    string
+	format     string
+}
+
+// relation returns the selected relation type and target ticket ID
+func (f *linkFlags) relation() (ticket.RelationType, string, error) {
+	var relType ticket.RelationType
+	var target string
+	selected := 0
+	for _, candidate := range []struct {
+		relType ticket.RelationType
+		target  string
+	}{
+		{ticket.RelationBlocks, f.blocks},
+		{ticket.RelationBlockedBy, f.blockedBy},
+		{ticket.RelationDuplicates, f.duplicates},
+		{ticket.RelationRelates, f.relates},
+	} {
+		if candidate.target != "" {
+			relType = candidate.relType
+			target = candidate.target
+			selected++
+		}
+	}
+
+	switch selected {
+	case 0:
+		return "", "", fmt.Errorf("missing relation: specify one of --blocks, --blocked-by, --duplicates, or --relates")
+	case 1:
+		return relType, strings.TrimSpace(target), nil
+	default:
+		return "", "", fmt.Errorf("only one relation flag can be specified at a time")
+	}
+}
+
+// SetupFlags configures flags for the command
+func (c *LinkCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &linkFlags{}
+	fs.StringVar(&flags.blocks, "blocks", "", "Ticket that this ticket blocks")
+	fs.StringVar(&flags.blockedBy, "blocked-by", "", "Ticket that blocks this ticket")
+	fs.StringVar(&flags.duplicates, "duplicates", "", "Ticket that this ticket duplicates")
+	fs.StringVar(&flags.relates, "relates", "", "Ticket that this ticket relates to")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *LinkCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[linkFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := f.relation(); err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the link or unlink command
+func (c *LinkCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[linkFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	relType, target, err := f.relation()
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var result *cli.LinkResult
+	if c.unlink {
+		result, err = app.UnlinkTickets(ctx, args[0], relType, target)
+	} else {
+		result, err = app.LinkTickets(ctx, args[0], relType, target)
+	}
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
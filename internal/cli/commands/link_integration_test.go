@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestLinkCommand_Execute_Integration(t *testing.T) {
+	const (
+		sourceID = "250101-120000-source"
+		targetID = "250101-130000-target"
+	)
+
+	readTicket := func(t *testing.T, env *testharness.TestEnvironment, status, id string) *ticket.Ticket {
+		parsed, err := ticket.Parse([]byte(env.ReadFile(env.TicketPath(status, id+".md"))))
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		name          string
+		setup         func(*testharness.TestEnvironment)
+		unlink        bool
+		flags         *linkFlags
+		args          []string
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name: "link maintains inverse relation",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket(sourceID, ticket.StatusTodo)
+				env.CreateTicket(targetID, ticket.StatusDoing)
+			},
+			flags: &linkFlags{blocks: targetID, format: FormatText},
+			args:  []string{sourceID},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.Equal(t, []string{"blocks:" + targetID}, readTicket(t, env, "todo", sourceID).Related)
+				assert.Equal(t, []string{"blocked_by:" + sourceID}, readTicket(t, env, "doing", targetID).Related)
+			},
+		},
+		{
+			name: "link resolves target by prefix",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket(sourceID, ticket.StatusTodo)
+				env.CreateTicket(targetID, ticket.StatusTodo)
+			},
+			flags: &linkFlags{relates: "250101-130000", format: FormatJSON},
+			args:  []string{sourceID},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.Equal(t, []string{"relates:" + targetID}, readTicket(t, env, "todo", sourceID).Related)
+				assert.Equal(t, []string{"relates:" + sourceID}, readTicket(t, env, "todo", targetID).Related)
+			},
+		},
+		{
+			name: "unlink removes both sides",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket(sourceID, ticket.StatusTodo,
+					testharness.WithParent("250101-110000-parent"),
+					func(t *ticket.Ticket) { t.Related = append(t.Related, "duplicates:"+targetID) })
+				env.CreateTicket(targetID, ticket.StatusDone,
+					func(t *ticket.Ticket) { t.Related = append(t.Related, "duplicated_by:"+sourceID) })
+			},
+			unlink: true,
+			flags:  &linkFlags{duplicates: targetID, format: FormatText},
+			args:   []string{sourceID},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.Equal(t, []string{"parent:250101-110000-parent"}, readTicket(t, env, "todo", sourceID).Related)
+				assert.Empty(t, readTicket(t, env, "done", targetID).Related)
+			},
+		},
+		{
+			name: "error when target does not exist",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket(sourceID, ticket.StatusTodo)
+			},
+			flags:         &linkFlags{blocks: "250101-999999-missing", format: FormatText},
+			args:          []string{sourceID},
+			errorContains: "Related ticket not found",
+		},
+		{
+			name: "error when linking ticket to itself",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket(sourceID, ticket.StatusTodo)
+			},
+			flags:         &linkFlags{blocks: sourceID, format: FormatText},
+			args:          []string{sourceID},
+			errorContains: "Invalid relation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			if tt.setup != nil {
+				tt.setup(env)
+			}
+
+			cmd := NewLinkCommand()
+			if tt.unlink {
+				cmd = NewUnlinkCommand()
+			}
+			require.NoError(t, cmd.Validate(tt.flags, tt.args))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			err = cmd.Execute(ctx, tt.flags, tt.args)
+
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestLinkCommand_Interface(t *testing.T) {
+	t.Parallel()
+	link := NewLinkCommand()
+	assert.Equal(t, "link", link.Name())
+	assert.Nil(t, link.Aliases())
+	assert.Equal(t, "Add a relation between two tickets", link.Description())
+	assert.Equal(t, "link <ticket-id> (--blocks|--blocked-by|--duplicates|--relates) <other-id> [--format text|json]", link.Usage())
+
+	unlink := NewUnlinkCommand()
+	assert.Equal(t, "unlink", unlink.Name())
+	assert.Equal(t, "Remove a relation between two tickets", unlink.Description())
+	assert.Equal(t, "unlink <ticket-id> (--blocks|--blocked-by|--duplicates|--relates) <other-id> [--format text|json]", unlink.Usage())
+}
+
+func TestLinkCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewLinkCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*linkFlags)
+
+	require.NoError(t, fs.Parse([]string{"ticket-1", "--blocked-by", "ticket-2", "-o", "json"}))
+	assert.Equal(t, []string{"ticket-1"}, fs.Args())
+	assert.Equal(t, "ticket-2", flags.blockedBy)
+	assert.Equal(t, FormatJSON, flags.format)
+
+	relType, target, err := flags.relation()
+	require.NoError(t, err)
+	assert.Equal(t, ticket.RelationBlockedBy, relType)
+	assert.Equal(t, "ticket-2", target)
+}
+
+func TestLinkCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *linkFlags
+		args        []string
+		errContains string
+	}{
+		{
+			name:  "valid blocks",
+			flags: &linkFlags{blocks: "ticket-2", format: FormatText},
+			args:  []string{"ticket-1"},
+		},
+		{
+			name:  "valid relates",
+			flags: &linkFlags{relates: "ticket-2", format: FormatJSON},
+			args:  []string{"ticket-1"},
+		},
+		{
+			name:        "missing ticket ID",
+			flags:       &linkFlags{blocks: "ticket-2", format: FormatText},
+			args:        []string{},
+			errContains: "missing ticket ID argument",
+		},
+		{
+			name:        "extra arguments",
+			flags:       &linkFlags{blocks: "ticket-2", format: FormatText},
+			args:        []string{"ticket-1", "ticket-3"},
+			errContains: "unexpected arguments",
+		},
+		{
+			name:        "no relation flag",
+			flags:       &linkFlags{format: FormatText},
+			args:        []string{"ticket-1"},
+			errContains: "missing relation",
+		},
+		{
+			name:        "multiple relation flags",
+			flags:       &linkFlags{blocks: "ticket-2", duplicates: "ticket-3", format: FormatText},
+			args:        []string{"ticket-1"},
+			errContains: "only one relation flag",
+		},
+		{
+			name:        "invalid format",
+			flags:       &linkFlags{blocks: "ticket-2", format: "xml"},
+			args:        []string{"ticket-1"},
+			errContains: "invalid format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewLinkCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
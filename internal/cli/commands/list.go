@@ -7,6 +7,7 @@ import (
 
 	"github.com/yshrsmz/ticketflow/internal/cli"
 	"github.com/yshrsmz/ticketflow/internal/command"
+	"github.com/yshrsmz/ticketflow/internal/config"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 )
 
@@ -38,7 +39,7 @@ func (c *ListCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *ListCommand) Usage() string {
-	return "list [--status todo|doing|done|all] [--count N] [--format text|json]"
+	return "list [--view NAME] [--status STATE|all] [--tag TAG]... [--filter EXPR] [--sort FIELDS] [--include-archived] [--tree] [--offset N] [--count N] [--format FORMAT]"
 }
 
 // listFlags holds the flags for the list command
@@ -47,17 +48,36 @@ type listFlags struct {
 	statusShort string
 	count       int
 	countShort  int
+	tags        []string
+	archived    bool
+	filter      string
+	sort        string
+	offset      int
+	view        string
+	tree        bool
 	format      string
+
+	// countChanged reports whether --count or -c was given on the command line
+	countChanged func() bool
 }
 
 // SetupFlags configures flags for the command
 func (c *ListCommand) SetupFlags(fs *flag.FlagSet) interface{} {
-	flags := &listFlags{}
-	fs.StringVar(&flags.status, "status", "", "Filter by status (todo|doing|done|all)")
-	fs.StringVar(&flags.statusShort, "s", "", "Filter by status (todo|doing|done|all)")
+	flags := &listFlags{
+		countChanged: func() bool { return fs.Changed("count") || fs.Changed("c") },
+	}
+	fs.StringVar(&flags.status, "status", "", "Filter by workflow state (todo|doing|done|<custom>|all)")
+	fs.StringVar(&flags.statusShort, "s", "", "Filter by workflow state (todo|doing|done|<custom>|all)")
 	fs.IntVar(&flags.count, "count", defaultCount, "Number of tickets to show")
 	fs.IntVar(&flags.countShort, "c", defaultCount, "Number of tickets to show")
-	fs.StringVar(&flags.format, "format", FormatText, "Output format (text|json)")
+	fs.StringSliceVar(&flags.tags, "tag", nil, "Filter by tag (repeatable; tickets must have all tags)")
+	fs.BoolVar(&flags.archived, "include-archived", false, "Also list archived tickets (implies --status all unless --status done)")
+	fs.StringVar(&flags.filter, "filter", "", "Filter expression, e.g. 'priority<=1 and slug~auth'")
+	fs.StringVar(&flags.sort, "sort", "", "Sort by comma-separated fields, '-' prefix for descending (e.g. priority,-created)")
+	fs.IntVar(&flags.offset, "offset", 0, "Number of tickets to skip")
+	fs.StringVar(&flags.view, "view", "", "Show a saved view from the configuration (other flags refine it)")
+	fs.BoolVar(&flags.tree, "tree", false, "Show tickets as a parent/sub-ticket tree (--offset and --count apply to top-level tickets)")
+	fs.StringVar(&flags.format, "format", FormatText, "Output format ("+extendedFormatsHelp+")")
 	return flags
 }
 
@@ -75,7 +95,7 @@ func (c *ListCommand) Validate(flags interface{}, args []string) error {
 	}
 
 	// Validate format flag
-	if err := ValidateFormat(f.format); err != nil {
+	if err := ValidateExtendedFormat(f.format); err != nil {
 		return err
 	}
 
@@ -84,9 +104,29 @@ func (c *ListCommand) Validate(flags interface{}, args []string) error {
 		return fmt.Errorf("count must be non-negative, got %d", f.count)
 	}
 
+	// Validate offset flag
+	if f.offset < 0 {
+		return fmt.Errorf("offset must be non-negative, got %d", f.offset)
+	}
+
 	// Validate status flag if provided
 	if f.status != "" && !isValidListStatus(f.status) {
-		return fmt.Errorf("invalid status: %q (must be 'todo', 'doing', 'done', or 'all')", f.status)
+		return fmt.Errorf("invalid status: %q (must be a workflow state such as 'todo', 'doing', 'done', or 'all')", f.status)
+	}
+
+	// Validate view name if provided; whether it is defined is checked once the config is loaded
+	if f.view != "" && !config.IsValidViewName(f.view) {
+		return fmt.Errorf("invalid view name: %q", f.view)
+	}
+
+	// Archived tickets are done tickets, so other states can never match them.
+	// A view decides its own states, so it is checked once the view is loaded.
+	if f.archived && f.view == "" {
+		switch f.status {
+		case "", cli.StatusAll, string(ticket.StatusDone):
+		default:
+			return fmt.Errorf("--include-archived can only be combined with --status done or --status all")
+		}
 	}
 
 	return nil
@@ -118,18 +158,40 @@ func (c *ListCommand) Execute(ctx context.Context, flags interface{}, args []str
 	var ticketStatus ticket.Status
 	if f.status != "" {
 		ticketStatus = ticket.Status(f.status)
+	} else if f.archived && f.view == "" {
+		// Without a state, --include-archived lists every ticket
+		ticketStatus = cli.StatusAll
+	}
+
+	// A view supplies its own count unless one is given explicitly
+	count := f.count
+	if f.view != "" && (f.countChanged == nil || !f.countChanged()) {
+		count = 0
 	}
 
-	// Delegate to App's ListTickets method
-	return app.ListTickets(ctx, ticketStatus, f.count, outputFormat)
+	// Delegate to App's ListTicketsWithOptions method
+	return app.ListTicketsWithOptions(ctx, cli.ListOptions{
+		Status:          ticketStatus,
+		Count:           count,
+		Tags:            f.tags,
+		IncludeArchived: f.archived,
+		Filter:          f.filter,
+		Sort:            f.sort,
+		Offset:          f.offset,
+		View:            f.view,
+		Tree:            f.tree,
+	})
 }
 
-// isValidListStatus checks if the status is valid for list command
+// isValidListStatus checks if the status is valid for list command.
+// Whether a state is actually configured is checked once the config is loaded.
 func isValidListStatus(status string) bool {
 	switch status {
-	case "", string(ticket.StatusTodo), string(ticket.StatusDoing), string(ticket.StatusDone), cli.StatusAll:
+	case "", cli.StatusAll:
 		return true
-	default:
+	case string(ticket.StatusFilterActive):
 		return false
+	default:
+		return config.IsValidStateName(status)
 	}
 }
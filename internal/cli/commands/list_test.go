@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/yshrsmz/ticketflow/internal/cli"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 )
@@ -19,7 +20,7 @@ func TestListCommand_Interface(t *testing.T) {
 	assert.Equal(t, "list", cmd.Name())
 	assert.Equal(t, []string{"ls"}, cmd.Aliases())
 	assert.Equal(t, "List tickets", cmd.Description())
-	assert.Equal(t, "list [--status todo|doing|done|all] [--count N] [--format text|json]", cmd.Usage())
+	assert.Equal(t, "list [--view NAME] [--status STATE|all] [--tag TAG]... [--filter EXPR] [--sort FIELDS] [--include-archived] [--tree] [--offset N] [--count N] [--format FORMAT]", cmd.Usage())
 }
 
 func TestListCommand_SetupFlags(t *testing.T) {
@@ -165,10 +166,10 @@ func TestListCommand_Validate(t *testing.T) {
 		},
 		{
 			name:      "invalid status",
-			flags:     &listFlags{status: "invalid", statusShort: "", count: 20, countShort: 20, format: FormatText},
+			flags:     &listFlags{status: "In Review", statusShort: "", count: 20, countShort: 20, format: FormatText},
 			args:      []string{},
 			wantError: true,
-			errorMsg:  `invalid status: "invalid" (must be 'todo', 'doing', 'done', or 'all')`,
+			errorMsg:  `invalid status: "In Review" (must be a workflow state such as 'todo', 'doing', 'done', or 'all')`,
 		},
 		{
 			name:      "negative count",
@@ -188,7 +189,7 @@ func TestListCommand_Validate(t *testing.T) {
 			flags:     &listFlags{status: "", statusShort: "", count: 20, countShort: 20, format: "xml"},
 			args:      []string{},
 			wantError: true,
-			errorMsg:  `invalid format: "xml" (must be "text" or "json")`,
+			errorMsg:  `invalid format: "xml" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`,
 		},
 		{
 			name:      "json format",
@@ -214,6 +215,57 @@ func TestListCommand_Validate(t *testing.T) {
 			args:      []string{},
 			wantError: false,
 		},
+		{
+			name:      "include archived without status",
+			flags:     &listFlags{status: "", statusShort: "", count: 20, countShort: 20, archived: true, format: FormatText},
+			args:      []string{},
+			wantError: false,
+		},
+		{
+			name:      "include archived with done status",
+			flags:     &listFlags{status: "done", statusShort: "", count: 20, countShort: 20, archived: true, format: FormatText},
+			args:      []string{},
+			wantError: false,
+		},
+		{
+			name:      "include archived with todo status",
+			flags:     &listFlags{status: "todo", statusShort: "", count: 20, countShort: 20, archived: true, format: FormatText},
+			args:      []string{},
+			wantError: true,
+			errorMsg:  "--include-archived can only be combined with --status done or --status all",
+		},
+		{
+			name:      "negative offset",
+			flags:     &listFlags{status: "", statusShort: "", count: 20, countShort: 20, offset: -1, format: FormatText},
+			args:      []string{},
+			wantError: true,
+			errorMsg:  "offset must be non-negative, got -1",
+		},
+		{
+			name:      "filter, sort and offset",
+			flags:     &listFlags{status: "all", statusShort: "", count: 20, countShort: 20, filter: "priority<=1", sort: "-created", offset: 5, format: FormatText},
+			args:      []string{},
+			wantError: false,
+		},
+		{
+			name:      "view",
+			flags:     &listFlags{status: "", statusShort: "", count: 20, countShort: 20, view: "mine-urgent", format: FormatText},
+			args:      []string{},
+			wantError: false,
+		},
+		{
+			name:      "invalid view name",
+			flags:     &listFlags{status: "", statusShort: "", count: 20, countShort: 20, view: "Mine Urgent", format: FormatText},
+			args:      []string{},
+			wantError: true,
+			errorMsg:  `invalid view name: "Mine Urgent"`,
+		},
+		{
+			name:      "include archived with a view leaves the status to the view",
+			flags:     &listFlags{status: "todo", statusShort: "", count: 20, countShort: 20, archived: true, view: "closed", format: FormatText},
+			args:      []string{},
+			wantError: false,
+		},
 		{
 			name:      "short count flag takes precedence",
 			flags:     &listFlags{status: "", statusShort: "", count: 30, countShort: 5, format: FormatText},
@@ -237,6 +289,31 @@ func TestListCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestListCommand_Validate_LeavesStatusUnchanged(t *testing.T) {
+	flags := &listFlags{count: 20, countShort: 20, archived: true, format: FormatText}
+	require.NoError(t, (&ListCommand{}).Validate(flags, nil))
+	assert.Empty(t, flags.status)
+}
+
+func TestListCommand_SetupFlags_Query(t *testing.T) {
+	cmd := &ListCommand{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	lf := cmd.SetupFlags(fs).(*listFlags)
+
+	assert.Empty(t, lf.filter)
+	assert.Empty(t, lf.sort)
+	assert.Equal(t, 0, lf.offset)
+	assert.Empty(t, lf.view)
+	assert.False(t, lf.tree)
+
+	require.NoError(t, fs.Parse([]string{"--filter", "slug~auth and has:worktree", "--sort", "priority,-created", "--offset", "10", "--view", "mine", "--tree"}))
+	assert.Equal(t, "mine", lf.view)
+	assert.True(t, lf.tree)
+	assert.Equal(t, "slug~auth and has:worktree", lf.filter)
+	assert.Equal(t, "priority,-created", lf.sort)
+	assert.Equal(t, 10, lf.offset)
+}
+
 func TestListCommand_Execute(t *testing.T) {
 	// Integration test that verifies the command works with real App
 	// This test will succeed in the actual ticketflow environment
@@ -287,7 +364,10 @@ func TestIsValidListStatus(t *testing.T) {
 		{"all", true},
 		{"", true},
 		{"active", false},
-		{"invalid", false},
+		{"review", true},
+		{"in-review", true},
+		{"In Review", false},
+		{"1st", false},
 		{string(ticket.StatusTodo), true},
 		{string(ticket.StatusDoing), true},
 		{string(ticket.StatusDone), true},
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// LogCommand implements the log command
+type LogCommand struct{}
+
+// NewLogCommand creates a new log command
+func NewLogCommand() command.Command {
+	return &LogCommand{}
+}
+
+// Name returns the command name
+func (c *LogCommand) Name() string {
+	return "log"
+}
+
+// Aliases returns alternative names for this command
+func (c *LogCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *LogCommand) Description() string {
+	return "Show the git history of a ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *LogCommand) Usage() string {
+	return "log [--format text|json] <ticket-id>"
+}
+
+// logFlags holds the flags for the log command
+type logFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *LogCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &logFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *LogCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[logFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *LogCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[logFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.TicketLog(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
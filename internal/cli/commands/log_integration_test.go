@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestLogCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-log-me"
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.CreateTicket(ticketID, ticket.StatusTodo)
+	env.RunGit("add", ".")
+	env.RunGit("commit", "-m", "Add ticket")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	require.NoError(t, NewStartCommand().Execute(ctx, &startFlags{format: FormatText}, []string{ticketID}))
+	worktreePath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+	env.RunGit("-C", worktreePath, "commit", "--allow-empty", "-m", "Work on ticket")
+
+	for _, format := range []string{FormatText, FormatJSON} {
+		flags := &logFlags{format: format}
+		require.NoError(t, NewLogCommand().Validate(flags, []string{ticketID}))
+		require.NoError(t, NewLogCommand().Execute(ctx, flags, []string{ticketID}))
+	}
+
+	app, err := cli.NewApp(ctx)
+	require.NoError(t, err)
+	result, err := app.TicketLog(ctx, ticketID)
+	require.NoError(t, err)
+
+	commits := make(map[string]git.CommitInfo)
+	for _, c := range result.Commits {
+		commits[c.Subject] = c
+	}
+	require.Contains(t, commits, "Add ticket")
+	require.Contains(t, commits, "Start ticket: "+ticketID)
+	require.Contains(t, commits, "Work on ticket")
+
+	assert.True(t, commits["Add ticket"].TouchesFile, "rename to doing is followed")
+	assert.True(t, commits["Add ticket"].Merged)
+	assert.True(t, commits["Start ticket: "+ticketID].TouchesFile)
+	assert.True(t, commits["Work on ticket"].OnBranch)
+	assert.False(t, commits["Work on ticket"].Merged)
+	assert.Equal(t, "main", result.DefaultBranch)
+
+	_, err = app.TicketLog(ctx, "no-such-ticket")
+	assert.Error(t, err)
+}
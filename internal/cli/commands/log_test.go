@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewLogCommand()
+
+	assert.Equal(t, "log", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Show the git history of a ticket", cmd.Description())
+	assert.Equal(t, "log [--format text|json] <ticket-id>", cmd.Usage())
+}
+
+func TestLogCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewLogCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*logFlags)
+
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestLogCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *logFlags
+		args        []string
+		errContains string
+	}{
+		{name: "ticket only", flags: &logFlags{format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "json format", flags: &logFlags{format: FormatJSON}, args: []string{"250101-120000-test"}},
+		{name: "missing ticket", flags: &logFlags{format: FormatText}, errContains: "missing ticket ID"},
+		{name: "extra args", flags: &logFlags{format: FormatText}, args: []string{"250101-120000-test", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &logFlags{format: "xml"}, args: []string{"250101-120000-test"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewLogCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
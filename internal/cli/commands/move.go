@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// MoveCommand implements the move command using the new Command interface
+type MoveCommand struct{}
+
+// NewMoveCommand creates a new move command
+func NewMoveCommand() command.Command {
+	return &MoveCommand{}
+}
+
+// Name returns the command name
+func (c *MoveCommand) Name() string {
+	return "move"
+}
+
+// Aliases returns alternative names for this command
+func (c *MoveCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *MoveCommand) Description() string {
+	return "Move a ticket to another workflow state"
+}
+
+// Usage returns the usage string for the command
+func (c *MoveCommand) Usage() string {
+	return "move [--format text|json] <ticket-id> <state>"
+}
+
+// moveFlags holds the flags for the move command
+type moveFlags struct {
+	format string
+}
+
+// SetupFlags configures flags for the command
+func (c *MoveCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &moveFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *MoveCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("missing state argument")
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("unexpected arguments after state: %v", args[2:])
+	}
+
+	f, err := AssertFlags[moveFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the move command
+func (c *MoveCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[moveFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.MoveTicket(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestMoveCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-move-me"
+
+	reviewStates := []config.StateConfig{
+		{Name: "todo", Dir: "todo", Active: true, Transitions: []string{"doing", "blocked"}},
+		{Name: "doing", Dir: "doing", Active: true, Transitions: []string{"review", "blocked"}},
+		{Name: "review", Dir: "review", Active: true, Transitions: []string{"doing", "done"}},
+		{Name: "blocked", Dir: "blocked", Active: false, Transitions: []string{"todo", "doing"}},
+		{Name: "done", Dir: "done", Active: false, Transitions: []string{"todo"}},
+	}
+
+	readTicket := func(t *testing.T, env *testharness.TestEnvironment, state string) *ticket.Ticket {
+		parsed, err := ticket.Parse([]byte(env.ReadFile(env.TicketPath(state, ticketID+".md"))))
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		name          string
+		states        []config.StateConfig
+		status        ticket.Status
+		args          []string
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name:   "move doing ticket to custom review state",
+			states: reviewStates,
+			status: ticket.StatusDoing,
+			args:   []string{ticketID, "review"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.False(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+				moved := readTicket(t, env, "review")
+				assert.NotNil(t, moved.StartedAt.Time)
+				assert.Nil(t, moved.ClosedAt.Time)
+				assert.Equal(t, "Move ticket: "+ticketID+" (doing → review)", env.LastCommitMessage())
+
+				link, err := os.Readlink(filepath.Join(env.RootDir, "current-ticket.md"))
+				require.NoError(t, err)
+				assert.Equal(t, filepath.Join("tickets", "review", ticketID+".md"), link)
+			},
+		},
+		{
+			name:   "move todo ticket to done sets timestamps",
+			status: ticket.StatusTodo,
+			args:   []string{ticketID, "done"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				moved := readTicket(t, env, "done")
+				assert.NotNil(t, moved.StartedAt.Time)
+				assert.NotNil(t, moved.ClosedAt.Time)
+			},
+		},
+		{
+			name:   "move done ticket back to todo clears timestamps",
+			states: reviewStates,
+			status: ticket.StatusDone,
+			args:   []string{ticketID, "todo"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				moved := readTicket(t, env, "todo")
+				assert.Nil(t, moved.StartedAt.Time)
+				assert.Nil(t, moved.ClosedAt.Time)
+			},
+		},
+		{
+			name:          "transition not allowed",
+			states:        reviewStates,
+			status:        ticket.StatusTodo,
+			args:          []string{ticketID, "review"},
+			errorContains: "Transition not allowed",
+		},
+		{
+			name:          "transition not allowed in default workflow",
+			status:        ticket.StatusDone,
+			args:          []string{ticketID, "todo"},
+			errorContains: "Transition not allowed",
+		},
+		{
+			name:          "unknown state",
+			status:        ticket.StatusTodo,
+			args:          []string{ticketID, "review"},
+			errorContains: "Invalid state",
+		},
+		{
+			name:          "ticket not found",
+			args:          []string{"250101-999999-missing", "doing"},
+			errorContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			if tt.states != nil {
+				env.Config.Tickets.States = tt.states
+				require.NoError(t, env.Config.Save(env.ConfigPath))
+			}
+			if tt.status != "" {
+				env.CreateTicket(ticketID, tt.status)
+			}
+
+			cmd := NewMoveCommand()
+			flags := &moveFlags{format: FormatText}
+			require.NoError(t, cmd.Validate(flags, tt.args))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			err = cmd.Execute(ctx, flags, tt.args)
+
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewMoveCommand()
+
+	assert.Equal(t, "move", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Move a ticket to another workflow state", cmd.Description())
+	assert.Equal(t, "move [--format text|json] <ticket-id> <state>", cmd.Usage())
+}
+
+func TestMoveCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewMoveCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*moveFlags)
+
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestMoveCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *moveFlags
+		args        []string
+		errContains string
+	}{
+		{name: "ticket and state", flags: &moveFlags{format: FormatText}, args: []string{"250101-120000-test", "review"}},
+		{name: "json format", flags: &moveFlags{format: FormatJSON}, args: []string{"250101-120000-test", "done"}},
+		{name: "missing ticket", flags: &moveFlags{format: FormatText}, errContains: "missing ticket ID"},
+		{name: "missing state", flags: &moveFlags{format: FormatText}, args: []string{"250101-120000-test"}, errContains: "missing state"},
+		{name: "extra args", flags: &moveFlags{format: FormatText}, args: []string{"250101-120000-test", "review", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &moveFlags{format: "xml"}, args: []string{"250101-120000-test", "review"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewMoveCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
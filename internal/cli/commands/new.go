@@ -34,13 +34,15 @@ func (c *NewCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *NewCommand) Usage() string {
-	return "new [--parent <ticket-id>] [--format text|json] <slug>"
+	return "new [--parent <ticket-id>] [--tag <tag>]... [--template <name>] [--format text|json] <slug>"
 }
 
 // newFlags holds the flags for the new command
 type newFlags struct {
-	parent string
-	format string
+	parent   string
+	tags     []string
+	template string
+	format   string
 }
 
 // normalize is no longer needed - pflag handles this automatically
@@ -50,6 +52,8 @@ func (c *NewCommand) SetupFlags(fs *flag.FlagSet) interface{} {
 	flags := &newFlags{}
 	// Use pflag's StringVarP to register both long and short forms
 	fs.StringVarP(&flags.parent, "parent", "p", "", "Parent ticket ID")
+	fs.StringSliceVarP(&flags.tags, "tag", "t", nil, "Tag to add to the ticket (repeatable)")
+	fs.StringVar(&flags.template, "template", "", "Name of the ticket template to use")
 	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
 	return flags
 }
@@ -113,7 +117,11 @@ func (c *NewCommand) Execute(ctx context.Context, flags interface{}, args []stri
 	slug := args[0]
 
 	// Use the existing NewTicket method from App which handles all the business logic
-	ticket, err := app.NewTicket(ctx, slug, parent)
+	ticket, err := app.NewTicketWithOptions(ctx, slug, cli.NewTicketOptions{
+		Parent:   parent,
+		Tags:     f.tags,
+		Template: f.template,
+	})
 	if err != nil {
 		return err
 	}
@@ -93,6 +93,64 @@ func TestNewCommand_Execute_Integration(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "create ticket from template",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket("parent-ticket-003", ticket.StatusTodo)
+				env.WriteFile("tickets/.templates/bug.md",
+					"---\npriority: 1\ndescription: \"Bug: {{.Slug}}\"\n---\n\n# {{.ID}}\n\nParent: {{.ParentID}}\nReported on {{.Date}}\n")
+			},
+			args:  []string{"login-crash"},
+			flags: map[string]string{"format": "text", "parent": "parent-ticket-003", "template": "bug"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				files, err := os.ReadDir(env.RootDir + "/tickets/todo")
+				require.NoError(t, err)
+
+				var found bool
+				for _, file := range files {
+					if strings.Contains(file.Name(), "login-crash") {
+						found = true
+						parsed, err := ticket.Parse([]byte(env.ReadFile("tickets/todo/" + file.Name())))
+						require.NoError(t, err)
+						assert.Equal(t, 1, parsed.Priority)
+						assert.Equal(t, "Bug: login-crash", parsed.Description)
+						assert.Contains(t, parsed.Content, "# "+strings.TrimSuffix(file.Name(), ".md"))
+						assert.Contains(t, parsed.Content, "Parent: parent-ticket-003")
+						assert.Contains(t, parsed.Content, "Reported on "+time.Now().Format(time.DateOnly))
+					}
+				}
+				assert.True(t, found, "Ticket should be created from the template")
+			},
+		},
+		{
+			name: "default template is used without --template",
+			setup: func(env *testharness.TestEnvironment) {
+				env.WriteFile("tickets/.templates/default.md", "# Default for {{.Slug}}\n")
+			},
+			args:  []string{"plain-ticket"},
+			flags: map[string]string{"format": "text"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				files, err := os.ReadDir(env.RootDir + "/tickets/todo")
+				require.NoError(t, err)
+				require.Len(t, files, 1)
+				assert.Contains(t, env.ReadFile("tickets/todo/"+files[0].Name()), "# Default for plain-ticket")
+			},
+		},
+		{
+			name: "unknown template",
+			setup: func(env *testharness.TestEnvironment) {
+				env.WriteFile("tickets/.templates/bug.md", "# Bug\n")
+			},
+			args:          []string{"no-template"},
+			flags:         map[string]string{"format": "text", "template": "feature"},
+			wantError:     true,
+			errorContains: "Template not found",
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				files, err := os.ReadDir(env.RootDir + "/tickets/todo")
+				require.NoError(t, err)
+				assert.Empty(t, files, "No ticket should be created")
+			},
+		},
 		{
 			name: "create ticket with JSON format",
 			setup: func(env *testharness.TestEnvironment) {
@@ -298,8 +356,9 @@ description: "Feature template"
 
 			// Setup flags
 			newFlags := &newFlags{
-				parent: tt.flags["parent"],
-				format: tt.flags["format"],
+				parent:   tt.flags["parent"],
+				template: tt.flags["template"],
+				format:   tt.flags["format"],
 			}
 
 			// Validate flags before execution
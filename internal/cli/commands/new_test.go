@@ -25,7 +25,7 @@ func TestNewCommand_Description(t *testing.T) {
 
 func TestNewCommand_Usage(t *testing.T) {
 	cmd := NewNewCommand()
-	assert.Equal(t, "new [--parent <ticket-id>] [--format text|json] <slug>", cmd.Usage())
+	assert.Equal(t, "new [--parent <ticket-id>] [--tag <tag>]... [--template <name>] [--format text|json] <slug>", cmd.Usage())
 }
 
 func TestNewCommand_SetupFlags(t *testing.T) {
@@ -63,11 +63,31 @@ func TestNewCommand_SetupFlags(t *testing.T) {
 
 func TestNewCommand_FlagParsing(t *testing.T) {
 	tests := []struct {
-		name           string
-		args           []string
-		expectedParent string
-		expectedFormat string
+		name             string
+		args             []string
+		expectedParent   string
+		expectedTags     []string
+		expectedTemplate string
+		expectedFormat   string
 	}{
+		{
+			name:             "template flag",
+			args:             []string{"--template", "bug", "my-ticket"},
+			expectedTemplate: "bug",
+			expectedFormat:   "text",
+		},
+		{
+			name:           "repeated tag flags",
+			args:           []string{"--tag", "backend", "-t", "auth", "my-ticket"},
+			expectedTags:   []string{"backend", "auth"},
+			expectedFormat: "text",
+		},
+		{
+			name:           "comma separated tags",
+			args:           []string{"--tag", "backend,ui", "my-ticket"},
+			expectedTags:   []string{"backend", "ui"},
+			expectedFormat: "text",
+		},
 		{
 			name:           "short parent flag",
 			args:           []string{"-p", "parent-456", "my-ticket"},
@@ -112,6 +132,8 @@ func TestNewCommand_FlagParsing(t *testing.T) {
 
 			// Verify the parsed values
 			assert.Equal(t, tt.expectedParent, flags.parent)
+			assert.Equal(t, tt.expectedTags, flags.tags)
+			assert.Equal(t, tt.expectedTemplate, flags.template)
 			assert.Equal(t, tt.expectedFormat, flags.format)
 		})
 	}
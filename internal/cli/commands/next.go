@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// NextCommand implements the next command using the new Command interface
+type NextCommand struct{}
+
+// NewNextCommand creates a new next command
+func NewNextCommand() command.Command {
+	return &NextCommand{}
+}
+
+// Name returns the command name
+func (c *NextCommand) Name() string {
+	return "next"
+}
+
+// Aliases returns alternative names for this command
+func (c *NextCommand) Aliases() []string {
+	return []string{"ready"}
+}
+
+// Description returns a short description of the command
+func (c *NextCommand) Description() string {
+	return "List todo tickets that are ready to start"
+}
+
+// Usage returns the usage string for the command
+func (c *NextCommand) Usage() string {
+	return "next [--count N] [--format text|json]"
+}
+
+// nextFlags holds the flags for the next command
+type nextFlags struct {
+	count  int
+	format string
+}
+
+// SetupFlags configures flags for the command
+func (c *NextCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &nextFlags{}
+	fs.IntVarP(&flags.count, "count", "c", 0, "Maximum number of tickets to show (0 for all)")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *NextCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	f, err := AssertFlags[nextFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if f.count < 0 {
+		return fmt.Errorf("count must be non-negative, got %d", f.count)
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the next command
+func (c *NextCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[nextFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.ReadyTickets(ctx, f.count)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewNextCommand()
+
+	assert.Equal(t, "next", cmd.Name())
+	assert.Equal(t, []string{"ready"}, cmd.Aliases())
+	assert.Equal(t, "List todo tickets that are ready to start", cmd.Description())
+	assert.Equal(t, "next [--count N] [--format text|json]", cmd.Usage())
+}
+
+func TestNextCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewNextCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*nextFlags)
+
+	assert.Equal(t, 0, flags.count)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-c", "1", "--format", "json"}))
+	assert.Equal(t, 1, flags.count)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestNextCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *nextFlags
+		args        []string
+		errContains string
+	}{
+		{name: "defaults", flags: &nextFlags{format: FormatText}},
+		{name: "count and json", flags: &nextFlags{count: 3, format: FormatJSON}},
+		{name: "negative count", flags: &nextFlags{count: -1, format: FormatText}, errContains: "count must be non-negative"},
+		{name: "invalid format", flags: &nextFlags{format: "xml"}, errContains: "invalid format"},
+		{name: "unexpected args", flags: &nextFlags{format: FormatText}, args: []string{"extra"}, errContains: "unexpected arguments"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewNextCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// PRBodyCommand implements the pr-body command using the new Command interface
+type PRBodyCommand struct{}
+
+// NewPRBodyCommand creates a new pr-body command
+func NewPRBodyCommand() command.Command {
+	return &PRBodyCommand{}
+}
+
+// Name returns the command name
+func (c *PRBodyCommand) Name() string {
+	return "pr-body"
+}
+
+// Aliases returns alternative names for this command
+func (c *PRBodyCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *PRBodyCommand) Description() string {
+	return "Render a pull request description for a ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *PRBodyCommand) Usage() string {
+	return "pr-body [--template FILE] [--file FILE] [--format text|json] [<ticket-id>]"
+}
+
+// prBodyFlags holds the flags for the pr-body command
+type prBodyFlags struct {
+	template string
+	file     string
+	format   string
+}
+
+// SetupFlags configures flags for the command
+func (c *PRBodyCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &prBodyFlags{}
+	fs.StringVar(&flags.template, "template", "", "Template file to render instead of the configured template")
+	fs.StringVar(&flags.file, "file", "", "Write the description to FILE instead of stdout")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *PRBodyCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[prBodyFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the pr-body command
+func (c *PRBodyCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[prBodyFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var ticketID string
+	if len(args) > 0 {
+		ticketID = args[0]
+	}
+
+	result, err := app.PRBody(ctx, ticketID, f.template, f.file)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestPRBodyCommand_Execute_Integration(t *testing.T) {
+	const (
+		parentID = "250101-110000-epic"
+		ticketID = "250101-120000-add-feature"
+	)
+
+	setup := func(t *testing.T) *testharness.TestEnvironment {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.CreateTicket(parentID, ticket.StatusTodo, testharness.WithDescription("The epic"))
+		env.CreateTicket(ticketID, ticket.StatusDoing,
+			testharness.WithDescription("Add the feature"),
+			testharness.WithParent(parentID),
+			testharness.WithContent("## Tasks\n- [x] Write code\n- [ ] Write docs"))
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start ticket")
+
+		env.RunGit("checkout", "-b", ticketID)
+		env.WriteFile("feature.txt", "feature\n")
+		env.RunGit("add", "feature.txt")
+		env.RunGit("commit", "-m", "Implement feature")
+		env.WriteFile("feature.txt", "feature\nfix\n")
+		env.RunGit("commit", "-am", "Fix feature")
+		env.RunGit("checkout", "main")
+		return env
+	}
+
+	run := func(t *testing.T, flags *prBodyFlags, args ...string) string {
+		cmd := NewPRBodyCommand()
+		require.NoError(t, cmd.Validate(flags, args))
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		return testharness.CaptureOutput(t, func() {
+			require.NoError(t, cmd.Execute(ctx, flags, args))
+		})
+	}
+
+	t.Run("renders the built-in template for the current ticket", func(t *testing.T) {
+		setup(t)
+		output := run(t, &prBodyFlags{format: FormatText})
+
+		assert.Contains(t, output, "## Summary\n\nAdd the feature\n\nTicket: `"+ticketID+"`\n")
+		assert.Contains(t, output, "Parent: `"+parentID+"` - The epic (tickets/todo/"+parentID+".md)\n")
+		assert.Contains(t, output, "## Tasks (1/2)\n\n- [x] Write code\n- [ ] Write docs\n")
+		assert.Regexp(t, `## Commits\n\n- Implement feature \([0-9a-f]{7}\)\n- Fix feature \([0-9a-f]{7}\)\n`, output)
+	})
+
+	t.Run("renders a template file to a file", func(t *testing.T) {
+		env := setup(t)
+		env.WriteFile("pr.tmpl", "{{.Title}} ({{len .Commits}} commits, {{.TaskProgress.Done}}/{{.TaskProgress.Total}} tasks) into {{.BaseBranch}}")
+		outFile := filepath.Join(env.RootDir, "pr.md")
+
+		output := run(t, &prBodyFlags{template: "pr.tmpl", file: outFile, format: FormatText}, ticketID)
+		assert.Contains(t, output, "Wrote pull request description for "+ticketID+" to "+outFile)
+
+		data, err := os.ReadFile(outFile)
+		require.NoError(t, err)
+		assert.Equal(t, "Add the feature (2 commits, 1/2 tasks) into main\n", string(data))
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		setup(t)
+		output := run(t, &prBodyFlags{format: FormatJSON}, ticketID)
+
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "ticket_id", ticketID)
+		testharness.AssertJSONField(t, jsonData, "title", "Add the feature")
+		testharness.AssertJSONArrayLength(t, jsonData, "commits", 2)
+		commits := testharness.GetJSONField(jsonData, "commits").([]interface{})
+		assert.Equal(t, "Implement feature", commits[0].(map[string]interface{})["subject"])
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		env := setup(t)
+		env.WriteFile("pr.tmpl", "{{.Title")
+
+		cmd := NewPRBodyCommand()
+		flags := &prBodyFlags{template: "pr.tmpl", format: FormatText}
+		err := cmd.Execute(context.Background(), flags, []string{ticketID})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid pull request template")
+	})
+}
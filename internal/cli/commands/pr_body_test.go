@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRBodyCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewPRBodyCommand()
+
+	assert.Equal(t, "pr-body", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Render a pull request description for a ticket", cmd.Description())
+	assert.Equal(t, "pr-body [--template FILE] [--file FILE] [--format text|json] [<ticket-id>]", cmd.Usage())
+}
+
+func TestPRBodyCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewPRBodyCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*prBodyFlags)
+
+	assert.Empty(t, flags.template)
+	assert.Empty(t, flags.file)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--template", "pr.tmpl", "--file", "pr.md", "-o", "json"}))
+	assert.Equal(t, "pr.tmpl", flags.template)
+	assert.Equal(t, "pr.md", flags.file)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestPRBodyCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *prBodyFlags
+		args        []string
+		errContains string
+	}{
+		{name: "current ticket", flags: &prBodyFlags{format: FormatText}},
+		{name: "ticket id", flags: &prBodyFlags{format: FormatJSON}, args: []string{"250101-120000-test"}},
+		{name: "unexpected args", flags: &prBodyFlags{format: FormatText}, args: []string{"250101-120000-test", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &prBodyFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewPRBodyCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
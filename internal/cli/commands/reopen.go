@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// ReopenCommand implements the reopen command using the new Command interface
+type ReopenCommand struct{}
+
+// NewReopenCommand creates a new reopen command
+func NewReopenCommand() command.Command {
+	return &ReopenCommand{}
+}
+
+// Name returns the command name
+func (c *ReopenCommand) Name() string {
+	return "reopen"
+}
+
+// Aliases returns alternative names for this command
+func (c *ReopenCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *ReopenCommand) Description() string {
+	return "Reopen a done or cancelled ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *ReopenCommand) Usage() string {
+	return "reopen [--start] [--force] [--format text|json] <ticket-id>"
+}
+
+// reopenFlags holds the flags for the reopen command
+type reopenFlags struct {
+	start  bool
+	force  bool
+	format string
+}
+
+// SetupFlags configures flags for the command
+func (c *ReopenCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &reopenFlags{}
+	fs.BoolVarP(&flags.start, "start", "s", false, "Start the ticket right away, recreating its branch or worktree")
+	fs.BoolVarP(&flags.force, "force", "f", false, "Force recreate worktree if it already exists (with --start)")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *ReopenCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[reopenFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if f.force && !f.start {
+		return fmt.Errorf("--force can only be used together with --start")
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the reopen command
+func (c *ReopenCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[reopenFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.ReopenTicket(ctx, args[0], f.start, f.force)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestReopenCommand_Execute_Integration(t *testing.T) {
+	const ticketID = "250101-120000-reopen-me"
+
+	tests := []struct {
+		name          string
+		status        ticket.Status
+		start         bool
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name:   "reopen done ticket",
+			status: ticket.StatusDone,
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.False(t, env.FileExists(env.TicketPath("done", ticketID+".md")))
+				content := env.ReadFile(env.TicketPath("todo", ticketID+".md"))
+				parsed, err := ticket.Parse([]byte(content))
+				require.NoError(t, err)
+				assert.Nil(t, parsed.StartedAt.Time)
+				assert.Nil(t, parsed.ClosedAt.Time)
+				assert.Empty(t, parsed.ClosureReason)
+				assert.Contains(t, content, "## Reopen Note")
+				assert.Contains(t, content, "**Previously closed on**")
+				assert.Equal(t, "Reopen ticket: "+ticketID, env.LastCommitMessage())
+			},
+		},
+		{
+			name:   "reopen cancelled ticket",
+			status: ticket.StatusCancelled,
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.False(t, env.FileExists(env.TicketPath("cancelled", ticketID+".md")))
+				assert.True(t, env.FileExists(env.TicketPath("todo", ticketID+".md")))
+			},
+		},
+		{
+			name:   "reopen and start recreates worktree",
+			status: ticket.StatusDone,
+			start:  true,
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				content := env.ReadFile(env.TicketPath("doing", ticketID+".md"))
+				parsed, err := ticket.Parse([]byte(content))
+				require.NoError(t, err)
+				assert.NotNil(t, parsed.StartedAt.Time)
+				assert.Nil(t, parsed.ClosedAt.Time)
+				assert.True(t, env.WorktreeExists(ticketID))
+			},
+		},
+		{
+			name:          "cannot reopen todo ticket",
+			status:        ticket.StatusTodo,
+			errorContains: "not closed",
+		},
+		{
+			name:          "ticket not found",
+			errorContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			if tt.status != "" {
+				env.CreateTicket(ticketID, tt.status)
+				env.RunGit("add", ".")
+				env.RunGit("commit", "-m", "Add ticket")
+			}
+
+			cmd := NewReopenCommand()
+			flags := &reopenFlags{start: tt.start, format: FormatText}
+			args := []string{ticketID}
+			require.NoError(t, cmd.Validate(flags, args))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			err = cmd.Execute(ctx, flags, args)
+
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
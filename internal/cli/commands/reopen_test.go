@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReopenCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewReopenCommand()
+
+	assert.Equal(t, "reopen", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Reopen a done or cancelled ticket", cmd.Description())
+	assert.Equal(t, "reopen [--start] [--force] [--format text|json] <ticket-id>", cmd.Usage())
+}
+
+func TestReopenCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewReopenCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*reopenFlags)
+
+	assert.False(t, flags.start)
+	assert.False(t, flags.force)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-s", "-f", "-o", "json"}))
+	assert.True(t, flags.start)
+	assert.True(t, flags.force)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestReopenCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *reopenFlags
+		args        []string
+		errContains string
+	}{
+		{name: "ticket only", flags: &reopenFlags{format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "with start", flags: &reopenFlags{start: true, format: FormatJSON}, args: []string{"250101-120000-test"}},
+		{name: "start and force", flags: &reopenFlags{start: true, force: true, format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "force without start", flags: &reopenFlags{force: true, format: FormatText}, args: []string{"250101-120000-test"}, errContains: "--force can only be used together with --start"},
+		{name: "missing ticket", flags: &reopenFlags{format: FormatText}, errContains: "missing ticket ID"},
+		{name: "extra args", flags: &reopenFlags{format: FormatText}, args: []string{"250101-120000-test", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &reopenFlags{format: "xml"}, args: []string{"250101-120000-test"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewReopenCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// SearchCommand implements the search command
+type SearchCommand struct{}
+
+// NewSearchCommand creates a new search command
+func NewSearchCommand() command.Command {
+	return &SearchCommand{}
+}
+
+// Name returns the command name
+func (c *SearchCommand) Name() string {
+	return "search"
+}
+
+// Aliases returns alternative names for this command
+func (c *SearchCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *SearchCommand) Description() string {
+	return "Search ticket IDs, descriptions and content"
+}
+
+// Usage returns the usage string for the command
+func (c *SearchCommand) Usage() string {
+	return "search [--status STATE|all] [--regex] [--include-archived] [--count N] [--format text|json] <query>"
+}
+
+// searchFlags holds the flags for the search command
+type searchFlags struct {
+	status   string
+	regex    bool
+	archived bool
+	count    int
+	format   string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *SearchCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &searchFlags{}
+	fs.StringVar(&flags.status, "status", cli.StatusAll, "Only search tickets in this workflow state (todo|doing|done|<custom>|all)")
+	fs.BoolVarP(&flags.regex, "regex", "E", false, "Treat the query as a regular expression")
+	fs.BoolVar(&flags.archived, "include-archived", false, "Also search archived tickets")
+	fs.IntVar(&flags.count, "count", 0, "Maximum number of tickets to show (0 for all)")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *SearchCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 || strings.TrimSpace(strings.Join(args, " ")) == "" {
+		return fmt.Errorf("missing search query argument")
+	}
+
+	f, err := AssertFlags[searchFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if f.count < 0 {
+		return fmt.Errorf("count must be non-negative, got %d", f.count)
+	}
+	if !isValidListStatus(f.status) {
+		return fmt.Errorf("invalid status: %q (must be a workflow state such as 'todo', 'doing', 'done', or 'all')", f.status)
+	}
+	if f.archived && f.status != cli.StatusAll && f.status != string(ticket.StatusDone) {
+		return fmt.Errorf("--include-archived can only be combined with --status done or --status all")
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *SearchCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[searchFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	// Multiple arguments form a single phrase, so quoting is optional
+	result, err := app.SearchTickets(ctx, cli.SearchOptions{
+		Query:           strings.Join(args, " "),
+		Regex:           f.regex,
+		Status:          ticket.Status(f.status),
+		IncludeArchived: f.archived,
+		Count:           f.count,
+	})
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestSearchCommand_Execute_Integration(t *testing.T) {
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.CreateTicket("250101-120000-login-page", ticket.StatusTodo,
+		testharness.WithDescription("Fix the login page"),
+		testharness.WithContent("Steps:\n- open the login form\n- it hangs"))
+	env.CreateTicket("250101-120001-session-timeout", ticket.StatusDone,
+		testharness.WithContent("Users are logged out after a login refresh."))
+	env.CreateTicket("250101-120002-docs", ticket.StatusTodo, testharness.WithContent("Nothing relevant"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	for _, format := range []string{FormatText, FormatJSON} {
+		flags := &searchFlags{status: cli.StatusAll, format: format}
+		require.NoError(t, NewSearchCommand().Validate(flags, []string{"login"}))
+		require.NoError(t, NewSearchCommand().Execute(ctx, flags, []string{"login"}))
+	}
+
+	app, err := cli.NewApp(ctx)
+	require.NoError(t, err)
+
+	result, err := app.SearchTickets(ctx, cli.SearchOptions{Query: "login"})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	first := result.Results[0]
+	assert.Equal(t, "250101-120000-login-page", first.Ticket.ID)
+	assert.Equal(t, []string{ticket.SearchFieldID, ticket.SearchFieldDescription, ticket.SearchFieldContent}, first.Fields)
+	require.Len(t, first.Matches, 2) // The "# <id>" heading and the step
+	assert.Equal(t, "- open the login form", first.Matches[1].Text)
+	lines := strings.Split(env.ReadFile(env.TicketPath("todo", "250101-120000-login-page.md")), "\n")
+	assert.Equal(t, "- open the login form", lines[first.Matches[1].Line-1], "line numbers refer to the ticket file")
+
+	result, err = app.SearchTickets(ctx, cli.SearchOptions{Query: "login", Status: ticket.StatusDone})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, "250101-120001-session-timeout", result.Results[0].Ticket.ID)
+
+	result, err = app.SearchTickets(ctx, cli.SearchOptions{Query: `log(ged)? ?out`, Regex: true})
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+
+	result, err = app.SearchTickets(ctx, cli.SearchOptions{Query: "login", Count: 1})
+	require.NoError(t, err)
+	assert.Len(t, result.Results, 1)
+	assert.Equal(t, 2, result.Total)
+
+	_, err = app.SearchTickets(ctx, cli.SearchOptions{Query: "login(", Regex: true})
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli"
+)
+
+func TestSearchCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewSearchCommand()
+
+	assert.Equal(t, "search", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Search ticket IDs, descriptions and content", cmd.Description())
+	assert.Equal(t, "search [--status STATE|all] [--regex] [--include-archived] [--count N] [--format text|json] <query>", cmd.Usage())
+}
+
+func TestSearchCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewSearchCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*searchFlags)
+
+	assert.Equal(t, cli.StatusAll, flags.status)
+	assert.False(t, flags.regex)
+	assert.False(t, flags.archived)
+	assert.Equal(t, 0, flags.count)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--status", "done", "-E", "--include-archived", "--count", "5", "-o", "json"}))
+	assert.Equal(t, "done", flags.status)
+	assert.True(t, flags.regex)
+	assert.True(t, flags.archived)
+	assert.Equal(t, 5, flags.count)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestSearchCommand_Validate(t *testing.T) {
+	t.Parallel()
+	valid := func() *searchFlags { return &searchFlags{status: cli.StatusAll, format: FormatText} }
+
+	tests := []struct {
+		name        string
+		flags       *searchFlags
+		args        []string
+		errContains string
+	}{
+		{name: "single word", flags: valid(), args: []string{"login"}},
+		{name: "several words", flags: valid(), args: []string{"login", "page"}},
+		{name: "done with archived", flags: &searchFlags{status: "done", archived: true, format: FormatJSON}, args: []string{"login"}},
+		{name: "missing query", flags: valid(), errContains: "missing search query"},
+		{name: "blank query", flags: valid(), args: []string{" "}, errContains: "missing search query"},
+		{name: "negative count", flags: &searchFlags{status: cli.StatusAll, count: -1, format: FormatText}, args: []string{"x"}, errContains: "count must be non-negative"},
+		{name: "invalid status", flags: &searchFlags{status: "In Review", format: FormatText}, args: []string{"x"}, errContains: "invalid status"},
+		{name: "archived with todo", flags: &searchFlags{status: "todo", archived: true, format: FormatText}, args: []string{"x"}, errContains: "--include-archived"},
+		{name: "invalid format", flags: &searchFlags{status: cli.StatusAll, format: "xml"}, args: []string{"x"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewSearchCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -34,7 +34,7 @@ func (c *ShowCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *ShowCommand) Usage() string {
-	return "show <ticket-id> [--format text|json]"
+	return "show <ticket-id> [--format FORMAT]"
 }
 
 // showFlags holds the flags for the show command
@@ -45,7 +45,7 @@ type showFlags struct {
 // SetupFlags configures flags for the command
 func (c *ShowCommand) SetupFlags(fs *flag.FlagSet) interface{} {
 	flags := &showFlags{}
-	fs.StringVar(&flags.format, "format", FormatText, "Output format (text|json)")
+	fs.StringVar(&flags.format, "format", FormatText, "Output format ("+extendedFormatsHelp+")")
 	return flags
 }
 
@@ -71,7 +71,7 @@ func (c *ShowCommand) Validate(flags interface{}, args []string) error {
 	if f.format == "" {
 		f.format = FormatText
 	}
-	if err := ValidateFormat(f.format); err != nil {
+	if err := ValidateExtendedFormat(f.format); err != nil {
 		return err
 	}
 
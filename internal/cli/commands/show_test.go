@@ -24,7 +24,7 @@ func TestShowCommand_Description(t *testing.T) {
 
 func TestShowCommand_Usage(t *testing.T) {
 	cmd := NewShowCommand()
-	assert.Equal(t, "show <ticket-id> [--format text|json]", cmd.Usage())
+	assert.Equal(t, "show <ticket-id> [--format FORMAT]", cmd.Usage())
 }
 
 func TestShowCommand_SetupFlags(t *testing.T) {
@@ -79,10 +79,29 @@ func TestShowCommand_Validate(t *testing.T) {
 		},
 		{
 			name:      "invalid format",
+			flags:     &showFlags{format: "xml"},
+			args:      []string{"123456"},
+			expectErr: true,
+			errMsg:    `invalid format: "xml" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`,
+		},
+		{
+			name:      "yaml format",
 			flags:     &showFlags{format: "yaml"},
 			args:      []string{"123456"},
+			expectErr: false,
+		},
+		{
+			name:      "template format",
+			flags:     &showFlags{format: "template={{.id}}"},
+			args:      []string{"123456"},
+			expectErr: false,
+		},
+		{
+			name:      "invalid template",
+			flags:     &showFlags{format: "template={{.id"},
+			args:      []string{"123456"},
 			expectErr: true,
-			errMsg:    `invalid format: "yaml" (must be "text" or "json")`,
+			errMsg:    `invalid output template: template: output:1: unclosed action`,
 		},
 		{
 			name:      "empty format defaults to text",
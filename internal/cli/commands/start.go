@@ -34,12 +34,13 @@ func (c *StartCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *StartCommand) Usage() string {
-	return "start [--force] [--format text|json] <ticket-id>"
+	return "start [--force] [--base <ref>] [--format text|json] <ticket-id>"
 }
 
 // startFlags holds the flags for the start command
 type startFlags struct {
 	force  bool
+	base   string
 	format string
 }
 
@@ -47,6 +48,7 @@ type startFlags struct {
 func (c *StartCommand) SetupFlags(fs *flag.FlagSet) interface{} {
 	flags := &startFlags{}
 	fs.BoolVarP(&flags.force, "force", "f", false, "Force recreate worktree if it already exists")
+	fs.StringVar(&flags.base, "base", "", "Create the ticket branch from default, current, parent or a ref (default: git.start_base)")
 	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
 	return flags
 }
@@ -117,7 +119,7 @@ func (c *StartCommand) Execute(ctx context.Context, flags interface{}, args []st
 	ticketID := args[0]
 
 	// Use the existing StartTicket method from App which handles all the business logic
-	result, err := app.StartTicket(ctx, ticketID, force)
+	result, err := app.StartTicketWithOptions(ctx, ticketID, cli.StartTicketOptions{Force: force, Base: f.base})
 	if err != nil {
 		return err
 	}
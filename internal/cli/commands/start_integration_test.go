@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -341,3 +342,187 @@ func TestStartCommand_Execute_MultipleTicketValidation(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected arguments after ticket ID")
 }
+
+func TestStartCommand_Execute_BranchTemplate(t *testing.T) {
+	const ticketID = "250101-120000-add-login"
+	const branch = "feature/add-login"
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, os.Chdir(oldWd))
+	})
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.Config.Git.BranchTemplate = "feature/{{.Slug}}"
+	data, err := yaml.Marshal(env.Config)
+	require.NoError(t, err)
+	env.WriteFile(".ticketflow.yaml", string(data))
+	env.WriteFile(".gitignore", "current-ticket.md\n")
+	env.CreateTicket(ticketID, ticket.StatusTodo)
+	env.RunGit("add", ".")
+	env.RunGit("commit", "-m", "Add ticket")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Start creates the templated branch and records it in the ticket
+	output := testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewStartCommand().Execute(ctx, &startFlags{format: FormatText}, []string{ticketID}))
+	})
+	assert.Contains(t, output, "git push -u origin "+branch)
+	assert.Contains(t, env.RunGit("branch", "--list", branch), branch)
+	assert.Empty(t, strings.TrimSpace(env.RunGit("branch", "--list", ticketID)))
+	assert.Contains(t, env.ReadFile(env.TicketPath("doing", ticketID+".md")), "branch: "+branch)
+
+	// The worktree is still named after the ticket
+	wtPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+	assert.True(t, env.WorktreeExists(ticketID))
+	assert.Equal(t, branch, strings.TrimSpace(env.RunGit("-C", wtPath, "branch", "--show-current")))
+
+	// Restore and close find the ticket through its branch
+	require.NoError(t, os.Chdir(wtPath))
+	require.NoError(t, os.Remove(filepath.Join(wtPath, "current-ticket.md")))
+	testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewRestoreCommand().Execute(ctx, &restoreFlags{format: FormatText}, nil))
+	})
+	_, err = os.Lstat(filepath.Join(wtPath, "current-ticket.md"))
+	require.NoError(t, err)
+
+	closeFlags := &closeFlags{format: FormatText}
+	require.NoError(t, NewCloseCommand().Validate(closeFlags, nil))
+	testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewCloseCommand().Execute(ctx, closeFlags, nil))
+	})
+	assert.FileExists(t, filepath.Join(wtPath, "tickets", "done", ticketID+".md"))
+
+	// Cleanup removes the worktree and the templated branch
+	require.NoError(t, os.Chdir(env.RootDir))
+	env.RunGit("merge", "--no-ff", "-m", "Merge "+branch, branch)
+	cleanupFlags := &cleanupFlags{force: true, format: FormatText}
+	require.NoError(t, NewCleanupCommand().Validate(cleanupFlags, []string{ticketID}))
+	testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewCleanupCommand().Execute(ctx, cleanupFlags, []string{ticketID}))
+	})
+	assert.False(t, env.WorktreeExists(ticketID))
+	assert.Empty(t, strings.TrimSpace(env.RunGit("branch", "--list", branch)))
+}
+
+func TestStartCommand_Execute_Base(t *testing.T) {
+	const ticketID = "250101-120000-hotfix"
+
+	// setup creates a todo ticket and a release branch that predates it
+	setup := func(t *testing.T, worktree bool, startBase string) *testharness.TestEnvironment {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.Config.Worktree.Enabled = worktree
+		env.Config.Git.StartBase = startBase
+		data, err := yaml.Marshal(env.Config)
+		require.NoError(t, err)
+		env.WriteFile(".ticketflow.yaml", string(data))
+		env.WriteFile(".gitignore", "current-ticket.md\n")
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Configure ticketflow")
+		env.RunGit("branch", "release")
+
+		env.CreateTicket(ticketID, ticket.StatusTodo)
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Add ticket")
+		return env
+	}
+
+	run := func(t *testing.T, flags *startFlags) (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		var err error
+		output := testharness.CaptureOutput(t, func() {
+			err = NewStartCommand().Execute(ctx, flags, []string{ticketID})
+		})
+		return output, err
+	}
+
+	t.Run("worktree branches from the base and carries the started ticket", func(t *testing.T) {
+		env := setup(t, true, "")
+		releaseCommit := strings.TrimSpace(env.RunGit("rev-parse", "release"))
+
+		output, err := run(t, &startFlags{base: "release", format: FormatJSON})
+		require.NoError(t, err)
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "base", "release")
+		testharness.AssertJSONField(t, jsonData, "base_commit", releaseCommit)
+
+		// The start commit is on main and on top of release in the worktree
+		assert.Equal(t, "Start ticket: "+ticketID, env.LastCommitMessage())
+		wtPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+		assert.Equal(t, releaseCommit, strings.TrimSpace(env.RunGit("-C", wtPath, "rev-parse", "HEAD^")))
+		assert.Equal(t, "Start ticket: "+ticketID, strings.TrimSpace(env.RunGit("-C", wtPath, "log", "-1", "--format=%s")))
+		assert.FileExists(t, filepath.Join(wtPath, "tickets", "doing", ticketID+".md"))
+		assert.NoFileExists(t, filepath.Join(wtPath, "tickets", "todo", ticketID+".md"))
+		assert.Empty(t, strings.TrimSpace(env.RunGit("-C", wtPath, "status", "--porcelain")))
+	})
+
+	t.Run("branch mode starts from git.start_base", func(t *testing.T) {
+		env := setup(t, false, "release")
+		releaseCommit := strings.TrimSpace(env.RunGit("rev-parse", "release"))
+
+		output, err := run(t, &startFlags{format: FormatText})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Base: release ("+releaseCommit[:7]+")")
+
+		assert.Equal(t, ticketID, env.GetCurrentBranch())
+		assert.Equal(t, releaseCommit, strings.TrimSpace(env.RunGit("rev-parse", "HEAD^")))
+		assert.True(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+		assert.False(t, env.HasUncommittedChanges())
+	})
+
+	t.Run("current overrides git.start_base", func(t *testing.T) {
+		env := setup(t, true, "release")
+		mainCommit := strings.TrimSpace(env.RunGit("rev-parse", "main"))
+
+		_, err := run(t, &startFlags{base: "current", format: FormatText})
+		require.NoError(t, err)
+		wtPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+		assert.Equal(t, mainCommit, strings.TrimSpace(env.RunGit("-C", wtPath, "rev-parse", "HEAD^")))
+	})
+
+	t.Run("unknown base fails before any change", func(t *testing.T) {
+		env := setup(t, true, "")
+		before := strings.TrimSpace(env.RunGit("rev-parse", "HEAD"))
+
+		_, err := run(t, &startFlags{base: "no-such-branch", format: FormatText})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid start base")
+		assert.Equal(t, before, strings.TrimSpace(env.RunGit("rev-parse", "HEAD")))
+		assert.True(t, env.FileExists(env.TicketPath("todo", ticketID+".md")))
+		assert.False(t, env.WorktreeExists(ticketID))
+	})
+
+	t.Run("rolls back when the start commit in the worktree fails", func(t *testing.T) {
+		env := setup(t, true, "")
+		before := strings.TrimSpace(env.RunGit("rev-parse", "HEAD"))
+		// Reject commits made in linked worktrees only
+		hook := filepath.Join(env.RootDir, ".git", "hooks", "pre-commit")
+		require.NoError(t, os.MkdirAll(filepath.Dir(hook), 0755))
+		require.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\ncase \"$(git rev-parse --git-dir)\" in *worktrees*) exit 1;; esac\n"), 0755))
+
+		_, err := run(t, &startFlags{base: "release", format: FormatText})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to commit ticket in worktree")
+
+		assert.Equal(t, before, strings.TrimSpace(env.RunGit("rev-parse", "HEAD")))
+		assert.True(t, env.FileExists(env.TicketPath("todo", ticketID+".md")))
+		assert.False(t, env.FileExists(env.TicketPath("doing", ticketID+".md")))
+		assert.False(t, env.WorktreeExists(ticketID))
+		assert.Empty(t, strings.TrimSpace(env.RunGit("branch", "--list", ticketID)))
+		assert.False(t, env.HasUncommittedChanges())
+	})
+}
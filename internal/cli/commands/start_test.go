@@ -27,7 +27,7 @@ func TestStartCommand_Description(t *testing.T) {
 
 func TestStartCommand_Usage(t *testing.T) {
 	cmd := NewStartCommand()
-	assert.Equal(t, "start [--force] [--format text|json] <ticket-id>", cmd.Usage())
+	assert.Equal(t, "start [--force] [--base <ref>] [--format text|json] <ticket-id>", cmd.Usage())
 }
 
 func TestStartCommand_SetupFlags(t *testing.T) {
@@ -46,6 +46,10 @@ func TestStartCommand_SetupFlags(t *testing.T) {
 	assert.NotNil(t, forceFlag)
 	assert.Equal(t, "false", forceFlag.DefValue)
 
+	baseFlag := fs.Lookup("base")
+	assert.NotNil(t, baseFlag)
+	assert.Equal(t, "", baseFlag.DefValue)
+
 	formatFlag := fs.Lookup("format")
 	assert.NotNil(t, formatFlag)
 	assert.Equal(t, "text", formatFlag.DefValue)
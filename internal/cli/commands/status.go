@@ -33,7 +33,7 @@ func (c *StatusCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *StatusCommand) Usage() string {
-	return "status [--format text|json]"
+	return "status [--format FORMAT]"
 }
 
 // statusFlags holds the flags for the status command
@@ -44,7 +44,7 @@ type statusFlags struct {
 // SetupFlags configures flags for the command
 func (c *StatusCommand) SetupFlags(fs *flag.FlagSet) interface{} {
 	flags := &statusFlags{}
-	fs.StringVar(&flags.format, "format", FormatText, "Output format (text|json)")
+	fs.StringVar(&flags.format, "format", FormatText, "Output format ("+extendedFormatsHelp+")")
 	return flags
 }
 
@@ -57,7 +57,7 @@ func (c *StatusCommand) Validate(flags interface{}, args []string) error {
 	}
 
 	// Validate format flag
-	if err := ValidateFormat(f.format); err != nil {
+	if err := ValidateExtendedFormat(f.format); err != nil {
 		return err
 	}
 
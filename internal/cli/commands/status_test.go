@@ -33,7 +33,7 @@ func TestStatusCommand_Interface(t *testing.T) {
 	assert.Equal(t, "status", cmd.Name())
 	assert.Nil(t, cmd.Aliases())
 	assert.Equal(t, "Show the status of the current ticket", cmd.Description())
-	assert.Equal(t, "status [--format text|json]", cmd.Usage())
+	assert.Equal(t, "status [--format FORMAT]", cmd.Usage())
 }
 
 func TestStatusCommand_SetupFlags(t *testing.T) {
@@ -108,14 +108,14 @@ func TestStatusCommand_Validate(t *testing.T) {
 			flags:     &statusFlags{format: "xml"},
 			args:      []string{},
 			wantError: true,
-			errorMsg:  `invalid format: "xml" (must be "text" or "json")`,
+			errorMsg:  `invalid format: "xml" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`,
 		},
 		{
 			name:      "empty format defaults to text",
 			flags:     &statusFlags{format: ""},
 			args:      []string{},
 			wantError: true,
-			errorMsg:  `invalid format: "" (must be "text" or "json")`,
+			errorMsg:  `invalid format: "" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`,
 		},
 		{
 			name:      "with unexpected arguments but valid format",
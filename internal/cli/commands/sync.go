@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+	"github.com/yshrsmz/ticketflow/internal/config"
+)
+
+// SyncCommand implements the sync command using the new Command interface
+type SyncCommand struct{}
+
+// NewSyncCommand creates a new sync command
+func NewSyncCommand() command.Command {
+	return &SyncCommand{}
+}
+
+// Name returns the command name
+func (c *SyncCommand) Name() string {
+	return "sync"
+}
+
+// Aliases returns alternative names for this command
+func (c *SyncCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *SyncCommand) Description() string {
+	return "Merge or rebase the default branch into ticket branches"
+}
+
+// Usage returns the usage string for the command
+func (c *SyncCommand) Usage() string {
+	return "sync [--all] [--strategy merge|rebase] [--format text|json] [<ticket-id>]"
+}
+
+// syncFlags holds the flags for the sync command
+type syncFlags struct {
+	all      bool
+	strategy string
+	format   string
+}
+
+// SetupFlags configures flags for the command
+func (c *SyncCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &syncFlags{}
+	fs.BoolVar(&flags.all, "all", false, "Sync every ticket in progress")
+	fs.StringVar(&flags.strategy, "strategy", "", "How to bring in the default branch (merge|rebase), defaults to sync.strategy")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *SyncCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after %s: %v", args[0], args[1:])
+	}
+
+	f, err := AssertFlags[syncFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	if f.all && len(args) > 0 {
+		return fmt.Errorf("cannot use --all with a ticket ID")
+	}
+
+	switch f.strategy {
+	case "", config.SyncStrategyMerge, config.SyncStrategyRebase:
+	default:
+		return fmt.Errorf("invalid strategy %q: must be merge or rebase", f.strategy)
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the sync command
+func (c *SyncCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[syncFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var ticketID string
+	if len(args) > 0 {
+		ticketID = args[0]
+	}
+
+	result, err := app.Sync(ctx, ticketID, f.all, f.strategy)
+	if err != nil {
+		return err
+	}
+
+	if err := app.Output.PrintResult(result); err != nil {
+		return err
+	}
+
+	// Exit non-zero, after the summary, so that scripts notice the worktrees
+	// left mid-sync or not synced
+	if result.HasConflicts() {
+		return cli.NewError(cli.ErrGitMergeFailed, "Sync stopped on conflicts",
+			"Some ticket branches have conflicts to resolve",
+			[]string{"Resolve the conflicts in the listed worktrees, then continue the " + result.Strategy})
+	}
+	if result.HasFailures() {
+		return cli.NewError(cli.ErrGitMergeFailed, "Sync failed for some tickets",
+			"Some ticket branches could not be synced; the other tickets were synced",
+			[]string{"Fix the problems listed for each ticket and run 'ticketflow sync' again"})
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSyncCommand_Execute_Integration(t *testing.T) {
+	const (
+		ticketID = "250101-120000-add-feature"
+		otherID  = "250102-090000-fix-bug"
+	)
+
+	// setup starts ticketID in a worktree with one commit changing feature.txt,
+	// then commits a change to app.txt on main
+	setup := func(t *testing.T) (*testharness.TestEnvironment, string) {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.WriteFile(".gitignore", "current-ticket.md\n")
+		env.WriteFile("app.txt", "base\n")
+		env.CreateTicket(ticketID, ticket.StatusDoing, testharness.WithDescription("Add the feature"))
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start ticket")
+
+		env.CreateWorktree(ticketID)
+		wtPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", ticketID)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "feature.txt"), []byte("feature\n"), 0644))
+		env.RunGit("-C", wtPath, "add", "feature.txt")
+		env.RunGit("-C", wtPath, "commit", "-m", "Implement feature")
+
+		env.WriteFile("app.txt", "base\nupstream\n")
+		env.RunGit("commit", "-am", "Change app on main")
+		return env, wtPath
+	}
+
+	run := func(t *testing.T, flags *syncFlags, args ...string) (string, error) {
+		cmd := NewSyncCommand()
+		require.NoError(t, cmd.Validate(flags, args))
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		var err error
+		output := testharness.CaptureOutput(t, func() {
+			err = cmd.Execute(ctx, flags, args)
+		})
+		return output, err
+	}
+
+	syncedTicket := func(t *testing.T, output string, index int) map[string]interface{} {
+		jsonData := testharness.ValidateJSON(t, output)
+		tickets := testharness.GetJSONField(jsonData, "tickets").([]interface{})
+		require.Greater(t, len(tickets), index)
+		return tickets[index].(map[string]interface{})
+	}
+
+	t.Run("merges the default branch into the ticket worktree", func(t *testing.T) {
+		env, wtPath := setup(t)
+
+		output, err := run(t, &syncFlags{format: FormatText}, ticketID)
+		require.NoError(t, err)
+		assert.Contains(t, output, "Syncing with main (merge)\n")
+		assert.Contains(t, output, "✅ "+ticketID+": Merged 1 commit(s) from main\n")
+
+		content, err := os.ReadFile(filepath.Join(wtPath, "app.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "base\nupstream\n", string(content))
+		// HEAD is a merge of main into the ticket branch
+		assert.Equal(t, strings.TrimSpace(env.RunGit("rev-parse", "main")),
+			strings.TrimSpace(env.RunGit("-C", wtPath, "rev-parse", "HEAD^2")))
+	})
+
+	t.Run("rebases the ticket branch with --strategy rebase", func(t *testing.T) {
+		env, wtPath := setup(t)
+
+		output, err := run(t, &syncFlags{strategy: "rebase", format: FormatJSON}, ticketID)
+		require.NoError(t, err)
+
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "upstream", "main")
+		testharness.AssertJSONField(t, jsonData, "strategy", "rebase")
+		synced := syncedTicket(t, output, 0)
+		assert.Equal(t, ticketID, synced["id"])
+		assert.Equal(t, ticketID, synced["branch"])
+		assert.Equal(t, "synced", synced["status"])
+		assert.Equal(t, float64(1), synced["ahead"])
+		assert.Equal(t, float64(1), synced["behind"])
+
+		// The ticket commit now sits on top of main, without a merge commit
+		assert.Equal(t, "Implement feature", strings.TrimSpace(env.RunGit("-C", wtPath, "log", "-1", "--pretty=%s")))
+		assert.Equal(t, strings.TrimSpace(env.RunGit("rev-parse", "main")),
+			strings.TrimSpace(env.RunGit("-C", wtPath, "rev-parse", "HEAD^")))
+	})
+
+	t.Run("uses sync.strategy from the config", func(t *testing.T) {
+		env, wtPath := setup(t)
+		env.Config.Sync.Strategy = "rebase"
+		data, err := yaml.Marshal(env.Config)
+		require.NoError(t, err)
+		env.WriteFile(".ticketflow.yaml", string(data))
+
+		output, err := run(t, &syncFlags{format: FormatText}, ticketID)
+		require.NoError(t, err)
+		assert.Contains(t, output, "Syncing with main (rebase)\n")
+		assert.Contains(t, output, "✅ "+ticketID+": Rebased onto 1 commit(s) from main\n")
+		assert.Empty(t, strings.TrimSpace(env.RunGit("-C", wtPath, "rev-list", "--merges", "main..HEAD")))
+	})
+
+	t.Run("reports a branch that is already up to date", func(t *testing.T) {
+		env, wtPath := setup(t)
+		env.RunGit("-C", wtPath, "merge", "--no-edit", "main")
+
+		output, err := run(t, &syncFlags{format: FormatJSON}, ticketID)
+		require.NoError(t, err)
+		synced := syncedTicket(t, output, 0)
+		assert.Equal(t, "up-to-date", synced["status"])
+		assert.Equal(t, float64(0), synced["behind"])
+	})
+
+	t.Run("stops on conflicts and leaves the merge to resolve", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "app.txt"), []byte("base\nticket\n"), 0644))
+		env.RunGit("-C", wtPath, "commit", "-am", "Change app on the ticket")
+
+		output, err := run(t, &syncFlags{format: FormatText}, ticketID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Sync stopped on conflicts")
+		assert.Contains(t, output, "❌ "+ticketID+": conflicts in 1 file(s)\n")
+		assert.Contains(t, output, "   app.txt\n")
+		assert.Contains(t, output, "Resolve them in "+wtPath)
+		assert.Contains(t, output, "git add <files> && git merge --continue\n")
+		assert.Contains(t, output, "git merge --abort\n")
+
+		// The merge is left in progress in the worktree
+		assert.NotEmpty(t, strings.TrimSpace(env.RunGit("-C", wtPath, "rev-parse", "-q", "--verify", "MERGE_HEAD")))
+		env.RunGit("-C", wtPath, "merge", "--abort")
+	})
+
+	t.Run("reports conflicts in JSON", func(t *testing.T) {
+		env, wtPath := setup(t)
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "app.txt"), []byte("base\nticket\n"), 0644))
+		env.RunGit("-C", wtPath, "commit", "-am", "Change app on the ticket")
+
+		output, err := run(t, &syncFlags{strategy: "rebase", format: FormatJSON}, ticketID)
+		require.Error(t, err)
+		synced := syncedTicket(t, output, 0)
+		assert.Equal(t, "conflict", synced["status"])
+		assert.Equal(t, []interface{}{"app.txt"}, synced["conflicts"])
+		assert.Equal(t, wtPath, synced["worktree"])
+		env.RunGit("-C", wtPath, "rebase", "--abort")
+	})
+
+	t.Run("syncs every doing ticket with --all", func(t *testing.T) {
+		env, wtPath := setup(t)
+		env.CreateTicket(otherID, ticket.StatusDoing, testharness.WithDescription("Fix the bug"))
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start other ticket")
+		env.RunGit("branch", otherID, "main~1")
+		// The other ticket's branch has no worktree
+
+		output, err := run(t, &syncFlags{all: true, format: FormatText})
+		require.NoError(t, err)
+		assert.Contains(t, output, "✅ "+ticketID+": Merged 2 commit(s) from main\n")
+		assert.Contains(t, output, "⚠️  "+otherID+": skipped, branch is not checked out in any worktree\n")
+
+		// Uncommitted changes are never touched
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "feature.txt"), []byte("changed\n"), 0644))
+		env.WriteFile("app.txt", "base\nupstream\nmore\n")
+		env.RunGit("commit", "-am", "Change app on main again")
+
+		output, err = run(t, &syncFlags{all: true, format: FormatJSON})
+		require.NoError(t, err)
+		synced := syncedTicket(t, output, 0)
+		assert.Equal(t, ticketID, synced["id"])
+		assert.Equal(t, "skipped", synced["status"])
+		assert.Equal(t, "worktree has uncommitted changes", synced["reason"])
+	})
+
+	t.Run("records a failed ticket and syncs the rest", func(t *testing.T) {
+		env, wtPath := setup(t)
+		env.CreateTicket(otherID, ticket.StatusDoing, testharness.WithDescription("Fix the bug"))
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start other ticket")
+		env.CreateWorktree(otherID)
+		otherPath := filepath.Join(filepath.Dir(env.RootDir), "test-worktrees", otherID)
+
+		env.WriteFile("new.txt", "main\n")
+		env.RunGit("add", "new.txt")
+		env.RunGit("commit", "-m", "Add new file on main")
+		// An untracked file in the way makes the merge fail without conflicts
+		require.NoError(t, os.WriteFile(filepath.Join(wtPath, "new.txt"), []byte("untracked\n"), 0644))
+
+		output, err := run(t, &syncFlags{all: true, format: FormatJSON})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Sync failed for some tickets")
+
+		byID := make(map[string]map[string]interface{})
+		for _, entry := range testharness.GetJSONField(testharness.ValidateJSON(t, output), "tickets").([]interface{}) {
+			synced := entry.(map[string]interface{})
+			byID[synced["id"].(string)] = synced
+		}
+		require.Len(t, byID, 2)
+		assert.Equal(t, "failed", byID[ticketID]["status"])
+		assert.Contains(t, byID[ticketID]["reason"], "git merge --no-edit main failed")
+		assert.Equal(t, "synced", byID[otherID]["status"])
+
+		content, err := os.ReadFile(filepath.Join(otherPath, "new.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "main\n", string(content))
+	})
+
+	t.Run("fetches the default branch from sync.remote", func(t *testing.T) {
+		env, wtPath := setup(t)
+		remotePath := filepath.Join(filepath.Dir(env.RootDir), "origin.git")
+		env.RunGit("init", "--bare", remotePath)
+		env.RunGit("remote", "add", "origin", remotePath)
+		env.RunGit("push", "origin", "main")
+		// Only the remote has the change to main
+		env.RunGit("reset", "--hard", "HEAD~1")
+		env.RunGit("update-ref", "-d", "refs/remotes/origin/main")
+
+		env.Config.Sync.Remote = "origin"
+		data, err := yaml.Marshal(env.Config)
+		require.NoError(t, err)
+		env.WriteFile(".ticketflow.yaml", string(data))
+
+		output, err := run(t, &syncFlags{format: FormatJSON}, ticketID)
+		require.NoError(t, err)
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "upstream", "origin/main")
+		testharness.AssertJSONField(t, jsonData, "remote", "origin")
+		assert.Equal(t, "synced", syncedTicket(t, output, 0)["status"])
+
+		content, err := os.ReadFile(filepath.Join(wtPath, "app.txt"))
+		require.NoError(t, err)
+		assert.Equal(t, "base\nupstream\n", string(content))
+	})
+
+	t.Run("rejects a ticket that is not in progress", func(t *testing.T) {
+		env, _ := setup(t)
+		env.CreateTicket(otherID, ticket.StatusTodo)
+
+		_, err := run(t, &syncFlags{format: FormatText}, otherID)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Ticket not in progress")
+	})
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewSyncCommand()
+
+	assert.Equal(t, "sync", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Merge or rebase the default branch into ticket branches", cmd.Description())
+	assert.Equal(t, "sync [--all] [--strategy merge|rebase] [--format text|json] [<ticket-id>]", cmd.Usage())
+}
+
+func TestSyncCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewSyncCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*syncFlags)
+
+	assert.False(t, flags.all)
+	assert.Equal(t, "", flags.strategy)
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"--all", "--strategy", "rebase", "-o", "json"}))
+	assert.True(t, flags.all)
+	assert.Equal(t, "rebase", flags.strategy)
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestSyncCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *syncFlags
+		args        []string
+		errContains string
+	}{
+		{name: "current ticket", flags: &syncFlags{format: FormatText}},
+		{name: "ticket ID", flags: &syncFlags{format: FormatText}, args: []string{"250101-120000-test"}},
+		{name: "all with rebase", flags: &syncFlags{all: true, strategy: "rebase", format: FormatJSON}},
+		{name: "all with ticket ID", flags: &syncFlags{all: true, format: FormatText}, args: []string{"250101-120000-test"}, errContains: "cannot use --all"},
+		{name: "unexpected args", flags: &syncFlags{format: FormatText}, args: []string{"a", "b"}, errContains: "unexpected arguments"},
+		{name: "invalid strategy", flags: &syncFlags{strategy: "squash", format: FormatText}, errContains: "invalid strategy"},
+		{name: "invalid format", flags: &syncFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewSyncCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+const (
+	// errUnknownTagSubcommand is the error message for unknown tag subcommands
+	errUnknownTagSubcommand = "unknown tag subcommand: %s"
+)
+
+// TagCommand implements the tag parent command using the new Command interface
+type TagCommand struct {
+	subcommands map[string]command.Command
+}
+
+// NewTagCommand creates a new tag command with its subcommands
+func NewTagCommand() command.Command {
+	return &TagCommand{
+		subcommands: map[string]command.Command{
+			"add":    NewTagAddCommand(),
+			"remove": NewTagRemoveCommand(),
+		},
+	}
+}
+
+// Name returns the command name
+func (c *TagCommand) Name() string {
+	return "tag"
+}
+
+// Aliases returns alternative names for this command
+func (c *TagCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TagCommand) Description() string {
+	return "Add or remove ticket tags"
+}
+
+// Usage returns the usage string for the command
+func (c *TagCommand) Usage() string {
+	return "tag <add|remove> <ticket-id> <tag>..."
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TagCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	// No flags for the parent command
+	return nil
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TagCommand) Validate(flags interface{}, args []string) error {
+	// Subcommands handle their own validation
+	return nil
+}
+
+// Execute runs the command with the given context
+func (c *TagCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(args) == 0 {
+		c.printUsage()
+		return nil
+	}
+
+	subcmdName := args[0]
+	subcmd, ok := c.subcommands[subcmdName]
+	if !ok {
+		c.printUsage()
+		return fmt.Errorf(errUnknownTagSubcommand, subcmdName)
+	}
+
+	// Parse flags for the subcommand
+	fs := flag.NewFlagSet(fmt.Sprintf("tag %s", subcmdName), flag.ContinueOnError)
+	subcmdFlags := subcmd.SetupFlags(fs)
+
+	// Parse remaining arguments
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	// Validate the subcommand
+	if err := subcmd.Validate(subcmdFlags, fs.Args()); err != nil {
+		return err
+	}
+
+	// Execute the subcommand
+	return subcmd.Execute(ctx, subcmdFlags, fs.Args())
+}
+
+// printUsage prints the usage information for the tag command
+func (c *TagCommand) printUsage() {
+	fmt.Println(`TicketFlow Tag Management
+
+USAGE:
+  ticketflow tag add <ticket-id> <tag>...      Add tags to a ticket
+  ticketflow tag remove <ticket-id> <tag>...   Remove tags from a ticket
+
+DESCRIPTION:
+  Tags group tickets by area or theme (e.g. backend, ui). Tags may contain
+  lowercase letters, numbers, and hyphens. Use 'ticketflow list --tag <tag>'
+  to filter tickets by tag.
+
+EXAMPLES:
+  # Tag a ticket
+  ticketflow tag add 250124-150000-login backend auth
+
+  # Remove a tag
+  ticketflow tag remove 250124-150000-login auth`)
+}
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// TagChangeCommand implements the tag add and tag remove subcommands
+type TagChangeCommand struct {
+	action cli.TagAction
+}
+
+// NewTagAddCommand creates a new tag add command
+func NewTagAddCommand() command.Command {
+	return &TagChangeCommand{action: cli.TagActionAdd}
+}
+
+// NewTagRemoveCommand creates a new tag remove command
+func NewTagRemoveCommand() command.Command {
+	return &TagChangeCommand{action: cli.TagActionRemove}
+}
+
+// Name returns the command name
+func (c *TagChangeCommand) Name() string {
+	return string(c.action)
+}
+
+// Aliases returns alternative names for this command
+func (c *TagChangeCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TagChangeCommand) Description() string {
+	if c.action == cli.TagActionAdd {
+		return "Add tags to a ticket"
+	}
+	return "Remove tags from a ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *TagChangeCommand) Usage() string {
+	return fmt.Sprintf("tag %s [--format text|json] <ticket-id> <tag>...", c.action)
+}
+
+// tagChangeFlags holds the flags for the tag add/remove commands
+type tagChangeFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TagChangeCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &tagChangeFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TagChangeCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("missing tag argument")
+	}
+
+	f, err := AssertFlags[tagChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *TagChangeCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[tagChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	ticketID := args[0]
+	tags := args[1:]
+
+	var result *cli.TagResult
+	if c.action == cli.TagActionAdd {
+		result, err = app.AddTicketTags(ctx, ticketID, tags)
+	} else {
+		result, err = app.RemoveTicketTags(ctx, ticketID, tags)
+	}
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestTagCommand_Execute_Integration(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*testharness.TestEnvironment)
+		args          []string
+		wantError     bool
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name: "add tags to ticket",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket("250101-120000-tag-add", ticket.StatusTodo)
+			},
+			args: []string{"add", "250101-120000-tag-add", "backend", "Auth"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				parsed, err := ticket.Parse([]byte(env.ReadFile("tickets/todo/250101-120000-tag-add.md")))
+				require.NoError(t, err)
+				assert.Equal(t, []string{"backend", "auth"}, parsed.Tags)
+			},
+		},
+		{
+			name: "remove tag from ticket",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket("250101-120000-tag-remove", ticket.StatusDoing,
+					testharness.WithTags("backend", "ui"))
+			},
+			args: []string{"remove", "250101-120000-tag-remove", "backend"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				parsed, err := ticket.Parse([]byte(env.ReadFile("tickets/doing/250101-120000-tag-remove.md")))
+				require.NoError(t, err)
+				assert.Equal(t, []string{"ui"}, parsed.Tags)
+			},
+		},
+		{
+			name: "error with invalid tag",
+			setup: func(env *testharness.TestEnvironment) {
+				env.CreateTicket("250101-120000-tag-invalid", ticket.StatusTodo)
+			},
+			args:          []string{"add", "250101-120000-tag-invalid", "bad_tag"},
+			wantError:     true,
+			errorContains: "Invalid tag format",
+		},
+		{
+			name:      "error with non-existent ticket",
+			args:      []string{"add", "non-existent", "backend"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			if tt.setup != nil {
+				tt.setup(env)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			cmd := NewTagCommand()
+			err = cmd.Execute(ctx, nil, tt.args)
+
+			if tt.wantError {
+				require.Error(t, err)
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewTagCommand()
+
+	assert.Equal(t, "tag", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Add or remove ticket tags", cmd.Description())
+	assert.Equal(t, "tag <add|remove> <ticket-id> <tag>...", cmd.Usage())
+}
+
+func TestTagCommand_Execute(t *testing.T) {
+	t.Parallel()
+	cmd := NewTagCommand()
+
+	// No subcommand shows usage
+	err := cmd.Execute(context.Background(), nil, []string{})
+	assert.NoError(t, err)
+
+	err = cmd.Execute(context.Background(), nil, []string{"rename"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown tag subcommand")
+}
+
+func TestTagChangeCommand_Interface(t *testing.T) {
+	t.Parallel()
+	add := NewTagAddCommand()
+	assert.Equal(t, "add", add.Name())
+	assert.Equal(t, "Add tags to a ticket", add.Description())
+	assert.Equal(t, "tag add [--format text|json] <ticket-id> <tag>...", add.Usage())
+
+	remove := NewTagRemoveCommand()
+	assert.Equal(t, "remove", remove.Name())
+	assert.Equal(t, "Remove tags from a ticket", remove.Description())
+	assert.Equal(t, "tag remove [--format text|json] <ticket-id> <tag>...", remove.Usage())
+}
+
+func TestTagChangeCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewTagAddCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	flags := cmd.SetupFlags(fs)
+	tf, ok := flags.(*tagChangeFlags)
+	require.True(t, ok)
+	assert.Equal(t, FormatText, tf.format)
+
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, tf.format)
+}
+
+func TestTagChangeCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       interface{}
+		args        []string
+		errContains string
+	}{
+		{
+			name:  "valid",
+			flags: &tagChangeFlags{format: FormatText},
+			args:  []string{"ticket-1", "backend"},
+		},
+		{
+			name:  "multiple tags",
+			flags: &tagChangeFlags{format: FormatJSON},
+			args:  []string{"ticket-1", "backend", "ui"},
+		},
+		{
+			name:        "missing ticket ID",
+			flags:       &tagChangeFlags{format: FormatText},
+			args:        []string{},
+			errContains: "missing ticket ID argument",
+		},
+		{
+			name:        "missing tag",
+			flags:       &tagChangeFlags{format: FormatText},
+			args:        []string{"ticket-1"},
+			errContains: "missing tag argument",
+		},
+		{
+			name:        "invalid format",
+			flags:       &tagChangeFlags{format: "xml"},
+			args:        []string{"ticket-1", "backend"},
+			errContains: "invalid format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewTagAddCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
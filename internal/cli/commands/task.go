@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+const (
+	// errUnknownTaskSubcommand is the error message for unknown task subcommands
+	errUnknownTaskSubcommand = "unknown task subcommand: %s"
+)
+
+// TaskCommand implements the task parent command using the new Command interface
+type TaskCommand struct {
+	subcommands map[string]command.Command
+}
+
+// NewTaskCommand creates a new task command with its subcommands
+func NewTaskCommand() command.Command {
+	return &TaskCommand{
+		subcommands: map[string]command.Command{
+			"list":    NewTaskListCommand(),
+			"check":   NewTaskCheckCommand(),
+			"uncheck": NewTaskUncheckCommand(),
+		},
+	}
+}
+
+// Name returns the command name
+func (c *TaskCommand) Name() string {
+	return "task"
+}
+
+// Aliases returns alternative names for this command
+func (c *TaskCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TaskCommand) Description() string {
+	return "List, check or uncheck ticket checklist items"
+}
+
+// Usage returns the usage string for the command
+func (c *TaskCommand) Usage() string {
+	return "task <list|check|uncheck> <ticket-id> [<n>]"
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TaskCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	// No flags for the parent command
+	return nil
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TaskCommand) Validate(flags interface{}, args []string) error {
+	// Subcommands handle their own validation
+	return nil
+}
+
+// Execute runs the command with the given context
+func (c *TaskCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(args) == 0 {
+		c.printUsage()
+		return nil
+	}
+
+	subcmdName := args[0]
+	subcmd, ok := c.subcommands[subcmdName]
+	if !ok {
+		c.printUsage()
+		return fmt.Errorf(errUnknownTaskSubcommand, subcmdName)
+	}
+
+	// Parse flags for the subcommand
+	fs := flag.NewFlagSet(fmt.Sprintf("task %s", subcmdName), flag.ContinueOnError)
+	subcmdFlags := subcmd.SetupFlags(fs)
+
+	// Parse remaining arguments
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	// Validate the subcommand
+	if err := subcmd.Validate(subcmdFlags, fs.Args()); err != nil {
+		return err
+	}
+
+	// Execute the subcommand
+	return subcmd.Execute(ctx, subcmdFlags, fs.Args())
+}
+
+// printUsage prints the usage information for the task command
+func (c *TaskCommand) printUsage() {
+	fmt.Println(`TicketFlow Task Management
+
+USAGE:
+  ticketflow task list <ticket-id>          List checklist items with their numbers
+  ticketflow task check <ticket-id> <n>     Mark item n as done
+  ticketflow task uncheck <ticket-id> <n>   Mark item n as not done
+
+DESCRIPTION:
+  Tasks are the Markdown checklist items ("- [ ] ...") in a ticket's content.
+  Items are numbered from 1 in the order they appear; items inside fenced
+  code blocks are ignored. Checking an item only rewrites its checkbox.
+
+EXAMPLES:
+  # Show the checklist of a ticket
+  ticketflow task list 250124-150000-login
+
+  # Tick off the second item
+  ticketflow task check 250124-150000-login 2`)
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// TaskChangeCommand implements the task check and task uncheck subcommands
+type TaskChangeCommand struct {
+	action cli.TaskAction
+}
+
+// NewTaskCheckCommand creates a new task check command
+func NewTaskCheckCommand() command.Command {
+	return &TaskChangeCommand{action: cli.TaskActionCheck}
+}
+
+// NewTaskUncheckCommand creates a new task uncheck command
+func NewTaskUncheckCommand() command.Command {
+	return &TaskChangeCommand{action: cli.TaskActionUncheck}
+}
+
+// Name returns the command name
+func (c *TaskChangeCommand) Name() string {
+	return string(c.action)
+}
+
+// Aliases returns alternative names for this command
+func (c *TaskChangeCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TaskChangeCommand) Description() string {
+	if c.action == cli.TaskActionCheck {
+		return "Mark a checklist item as done"
+	}
+	return "Mark a checklist item as not done"
+}
+
+// Usage returns the usage string for the command
+func (c *TaskChangeCommand) Usage() string {
+	return fmt.Sprintf("task %s [--format text|json] <ticket-id> <n>", c.action)
+}
+
+// taskChangeFlags holds the flags for the task check/uncheck commands
+type taskChangeFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TaskChangeCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &taskChangeFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TaskChangeCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("missing task number argument")
+	}
+	if len(args) > 2 {
+		return fmt.Errorf("unexpected arguments after task number: %v", args[2:])
+	}
+	if _, err := parseTaskNumber(args[1]); err != nil {
+		return err
+	}
+
+	f, err := AssertFlags[taskChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *TaskChangeCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[taskChangeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	n, err := parseTaskNumber(args[1])
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var result *cli.TaskResult
+	if c.action == cli.TaskActionCheck {
+		result, err = app.CheckTicketTask(ctx, args[0], n)
+	} else {
+		result, err = app.UncheckTicketTask(ctx, args[0], n)
+	}
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
+
+// parseTaskNumber parses a 1-based task number
+func parseTaskNumber(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid task number: %q (must be a positive integer)", s)
+	}
+	return n, nil
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestTaskCommand_Execute_Integration(t *testing.T) {
+	const (
+		ticketID = "250101-120000-task"
+		content  = "## Tasks\n- [ ] Write code\n- [x] Write tests\n"
+	)
+
+	tests := []struct {
+		name          string
+		args          []string
+		errorContains string
+		validate      func(*testing.T, *testharness.TestEnvironment)
+	}{
+		{
+			name: "list tasks",
+			args: []string{"list", ticketID},
+		},
+		{
+			name: "check task",
+			args: []string{"check", ticketID, "1"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				data := env.ReadFile(env.TicketPath("todo", ticketID+".md"))
+				assert.Contains(t, data, "- [x] Write code")
+				parsed, err := ticket.Parse([]byte(data))
+				require.NoError(t, err)
+				assert.Equal(t, ticket.TaskProgress{Done: 2, Total: 2}, parsed.TaskProgress())
+			},
+		},
+		{
+			name: "uncheck task",
+			args: []string{"uncheck", ticketID, "2", "--format", "json"},
+			validate: func(t *testing.T, env *testharness.TestEnvironment) {
+				assert.Contains(t, env.ReadFile(env.TicketPath("todo", ticketID+".md")), "- [ ] Write tests")
+			},
+		},
+		{
+			name:          "task out of range",
+			args:          []string{"check", ticketID, "3"},
+			errorContains: "Task not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := testharness.NewTestEnvironment(t)
+
+			oldWd, err := os.Getwd()
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, os.Chdir(oldWd))
+			}()
+			require.NoError(t, os.Chdir(env.RootDir))
+
+			env.CreateTicket(ticketID, ticket.StatusTodo, testharness.WithContent(content))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err = NewTaskCommand().Execute(ctx, nil, tt.args)
+			if tt.errorContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.validate != nil {
+				tt.validate(t, env)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// TaskListCommand implements the task list subcommand
+type TaskListCommand struct{}
+
+// NewTaskListCommand creates a new task list command
+func NewTaskListCommand() command.Command {
+	return &TaskListCommand{}
+}
+
+// Name returns the command name
+func (c *TaskListCommand) Name() string {
+	return "list"
+}
+
+// Aliases returns alternative names for this command
+func (c *TaskListCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TaskListCommand) Description() string {
+	return "List the checklist items of a ticket"
+}
+
+// Usage returns the usage string for the command
+func (c *TaskListCommand) Usage() string {
+	return "task list [--format text|json] <ticket-id>"
+}
+
+// taskListFlags holds the flags for the task list command
+type taskListFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TaskListCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &taskListFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TaskListCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing ticket ID argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[taskListFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *TaskListCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[taskListFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.ListTicketTasks(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewTaskCommand()
+
+	assert.Equal(t, "task", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "List, check or uncheck ticket checklist items", cmd.Description())
+	assert.Equal(t, "task <list|check|uncheck> <ticket-id> [<n>]", cmd.Usage())
+}
+
+func TestTaskCommand_Execute(t *testing.T) {
+	t.Parallel()
+	cmd := NewTaskCommand()
+
+	// No subcommand shows usage
+	err := cmd.Execute(context.Background(), nil, []string{})
+	assert.NoError(t, err)
+
+	err = cmd.Execute(context.Background(), nil, []string{"toggle"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown task subcommand")
+}
+
+func TestTaskListCommand_Validate(t *testing.T) {
+	t.Parallel()
+	cmd := NewTaskListCommand()
+	assert.Equal(t, "task list [--format text|json] <ticket-id>", cmd.Usage())
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*taskListFlags)
+	assert.Equal(t, FormatText, flags.format)
+
+	assert.NoError(t, cmd.Validate(flags, []string{"ticket-1"}))
+	assert.ErrorContains(t, cmd.Validate(flags, nil), "missing ticket ID")
+	assert.ErrorContains(t, cmd.Validate(flags, []string{"ticket-1", "2"}), "unexpected arguments")
+	assert.ErrorContains(t, cmd.Validate(&taskListFlags{format: "xml"}, []string{"ticket-1"}), "invalid format")
+}
+
+func TestTaskChangeCommand_Interface(t *testing.T) {
+	t.Parallel()
+	check := NewTaskCheckCommand()
+	assert.Equal(t, "check", check.Name())
+	assert.Equal(t, "Mark a checklist item as done", check.Description())
+	assert.Equal(t, "task check [--format text|json] <ticket-id> <n>", check.Usage())
+
+	uncheck := NewTaskUncheckCommand()
+	assert.Equal(t, "uncheck", uncheck.Name())
+	assert.Equal(t, "Mark a checklist item as not done", uncheck.Description())
+	assert.Equal(t, "task uncheck [--format text|json] <ticket-id> <n>", uncheck.Usage())
+}
+
+func TestTaskChangeCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       interface{}
+		args        []string
+		errContains string
+	}{
+		{name: "valid", flags: &taskChangeFlags{format: FormatText}, args: []string{"ticket-1", "2"}},
+		{name: "json format", flags: &taskChangeFlags{format: FormatJSON}, args: []string{"ticket-1", "1"}},
+		{name: "missing ticket", flags: &taskChangeFlags{format: FormatText}, errContains: "missing ticket ID"},
+		{name: "missing number", flags: &taskChangeFlags{format: FormatText}, args: []string{"ticket-1"}, errContains: "missing task number"},
+		{name: "zero", flags: &taskChangeFlags{format: FormatText}, args: []string{"ticket-1", "0"}, errContains: "invalid task number"},
+		{name: "not a number", flags: &taskChangeFlags{format: FormatText}, args: []string{"ticket-1", "first"}, errContains: "invalid task number"},
+		{name: "extra args", flags: &taskChangeFlags{format: FormatText}, args: []string{"ticket-1", "1", "2"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &taskChangeFlags{format: "xml"}, args: []string{"ticket-1", "1"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewTaskCheckCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -200,6 +200,9 @@ func (e *TestEnvironment) CreateTicket(id string, status ticket.Status, options
 	if len(t.Related) > 0 {
 		frontmatter["related"] = t.Related
 	}
+	if len(t.Tags) > 0 {
+		frontmatter["tags"] = t.Tags
+	}
 
 	data, err := yaml.Marshal(frontmatter)
 	require.NoError(e.t, err)
@@ -249,6 +252,13 @@ func WithDescription(description string) TicketOption {
 	}
 }
 
+// WithTags sets the ticket tags
+func WithTags(tags ...string) TicketOption {
+	return func(t *ticket.Ticket) {
+		t.Tags = append(t.Tags, tags...)
+	}
+}
+
 // TicketPath returns the path to a ticket file
 func (e *TestEnvironment) TicketPath(status, filename string) string {
 	return filepath.Join("tickets", status, filename)
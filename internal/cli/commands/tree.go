@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// TreeCommand implements the tree command
+type TreeCommand struct{}
+
+// NewTreeCommand creates a new tree command
+func NewTreeCommand() command.Command {
+	return &TreeCommand{}
+}
+
+// Name returns the command name
+func (c *TreeCommand) Name() string {
+	return "tree"
+}
+
+// Aliases returns alternative names for this command
+func (c *TreeCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *TreeCommand) Description() string {
+	return "Show tickets and their sub-tickets as a tree"
+}
+
+// Usage returns the usage string for the command
+func (c *TreeCommand) Usage() string {
+	return "tree [--format text|json] [<root-ticket-id>]"
+}
+
+// treeFlags holds the flags for the tree command
+type treeFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *TreeCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &treeFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *TreeCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after ticket ID: %v", args[1:])
+	}
+
+	f, err := AssertFlags[treeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *TreeCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[treeFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	var rootID string
+	if len(args) > 0 {
+		rootID = args[0]
+	}
+
+	result, err := app.TicketTree(ctx, rootID)
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestTreeCommand_Execute_Integration(t *testing.T) {
+	const (
+		epicID  = "250101-120000-epic"
+		childID = "250102-120000-child"
+		leafID  = "250103-120000-leaf"
+		otherID = "250104-120000-other"
+	)
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.CreateTicket(epicID, ticket.StatusDoing, testharness.WithDescription("Epic"))
+	env.CreateTicket(childID, ticket.StatusDone, testharness.WithParent(epicID))
+	env.CreateTicket(leafID, ticket.StatusTodo, testharness.WithParent(childID))
+	env.CreateTicket(otherID, ticket.StatusTodo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output := testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewTreeCommand().Execute(ctx, &treeFlags{format: FormatText}, []string{epicID}))
+	})
+	assert.Contains(t, output, epicID+" [doing] Epic  (sub-tickets 1/2 closed)")
+	assert.Contains(t, output, "└── "+childID+" [done]")
+	assert.Contains(t, output, "    └── "+leafID+" [todo]")
+	assert.NotContains(t, output, otherID)
+
+	output = testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewTreeCommand().Execute(ctx, &treeFlags{format: FormatJSON}, nil))
+	})
+	jsonData := testharness.ValidateJSON(t, output)
+	testharness.AssertJSONArrayLength(t, jsonData, "tree", 2)
+
+	var epic map[string]interface{}
+	for _, node := range jsonData["tree"].([]interface{}) {
+		n := node.(map[string]interface{})
+		if n["ticket"].(map[string]interface{})["id"] == epicID {
+			epic = n
+		}
+	}
+	require.NotNil(t, epic)
+	testharness.AssertJSONField(t, epic, "rollup.total", float64(2))
+	testharness.AssertJSONField(t, epic, "rollup.by_state.todo", float64(1))
+	children := epic["children"].([]interface{})
+	require.Len(t, children, 1)
+	grandchildren := children[0].(map[string]interface{})["children"].([]interface{})
+	require.Len(t, grandchildren, 1)
+	testharness.AssertJSONField(t, grandchildren[0].(map[string]interface{}), "ticket.id", leafID)
+
+	// list --tree applies the list filters: the done child is hidden, so its
+	// sub-ticket is shown as a top-level ticket
+	flags := &listFlags{count: defaultCount, countShort: defaultCount, tree: true, format: FormatText}
+	require.NoError(t, NewListCommand().Validate(flags, nil))
+	output = testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewListCommand().Execute(ctx, flags, nil))
+	})
+	assert.Contains(t, output, epicID+" [doing] Epic\n")
+	assert.Contains(t, output, leafID+" [todo]")
+	assert.NotContains(t, output, childID)
+
+	err = NewTreeCommand().Execute(ctx, &treeFlags{format: FormatText}, []string{"no-such-ticket"})
+	assert.Error(t, err)
+}
+
+func TestTreeCommand_Execute_ArchivedRoot(t *testing.T) {
+	const (
+		epicID     = "240101-120000-epic"
+		doneID     = "240102-120000-done-child"
+		activeID   = "250102-120000-active-child"
+		archiveDir = "tickets/done/archive/2024"
+	)
+
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.CreateTicket(epicID, ticket.StatusDone, testharness.WithDescription("Epic"))
+	env.CreateTicket(doneID, ticket.StatusDone, testharness.WithParent(epicID))
+	env.CreateTicket(activeID, ticket.StatusTodo, testharness.WithParent(epicID))
+	require.NoError(t, os.MkdirAll(archiveDir, 0755))
+	for _, id := range []string{epicID, doneID} {
+		require.NoError(t, os.Rename(env.TicketPath("done", id+".md"), filepath.Join(archiveDir, id+".md")))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// The archived epic is shown with its archived and active sub-tickets
+	output := testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewTreeCommand().Execute(ctx, &treeFlags{format: FormatText}, []string{epicID}))
+	})
+	assert.Contains(t, output, epicID+" [archived] Epic  (sub-tickets 1/2 closed)")
+	assert.Contains(t, output, doneID+" [archived]")
+	assert.Contains(t, output, activeID+" [todo]")
+
+	// The full tree leaves archived tickets out and shows their sub-tickets as roots
+	output = testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewTreeCommand().Execute(ctx, &treeFlags{format: FormatJSON}, nil))
+	})
+	jsonData := testharness.ValidateJSON(t, output)
+	testharness.AssertJSONArrayLength(t, jsonData, "tree", 1)
+	testharness.AssertJSONField(t, jsonData["tree"].([]interface{})[0].(map[string]interface{}), "ticket.id", activeID)
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewTreeCommand()
+
+	assert.Equal(t, "tree", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Show tickets and their sub-tickets as a tree", cmd.Description())
+	assert.Equal(t, "tree [--format text|json] [<root-ticket-id>]", cmd.Usage())
+}
+
+func TestTreeCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewTreeCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*treeFlags)
+
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestTreeCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *treeFlags
+		args        []string
+		errContains string
+	}{
+		{name: "all tickets", flags: &treeFlags{format: FormatText}},
+		{name: "root ticket", flags: &treeFlags{format: FormatJSON}, args: []string{"250101-120000-epic"}},
+		{name: "extra args", flags: &treeFlags{format: FormatText}, args: []string{"250101-120000-epic", "extra"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &treeFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewTreeCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/yshrsmz/ticketflow/internal/cli"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 )
 
@@ -14,6 +15,9 @@ import (
 const (
 	// ErrInvalidFormat is the error message template for invalid format values
 	ErrInvalidFormat = "invalid format: %q (must be %q or %q)"
+	// ErrInvalidExtendedFormat is the error message template for invalid format
+	// values of commands that accept the additional formats
+	ErrInvalidExtendedFormat = "invalid format: %q (must be one of %s)"
 	// ErrInvalidFlags is the error message template for invalid flag type assertions
 	ErrInvalidFlags = "invalid flags type: expected *%T, got %T"
 )
@@ -27,6 +31,23 @@ func ValidateFormat(format string) error {
 	return nil
 }
 
+// ValidateExtendedFormat validates the format of commands whose output can also
+// be rendered as YAML, CSV, a Markdown table, NDJSON or a Go template
+// ("template=<template>"). Returns an error if the format or template is invalid.
+func ValidateExtendedFormat(format string) error {
+	switch format {
+	case FormatText, FormatJSON, FormatYAML, FormatCSV, FormatMarkdown, FormatNDJSON:
+		return nil
+	}
+	if text, ok := strings.CutPrefix(format, FormatTemplatePrefix); ok {
+		if _, err := cli.ParseOutputTemplate(text); err != nil {
+			return fmt.Errorf("invalid output template: %w", err)
+		}
+		return nil
+	}
+	return fmt.Errorf(ErrInvalidExtendedFormat, format, strings.ReplaceAll(extendedFormatsHelp, "|", ", "))
+}
+
 // ExtractParentFromTicket extracts the parent ticket ID from a ticket's related field.
 // Returns an empty string if the ticket is nil, has no related items, or has no parent.
 func ExtractParentFromTicket(t *ticket.Ticket) string {
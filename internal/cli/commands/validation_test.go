@@ -231,3 +231,33 @@ func TestAssertFlags(t *testing.T) {
 		assert.Equal(t, 10, result.count)
 	})
 }
+
+func TestValidateExtendedFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		errMsg string
+	}{
+		{name: "text", format: FormatText},
+		{name: "json", format: FormatJSON},
+		{name: "yaml", format: FormatYAML},
+		{name: "csv", format: FormatCSV},
+		{name: "markdown", format: FormatMarkdown},
+		{name: "ndjson", format: FormatNDJSON},
+		{name: "template", format: "template={{.id}} {{.status}}"},
+		{name: "invalid template", format: "template={{.id", errMsg: "invalid output template: template: output:1: unclosed action"},
+		{name: "unknown format", format: "xml", errMsg: `invalid format: "xml" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`},
+		{name: "empty format", format: "", errMsg: `invalid format: "" (must be one of text, json, yaml, csv, markdown, ndjson, template=TEMPLATE)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExtendedFormat(tt.format)
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// ViewsCommand implements the views command
+type ViewsCommand struct{}
+
+// NewViewsCommand creates a new views command
+func NewViewsCommand() command.Command {
+	return &ViewsCommand{}
+}
+
+// Name returns the command name
+func (c *ViewsCommand) Name() string {
+	return "views"
+}
+
+// Aliases returns alternative names for this command
+func (c *ViewsCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *ViewsCommand) Description() string {
+	return "List saved views defined in the configuration"
+}
+
+// Usage returns the usage string for the command
+func (c *ViewsCommand) Usage() string {
+	return "views [--format text|json]"
+}
+
+// viewsFlags holds the flags for the views command
+type viewsFlags struct {
+	format string
+}
+
+// SetupFlags configures the flag set for this command
+func (c *ViewsCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &viewsFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the provided flags and arguments are valid
+func (c *ViewsCommand) Validate(flags interface{}, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	f, err := AssertFlags[viewsFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the command with the given context
+func (c *ViewsCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[viewsFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.ListViews()
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
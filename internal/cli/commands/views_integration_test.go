@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+	"gopkg.in/yaml.v3"
+)
+
+func TestViewsCommand_Execute_Integration(t *testing.T) {
+	env := testharness.NewTestEnvironment(t)
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, os.Chdir(oldWd))
+	}()
+	require.NoError(t, os.Chdir(env.RootDir))
+
+	env.Config.Views = []config.ViewConfig{
+		{Name: "auth-work", Description: "Open auth tickets", Status: []string{"todo", "doing"}, Match: "auth", Sort: "-id"},
+		{Name: "latest", Status: []string{"all"}, Sort: "-id", Count: 1},
+	}
+	data, err := yaml.Marshal(env.Config)
+	require.NoError(t, err)
+	env.WriteFile(".ticketflow.yaml", string(data))
+
+	env.CreateTicket("250101-120000-auth-login", ticket.StatusTodo)
+	env.CreateTicket("250102-120000-auth-logout", ticket.StatusDoing)
+	env.CreateTicket("250103-120000-auth-token", ticket.StatusDone)
+	env.CreateTicket("250104-120000-docs", ticket.StatusTodo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	output := testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewViewsCommand().Execute(ctx, &viewsFlags{format: FormatText}, nil))
+	})
+	assert.Contains(t, output, "auth-work - Open auth tickets")
+	assert.Contains(t, output, "  Status: todo, doing")
+	assert.Contains(t, output, "  Filter: (status=todo or status=doing) and (slug~auth or description~auth)")
+	assert.Contains(t, output, "  Count:  1")
+
+	output = testharness.CaptureOutput(t, func() {
+		require.NoError(t, NewViewsCommand().Execute(ctx, &viewsFlags{format: FormatJSON}, nil))
+	})
+	jsonData := testharness.ValidateJSON(t, output)
+	testharness.AssertJSONArrayLength(t, jsonData, "views", 2)
+
+	listIDs := func(args ...string) []string {
+		t.Helper()
+		fs := flag.NewFlagSet("list", flag.ContinueOnError)
+		flags := NewListCommand().SetupFlags(fs).(*listFlags)
+		require.NoError(t, fs.Parse(append(args, "--format", FormatJSON)))
+		require.NoError(t, NewListCommand().Validate(flags, nil))
+		output := testharness.CaptureOutput(t, func() {
+			require.NoError(t, NewListCommand().Execute(ctx, flags, nil))
+		})
+		var parsed struct {
+			Tickets []struct {
+				ID string `json:"id"`
+			} `json:"tickets"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &parsed))
+		ids := make([]string, 0, len(parsed.Tickets))
+		for _, tk := range parsed.Tickets {
+			ids = append(ids, tk.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"250102-120000-auth-logout", "250101-120000-auth-login"},
+		listIDs("--view", "auth-work"))
+	assert.Equal(t, []string{"250104-120000-docs"},
+		listIDs("--view", "latest"))
+	// Explicit flags refine the view
+	assert.Equal(t, []string{"250104-120000-docs", "250103-120000-auth-token"},
+		listIDs("--view", "latest", "--count", "2"))
+	// An explicit count equal to the flag default still overrides the view
+	assert.Len(t, listIDs("--view", "latest", "--count", "20"), 4)
+	assert.Len(t, listIDs("--view", "latest", "--c", "20"), 4)
+	assert.Equal(t, []string{"250101-120000-auth-login"},
+		listIDs("--view", "auth-work", "--status", "todo"))
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewsCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewViewsCommand()
+
+	assert.Equal(t, "views", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "List saved views defined in the configuration", cmd.Description())
+	assert.Equal(t, "views [--format text|json]", cmd.Usage())
+}
+
+func TestViewsCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewViewsCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*viewsFlags)
+
+	assert.Equal(t, FormatText, flags.format)
+
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestViewsCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *viewsFlags
+		args        []string
+		errContains string
+	}{
+		{name: "no flags", flags: &viewsFlags{format: FormatText}},
+		{name: "json", flags: &viewsFlags{format: FormatJSON}},
+		{name: "unexpected args", flags: &viewsFlags{format: FormatText}, args: []string{"mine"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &viewsFlags{format: "xml"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewViewsCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/yshrsmz/ticketflow/internal/cli"
+	"github.com/yshrsmz/ticketflow/internal/command"
+)
+
+// WhichCommand implements the which command using the new Command interface
+type WhichCommand struct{}
+
+// NewWhichCommand creates a new which command
+func NewWhichCommand() command.Command {
+	return &WhichCommand{}
+}
+
+// Name returns the command name
+func (c *WhichCommand) Name() string {
+	return "which"
+}
+
+// Aliases returns alternative names for this command
+func (c *WhichCommand) Aliases() []string {
+	return nil
+}
+
+// Description returns a short description of the command
+func (c *WhichCommand) Description() string {
+	return "Show the ticket a commit or line of code belongs to"
+}
+
+// Usage returns the usage string for the command
+func (c *WhichCommand) Usage() string {
+	return "which [--format text|json] <rev|file:line>"
+}
+
+// whichFlags holds the flags for the which command
+type whichFlags struct {
+	format string
+}
+
+// SetupFlags configures flags for the command
+func (c *WhichCommand) SetupFlags(fs *flag.FlagSet) interface{} {
+	flags := &whichFlags{}
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text|json)")
+	return flags
+}
+
+// Validate checks if the command arguments are valid
+func (c *WhichCommand) Validate(flags interface{}, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("missing revision or file:line argument")
+	}
+	if len(args) > 1 {
+		return fmt.Errorf("unexpected arguments after %s: %v", args[0], args[1:])
+	}
+
+	f, err := AssertFlags[whichFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFormat(f.format)
+}
+
+// Execute runs the which command
+func (c *WhichCommand) Execute(ctx context.Context, flags interface{}, args []string) error {
+	// Check if context is already cancelled
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	f, err := AssertFlags[whichFlags](flags)
+	if err != nil {
+		return err
+	}
+
+	// Parse output format first
+	outputFormat := cli.ParseOutputFormat(f.format)
+	cli.SetGlobalOutputFormat(outputFormat) // Ensure errors are formatted correctly
+
+	app, err := cli.NewAppWithFormat(ctx, outputFormat)
+	if err != nil {
+		return err
+	}
+
+	result, err := app.Which(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return app.Output.PrintResult(result)
+}
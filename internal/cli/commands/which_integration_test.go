@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/cli/commands/testharness"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestWhichCommand_Execute_Integration(t *testing.T) {
+	const (
+		ticketID = "250101-120000-add-feature"
+		otherID  = "250102-090000-fix-bug"
+	)
+
+	setup := func(t *testing.T) *testharness.TestEnvironment {
+		env := testharness.NewTestEnvironment(t)
+
+		oldWd, err := os.Getwd()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, os.Chdir(oldWd))
+		})
+		require.NoError(t, os.Chdir(env.RootDir))
+
+		env.CreateTicket(ticketID, ticket.StatusDoing, testharness.WithDescription("Add the feature"))
+		env.CreateTicket(otherID, ticket.StatusDoing, testharness.WithDescription("Fix the bug"))
+		env.WriteFile("app.txt", "base\n")
+		env.RunGit("add", ".")
+		env.RunGit("commit", "-m", "Start tickets")
+		return env
+	}
+
+	run := func(t *testing.T, format string, target string) (string, error) {
+		cmd := NewWhichCommand()
+		flags := &whichFlags{format: format}
+		require.NoError(t, cmd.Validate(flags, []string{target}))
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		var err error
+		output := testharness.CaptureOutput(t, func() {
+			err = cmd.Execute(ctx, flags, []string{target})
+		})
+		return output, err
+	}
+
+	t.Run("finds the ticket from the Ticket trailer", func(t *testing.T) {
+		env := setup(t)
+		env.WriteFile("app.txt", "base\nfeature\n")
+		env.RunGit("commit", "-am", "Implement feature\n\nTicket: "+ticketID)
+
+		output, err := run(t, FormatText, "HEAD")
+		require.NoError(t, err)
+		assert.Contains(t, output, "Commit: ")
+		assert.Contains(t, output, "Implement feature\n")
+		assert.Contains(t, output, "Found via: Ticket trailer\n")
+		assert.Contains(t, output, "ID: "+ticketID+"\n")
+		assert.Contains(t, output, "Description: Add the feature\n")
+	})
+
+	t.Run("finds the ticket from the branch of a line", func(t *testing.T) {
+		env := setup(t)
+		env.RunGit("checkout", "-b", ticketID)
+		env.WriteFile("app.txt", "base\nfeature\n")
+		env.RunGit("commit", "-am", "Implement feature")
+		// A later branch off the ticket branch also contains the commit
+		env.RunGit("checkout", "-b", otherID)
+
+		output, err := run(t, FormatJSON, "app.txt:2")
+		require.NoError(t, err)
+
+		jsonData := testharness.ValidateJSON(t, output)
+		testharness.AssertJSONField(t, jsonData, "source", "branch")
+		testharness.AssertJSONField(t, jsonData, "ref", ticketID)
+		testharness.AssertJSONField(t, jsonData, "file", "app.txt")
+		testharness.AssertJSONField(t, jsonData, "line", float64(2))
+		tk := testharness.GetJSONField(jsonData, "ticket").(map[string]interface{})
+		assert.Equal(t, ticketID, tk["id"])
+		commit := testharness.GetJSONField(jsonData, "commit").(map[string]interface{})
+		assert.Equal(t, "Implement feature", commit["subject"])
+	})
+
+	t.Run("finds the ticket from the merge commit", func(t *testing.T) {
+		env := setup(t)
+		env.RunGit("checkout", "-b", ticketID)
+		env.WriteFile("app.txt", "base\nfeature\n")
+		env.RunGit("commit", "-am", "Implement feature")
+		env.RunGit("checkout", "main")
+		env.RunGit("merge", "--no-ff", ticketID, "-m", "Merge branch '"+ticketID+"'")
+
+		output, err := run(t, FormatText, "app.txt:2")
+		require.NoError(t, err)
+		assert.Contains(t, output, "Line: app.txt:2\n")
+		assert.Contains(t, output, "Found via: merge commit ")
+		assert.Contains(t, output, "ID: "+ticketID+"\n")
+
+		// Lines that were on main before the merge do not belong to the ticket
+		_, err = run(t, FormatText, "app.txt:1")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "No ticket found for commit")
+	})
+
+	t.Run("finds the ticket from a squash commit message", func(t *testing.T) {
+		env := setup(t)
+		env.WriteFile("app.txt", "base\nfix\n")
+		env.RunGit("commit", "-am", "Fix the bug ("+otherID+")")
+
+		output, err := run(t, FormatText, "main")
+		require.NoError(t, err)
+		assert.Contains(t, output, "Found via: commit message\n")
+		assert.Contains(t, output, "ID: "+otherID+"\n")
+	})
+
+	t.Run("reports a missing ticket", func(t *testing.T) {
+		env := setup(t)
+		env.RunGit("commit", "--allow-empty", "-m", "Cleanup\n\nTicket: 250103-100000-deleted")
+
+		_, err := run(t, FormatText, "HEAD")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Ticket not found")
+	})
+
+	t.Run("unknown revision", func(t *testing.T) {
+		setup(t)
+
+		_, err := run(t, FormatText, "no-such-branch")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unknown revision")
+	})
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWhichCommand_Interface(t *testing.T) {
+	t.Parallel()
+	cmd := NewWhichCommand()
+
+	assert.Equal(t, "which", cmd.Name())
+	assert.Nil(t, cmd.Aliases())
+	assert.Equal(t, "Show the ticket a commit or line of code belongs to", cmd.Description())
+	assert.Equal(t, "which [--format text|json] <rev|file:line>", cmd.Usage())
+}
+
+func TestWhichCommand_SetupFlags(t *testing.T) {
+	t.Parallel()
+	cmd := NewWhichCommand()
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := cmd.SetupFlags(fs).(*whichFlags)
+
+	assert.Equal(t, FormatText, flags.format)
+	require.NoError(t, fs.Parse([]string{"-o", "json"}))
+	assert.Equal(t, FormatJSON, flags.format)
+}
+
+func TestWhichCommand_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		flags       *whichFlags
+		args        []string
+		errContains string
+	}{
+		{name: "revision", flags: &whichFlags{format: FormatText}, args: []string{"HEAD~2"}},
+		{name: "file and line", flags: &whichFlags{format: FormatJSON}, args: []string{"main.go:42"}},
+		{name: "missing target", flags: &whichFlags{format: FormatText}, errContains: "missing revision"},
+		{name: "unexpected args", flags: &whichFlags{format: FormatText}, args: []string{"HEAD", "main"}, errContains: "unexpected arguments"},
+		{name: "invalid format", flags: &whichFlags{format: "xml"}, args: []string{"HEAD"}, errContains: "invalid format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewWhichCommand().Validate(tt.flags, tt.args)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -50,7 +50,9 @@ func (c *WorktreeCommand) Usage() string {
 
 // SetupFlags configures the flag set for this command
 func (c *WorktreeCommand) SetupFlags(fs *flag.FlagSet) interface{} {
-	// No flags for the parent command
+	// No flags for the parent command. Flags after the subcommand name are
+	// left unparsed, so the subcommand can parse its own flags.
+	fs.SetInterspersed(false)
 	return nil
 }
 
@@ -105,8 +107,8 @@ func (c *WorktreeCommand) printUsage() {
 	fmt.Println(`TicketFlow Worktree Management
 
 USAGE:
-  ticketflow worktree list [--format json]   List all worktrees
-  ticketflow worktree clean                   Remove orphaned worktrees
+  ticketflow worktree list [--format FORMAT]   List all worktrees
+  ticketflow worktree clean                     Remove orphaned worktrees
 
 DESCRIPTION:
   The worktree command manages git worktrees associated with tickets.
@@ -126,7 +126,7 @@ func TestWorktreeCommand_Execute_ListWithInvalidFormat_Integration(t *testing.T)
 	env.WithWorkingDirectory(t, func() {
 		cmd := NewWorktreeCommand()
 		// Test invalid format value
-		err := cmd.Execute(context.Background(), nil, []string{"list", "--format=yaml"})
+		err := cmd.Execute(context.Background(), nil, []string{"list", "--format=xml"})
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "format")
 	})
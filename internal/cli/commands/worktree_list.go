@@ -34,7 +34,7 @@ func (c *WorktreeListCommand) Description() string {
 
 // Usage returns the usage string for the command
 func (c *WorktreeListCommand) Usage() string {
-	return "worktree list [--format json]"
+	return "worktree list [--format FORMAT]"
 }
 
 // worktreeListFlags holds the flags for the worktree list command
@@ -46,7 +46,7 @@ type worktreeListFlags struct {
 func (c *WorktreeListCommand) SetupFlags(fs *flag.FlagSet) interface{} {
 	flags := &worktreeListFlags{}
 	// Phase 1: Use StringVarP for proper shorthand support with pflag
-	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format (text, json)")
+	fs.StringVarP(&flags.format, "format", "o", FormatText, "Output format ("+extendedFormatsHelp+")")
 	return flags
 }
 
@@ -66,7 +66,7 @@ func (c *WorktreeListCommand) Validate(flags interface{}, args []string) error {
 
 	// Validate format (empty string defaults to text which is valid)
 	if f.format != "" {
-		if err := ValidateFormat(f.format); err != nil {
+		if err := ValidateExtendedFormat(f.format); err != nil {
 			return err
 		}
 	}
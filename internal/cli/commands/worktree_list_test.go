@@ -14,7 +14,7 @@ func TestWorktreeListCommand_Interface(t *testing.T) {
 	assert.Equal(t, "list", cmd.Name())
 	assert.Nil(t, cmd.Aliases())
 	assert.Equal(t, "List all worktrees", cmd.Description())
-	assert.Equal(t, "worktree list [--format json]", cmd.Usage())
+	assert.Equal(t, "worktree list [--format FORMAT]", cmd.Usage())
 }
 
 func TestWorktreeListCommand_SetupFlags(t *testing.T) {
@@ -63,7 +63,7 @@ func TestWorktreeListCommand_Validate(t *testing.T) {
 		},
 		{
 			name:        "invalid format",
-			flags:       &worktreeListFlags{format: "yaml"},
+			flags:       &worktreeListFlags{format: "xml"},
 			args:        []string{},
 			wantErr:     true,
 			errContains: "invalid format",
@@ -28,6 +28,10 @@ func TestWorktreeCommand_SetupFlags(t *testing.T) {
 
 	// No flags for parent command
 	assert.Nil(t, flags)
+
+	// Subcommand flags are left for the subcommand to parse
+	require.NoError(t, fs.Parse([]string{"list", "--format", "csv"}))
+	assert.Equal(t, []string{"list", "--format", "csv"}, fs.Args())
 }
 
 func TestWorktreeCommand_Validate(t *testing.T) {
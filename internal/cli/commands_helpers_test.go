@@ -13,23 +13,25 @@ import (
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 )
 
-func TestCountTicketsByStatus(t *testing.T) {
+func TestCountTicketsByState(t *testing.T) {
 	t.Parallel()
 	now := time.Now()
 
+	review := ticket.Ticket{Path: "/review/ticket7.md", StartedAt: ticket.RFC3339TimePtr{Time: &now}}
+	review.SetState("review")
+	cancelled := ticket.Ticket{Path: "/cancelled/ticket8.md", ClosedAt: ticket.RFC3339TimePtr{Time: &now}}
+	cancelled.SetState(ticket.StatusCancelled)
+
 	tests := []struct {
-		name      string
-		tickets   []ticket.Ticket
-		wantTodo  int
-		wantDoing int
-		wantDone  int
+		name    string
+		states  []config.StateConfig
+		tickets []ticket.Ticket
+		want    map[string]int
 	}{
 		{
-			name:      "empty list",
-			tickets:   []ticket.Ticket{},
-			wantTodo:  0,
-			wantDoing: 0,
-			wantDone:  0,
+			name:    "empty list",
+			tickets: []ticket.Ticket{},
+			want:    map[string]int{"total": 0, "todo": 0, "doing": 0, "done": 0, "cancelled": 0},
 		},
 		{
 			name: "mixed statuses",
@@ -40,10 +42,9 @@ func TestCountTicketsByStatus(t *testing.T) {
 				{Path: "/done/ticket4.md", StartedAt: ticket.RFC3339TimePtr{Time: &now}, ClosedAt: ticket.RFC3339TimePtr{Time: &now}}, // Done
 				{Path: "/done/ticket5.md", StartedAt: ticket.RFC3339TimePtr{Time: &now}, ClosedAt: ticket.RFC3339TimePtr{Time: &now}}, // Done
 				{Path: "/done/ticket6.md", StartedAt: ticket.RFC3339TimePtr{Time: &now}, ClosedAt: ticket.RFC3339TimePtr{Time: &now}}, // Done
+				cancelled, // Cancelled - counted separately from done
 			},
-			wantTodo:  2,
-			wantDoing: 1,
-			wantDone:  3,
+			want: map[string]int{"total": 7, "todo": 2, "doing": 1, "done": 3, "cancelled": 1},
 		},
 		{
 			name: "all todo",
@@ -51,19 +52,31 @@ func TestCountTicketsByStatus(t *testing.T) {
 				{Path: "/todo/ticket1.md"},
 				{Path: "/todo/ticket2.md"},
 			},
-			wantTodo:  2,
-			wantDoing: 0,
-			wantDone:  0,
+			want: map[string]int{"total": 2, "todo": 2, "doing": 0, "done": 0, "cancelled": 0},
+		},
+		{
+			name: "custom states",
+			states: []config.StateConfig{
+				{Name: "todo", Dir: "todo", Active: true},
+				{Name: "doing", Dir: "doing", Active: true},
+				{Name: "review", Dir: "review", Active: true},
+				{Name: "done", Dir: "done"},
+			},
+			tickets: []ticket.Ticket{
+				{Path: "/todo/ticket1.md"},
+				{Path: "/doing/ticket3.md", StartedAt: ticket.RFC3339TimePtr{Time: &now}},
+				review,
+			},
+			want: map[string]int{"total": 3, "todo": 1, "doing": 1, "review": 1, "done": 0, "cancelled": 0},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			app := &App{Output: NewOutputWriter(nil, nil, FormatText)}
-			todoCount, doingCount, doneCount := app.countTicketsByStatus(tt.tickets)
-			assert.Equal(t, tt.wantTodo, todoCount, "todo count mismatch")
-			assert.Equal(t, tt.wantDoing, doingCount, "doing count mismatch")
-			assert.Equal(t, tt.wantDone, doneCount, "done count mismatch")
+			cfg := config.Default()
+			cfg.Tickets.States = tt.states
+			app := &App{Config: cfg, Output: NewOutputWriter(nil, nil, FormatText)}
+			assert.Equal(t, tt.want, app.countTicketsByState(tt.tickets))
 		})
 	}
 }
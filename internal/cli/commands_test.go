@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -215,6 +216,259 @@ func TestApp_ListTickets(t *testing.T) {
 	}
 }
 
+func TestApp_ListTicketsWithOptions_Tags(t *testing.T) {
+	t.Parallel()
+
+	tickets := []ticket.Ticket{
+		{ID: "backend-ui", Priority: 1, Tags: []string{"backend", "ui"}},
+		{ID: "backend-only", Priority: 2, Tags: []string{"backend"}},
+		{ID: "untagged", Priority: 2},
+	}
+
+	tests := []struct {
+		name     string
+		tags     []string
+		expected []string
+	}{
+		{name: "single tag", tags: []string{"backend"}, expected: []string{"backend-ui", "backend-only"}},
+		{name: "all tags must match", tags: []string{"backend", "UI"}, expected: []string{"backend-ui"}},
+		{name: "no match", tags: []string{"docs"}, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockManager := new(mocks.MockTicketManager)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterActive).Return(tickets, nil)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterAll).Return(tickets, nil)
+
+			var stdout strings.Builder
+			app := &App{
+				Config:  config.Default(),
+				Manager: mockManager,
+				Output:  NewOutputWriter(&stdout, nil, FormatJSON),
+			}
+
+			err := app.ListTicketsWithOptions(context.Background(), ListOptions{Tags: tt.tags})
+			require.NoError(t, err)
+
+			var parsed struct {
+				Tickets []struct {
+					ID string `json:"id"`
+				} `json:"tickets"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(stdout.String()), &parsed))
+			ids := make([]string, 0, len(parsed.Tickets))
+			for _, pt := range parsed.Tickets {
+				ids = append(ids, pt.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	t.Run("invalid tag", func(t *testing.T) {
+		mockManager := new(mocks.MockTicketManager)
+		mockManager.On("List", mock.Anything, ticket.StatusFilterActive).Return(tickets, nil)
+		app := &App{
+			Config:  config.Default(),
+			Manager: mockManager,
+			Output:  NewOutputWriter(nil, nil, FormatText),
+		}
+
+		err := app.ListTicketsWithOptions(context.Background(), ListOptions{Tags: []string{"bad tag"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid tag format")
+	})
+}
+
+func TestApp_ListTicketsWithOptions_Query(t *testing.T) {
+	t.Parallel()
+
+	created := func(day int) ticket.RFC3339Time {
+		return ticket.NewRFC3339Time(time.Date(2025, 1, day, 12, 0, 0, 0, time.Local))
+	}
+	tickets := []ticket.Ticket{
+		{ID: "250101-120000-auth-login", Slug: "auth-login", Priority: 1, CreatedAt: created(1)},
+		{ID: "250103-120000-auth-logout", Slug: "auth-logout", Priority: 1, CreatedAt: created(3)},
+		{ID: "250102-120000-docs", Slug: "docs", Priority: 3, CreatedAt: created(2)},
+		{ID: "250104-120000-auth-token", Slug: "auth-token", Priority: 2, CreatedAt: created(4)},
+	}
+
+	tests := []struct {
+		name     string
+		opts     ListOptions
+		expected []string
+	}{
+		{
+			name:     "filter",
+			opts:     ListOptions{Filter: "slug~auth and priority<=1"},
+			expected: []string{"250101-120000-auth-login", "250103-120000-auth-logout"},
+		},
+		{
+			name:     "filter and sort",
+			opts:     ListOptions{Filter: "slug~auth", Sort: "priority,-created"},
+			expected: []string{"250103-120000-auth-logout", "250101-120000-auth-login", "250104-120000-auth-token"},
+		},
+		{
+			name:     "offset and count",
+			opts:     ListOptions{Sort: "created", Offset: 1, Count: 2},
+			expected: []string{"250102-120000-docs", "250103-120000-auth-logout"},
+		},
+		{
+			name:     "offset past the end",
+			opts:     ListOptions{Offset: 10},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockManager := new(mocks.MockTicketManager)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterActive).Return(tickets, nil)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterAll).Return(tickets, nil)
+
+			var stdout strings.Builder
+			app := &App{
+				Config:  config.Default(),
+				Manager: mockManager,
+				Output:  NewOutputWriter(&stdout, nil, FormatJSON),
+			}
+
+			require.NoError(t, app.ListTicketsWithOptions(context.Background(), tt.opts))
+
+			var parsed struct {
+				Tickets []struct {
+					ID string `json:"id"`
+				} `json:"tickets"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(stdout.String()), &parsed))
+			ids := make([]string, 0, len(parsed.Tickets))
+			for _, pt := range parsed.Tickets {
+				ids = append(ids, pt.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	t.Run("invalid filter", func(t *testing.T) {
+		app := &App{Config: config.Default(), Manager: new(mocks.MockTicketManager), Output: NewOutputWriter(nil, nil, FormatText)}
+		err := app.ListTicketsWithOptions(context.Background(), ListOptions{Filter: "priority<=high"})
+		require.Error(t, err)
+		var cliErr *CLIError
+		require.ErrorAs(t, err, &cliErr)
+		assert.Equal(t, ErrValidation, cliErr.Code)
+		assert.Equal(t, "Invalid filter expression", cliErr.Message)
+		assert.Contains(t, cliErr.Details, "priority must be a number")
+	})
+
+	t.Run("invalid sort", func(t *testing.T) {
+		app := &App{Config: config.Default(), Manager: new(mocks.MockTicketManager), Output: NewOutputWriter(nil, nil, FormatText)}
+		err := app.ListTicketsWithOptions(context.Background(), ListOptions{Sort: "size"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid sort order")
+	})
+}
+
+func TestApp_ListTicketsWithOptions_View(t *testing.T) {
+	t.Parallel()
+
+	created := func(day int) ticket.RFC3339Time {
+		return ticket.NewRFC3339Time(time.Date(2025, 1, day, 12, 0, 0, 0, time.Local))
+	}
+	tickets := []ticket.Ticket{
+		{ID: "250101-120000-auth-login", Slug: "auth-login", Priority: 1, CreatedAt: created(1)},
+		{ID: "250103-120000-auth-logout", Slug: "auth-logout", Priority: 1, CreatedAt: created(3)},
+		{ID: "250102-120000-docs", Slug: "docs", Priority: 3, CreatedAt: created(2)},
+		{ID: "250104-120000-auth-token", Slug: "auth-token", Priority: 2, CreatedAt: created(4)},
+	}
+	tickets[0].SetState(ticket.StatusDoing)
+	tickets[1].SetState(ticket.StatusTodo)
+	tickets[2].SetState(ticket.StatusTodo)
+	tickets[3].SetState(ticket.StatusDone)
+
+	cfg := config.Default()
+	cfg.Views = []config.ViewConfig{
+		{Name: "auth", Match: "auth", Sort: "-created", Count: 2},
+		{Name: "open", Status: []string{"todo", "doing"}, Sort: "created"},
+	}
+
+	tests := []struct {
+		name     string
+		opts     ListOptions
+		expected []string
+	}{
+		{
+			name:     "view settings",
+			opts:     ListOptions{View: "auth"},
+			expected: []string{"250104-120000-auth-token", "250103-120000-auth-logout"},
+		},
+		{
+			name:     "explicit sort and count override the view",
+			opts:     ListOptions{View: "auth", Sort: "created", Count: 1},
+			expected: []string{"250101-120000-auth-login"},
+		},
+		{
+			name:     "filter is combined with the view",
+			opts:     ListOptions{View: "auth", Filter: "priority<=1"},
+			expected: []string{"250103-120000-auth-logout", "250101-120000-auth-login"},
+		},
+		{
+			name:     "several states",
+			opts:     ListOptions{View: "open"},
+			expected: []string{"250101-120000-auth-login", "250102-120000-docs", "250103-120000-auth-logout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockManager := new(mocks.MockTicketManager)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterActive).Return(tickets, nil)
+			mockManager.On("List", mock.Anything, ticket.StatusFilterAll).Return(tickets, nil)
+
+			var stdout strings.Builder
+			app := &App{
+				Config:  cfg,
+				Manager: mockManager,
+				Output:  NewOutputWriter(&stdout, nil, FormatJSON),
+			}
+
+			require.NoError(t, app.ListTicketsWithOptions(context.Background(), tt.opts))
+
+			var parsed struct {
+				Tickets []struct {
+					ID string `json:"id"`
+				} `json:"tickets"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(stdout.String()), &parsed))
+			ids := make([]string, 0, len(parsed.Tickets))
+			for _, pt := range parsed.Tickets {
+				ids = append(ids, pt.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	t.Run("unknown view", func(t *testing.T) {
+		app := &App{Config: cfg, Manager: new(mocks.MockTicketManager), Output: NewOutputWriter(nil, nil, FormatText)}
+		err := app.ListTicketsWithOptions(context.Background(), ListOptions{View: "mine"})
+		require.Error(t, err)
+		var cliErr *CLIError
+		require.ErrorAs(t, err, &cliErr)
+		assert.Equal(t, "View not found", cliErr.Message)
+		assert.Contains(t, cliErr.Suggestions[0], "auth, open")
+	})
+
+	t.Run("invalid view filter", func(t *testing.T) {
+		broken := config.Default()
+		broken.Views = []config.ViewConfig{{Name: "broken", Filter: "priority<=high"}}
+		app := &App{Config: broken, Manager: new(mocks.MockTicketManager), Output: NewOutputWriter(nil, nil, FormatText)}
+		err := app.ListTicketsWithOptions(context.Background(), ListOptions{View: "broken"})
+		require.Error(t, err)
+		var cliErr *CLIError
+		require.ErrorAs(t, err, &cliErr)
+		assert.Equal(t, ErrConfigInvalid, cliErr.Code)
+	})
+}
+
 func TestApp_StartTicket_WithMocks(t *testing.T) {
 	t.Parallel()
 	tmpDir := t.TempDir()
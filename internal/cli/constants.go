@@ -4,6 +4,10 @@ package cli
 const (
 	GitignoreFile = ".gitignore"
 	WorktreesDir  = ".worktrees/"
+
+	// IndexDir and IndexFile locate the ticket index cache inside the git dir
+	IndexDir  = "ticketflow"
+	IndexFile = "index.gob"
 )
 
 // Status filter values
@@ -0,0 +1,409 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// Doctor issue codes
+const (
+	DoctorTimestampsInconsistent = "TIMESTAMPS_INCONSISTENT"
+	DoctorMissingWorktree        = "MISSING_WORKTREE"
+	DoctorMissingBranch          = "MISSING_BRANCH"
+	DoctorOrphanedWorktree       = "ORPHANED_WORKTREE"
+	DoctorStaleBranch            = "STALE_BRANCH"
+	DoctorDanglingRelation       = "DANGLING_RELATION"
+	DoctorDuplicateTicket        = "DUPLICATE_TICKET"
+	DoctorBrokenCurrentTicket    = "BROKEN_CURRENT_TICKET"
+	DoctorStaleCurrentTicket     = "STALE_CURRENT_TICKET"
+)
+
+// Doctor issue severities
+const (
+	DoctorSeverityError   = "error"
+	DoctorSeverityWarning = "warning"
+	DoctorSeverityInfo    = "info" // Worth knowing, but not a problem
+)
+
+// DoctorIssue is a single inconsistency found by the doctor command
+type DoctorIssue struct {
+	Code     string
+	Severity string
+	TicketID string // Empty for issues not tied to a ticket
+	Message  string
+	Remedy   string
+	Fixable  bool // Whether 'doctor --fix' can repair the issue
+	Fixed    bool
+	FixError string
+
+	// fix repairs the issue; set only for fixable issues
+	fix func(ctx context.Context) error
+}
+
+// doctorState holds what the checks look at, gathered once up front
+type doctorState struct {
+	tickets   []ticket.Ticket
+	byID      map[string]*ticket.Ticket
+	byBranch  map[string]*ticket.Ticket
+	known     map[string]bool // IDs of every ticket, archived ones included
+	branches  map[string]bool
+	worktrees []git.WorktreeInfo
+}
+
+// Doctor audits ticket directories, frontmatter timestamps, relations, branches,
+// worktrees and the current-ticket.md symlink for inconsistencies. With fix set,
+// issues that can be repaired safely are fixed in place; nothing is committed.
+func (app *App) Doctor(ctx context.Context, fix bool) (*DoctorResult, error) {
+	logger := log.Global().WithOperation("doctor")
+
+	state, err := app.gatherDoctorState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []DoctorIssue
+	issues = append(issues, app.checkTicketTimestamps(state)...)
+	issues = append(issues, checkDuplicateTickets(state)...)
+	issues = append(issues, checkDanglingRelations(state)...)
+	issues = append(issues, app.checkTicketBranches(state)...)
+	issues = append(issues, app.checkCurrentTicketLink(state)...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].TicketID < issues[j].TicketID
+	})
+
+	result := &DoctorResult{
+		Issues:       issues,
+		TicketCount:  len(state.tickets),
+		BranchCount:  len(state.branches),
+		FixRequested: fix,
+	}
+	if app.Config.Worktree.Enabled {
+		result.WorktreeCount = len(state.worktrees)
+	}
+
+	if fix {
+		for i := range result.Issues {
+			issue := &result.Issues[i]
+			if !issue.Fixable {
+				continue
+			}
+			if err := issue.fix(ctx); err != nil {
+				logger.WithTicket(issue.TicketID).WithError(err).Warn("failed to fix issue", "code", issue.Code)
+				issue.FixError = err.Error()
+				continue
+			}
+			issue.Fixed = true
+		}
+	}
+
+	logger.Info("doctor finished", "issues", len(result.Issues), "fix", fix)
+	return result, nil
+}
+
+// gatherDoctorState lists tickets, branches and worktrees
+func (app *App) gatherDoctorState(ctx context.Context) (*doctorState, error) {
+	tickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	archived, err := app.Manager.List(ctx, ticket.StatusFilterArchived)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	state := &doctorState{
+		tickets:  tickets,
+		byID:     make(map[string]*ticket.Ticket, len(tickets)),
+		known:    make(map[string]bool, len(tickets)+len(archived)),
+		branches: make(map[string]bool),
+		byBranch: ticketsByBranch(tickets),
+	}
+	for i := range tickets {
+		state.byID[tickets[i].ID] = &tickets[i]
+		state.known[tickets[i].ID] = true
+	}
+	for _, t := range archived {
+		state.known[t.ID] = true
+	}
+
+	output, err := app.Git.Exec(ctx, "branch", "--format=%(refname:short)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+	for _, branch := range splitLines(output) {
+		state.branches[branch] = true
+	}
+
+	if app.Config.Worktree.Enabled {
+		state.worktrees, err = app.Git.ListWorktrees(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list worktrees: %w", err)
+		}
+	}
+
+	return state, nil
+}
+
+// checkTicketTimestamps reports timestamps a ticket's state does not allow:
+// closed_at and closure_reason on todo and doing tickets, and started_at on
+// todo tickets. Missing timestamps are never made up; a closed ticket without
+// closed_at is only noted.
+func (app *App) checkTicketTimestamps(state *doctorState) []DoctorIssue {
+	var issues []DoctorIssue
+	for i := range state.tickets {
+		t := &state.tickets[i]
+
+		switch t.State() {
+		case config.StateTodo, config.StateDoing:
+			problems := forbiddenTimestamps(t)
+			if len(problems) == 0 {
+				continue
+			}
+			issues = append(issues, DoctorIssue{
+				Code:     DoctorTimestampsInconsistent,
+				Severity: DoctorSeverityError,
+				TicketID: t.ID,
+				Message:  fmt.Sprintf("Ticket in %s/ has inconsistent timestamps: %s", t.State(), strings.Join(problems, ", ")),
+				Remedy:   "Run 'ticketflow doctor --fix' to clear the fields the state does not allow",
+				Fixable:  true,
+				fix: func(ctx context.Context) error {
+					clearForbiddenTimestamps(t)
+					return app.Manager.Update(ctx, t)
+				},
+			})
+		case config.StateDone, config.StateCancelled:
+			// Tickets closed from todo have no started_at, which is fine
+			if t.ClosedAt.Time != nil {
+				continue
+			}
+			issues = append(issues, DoctorIssue{
+				Code:     DoctorTimestampsInconsistent,
+				Severity: DoctorSeverityInfo,
+				TicketID: t.ID,
+				Message:  fmt.Sprintf("Ticket in %s/ has no closed_at", t.State()),
+				Remedy:   "Set closed_at by hand if you know when the ticket was closed",
+			})
+		}
+	}
+	return issues
+}
+
+// forbiddenTimestamps lists the fields set on an open ticket that its state
+// does not allow
+func forbiddenTimestamps(t *ticket.Ticket) []string {
+	var problems []string
+	if t.State() == config.StateTodo && t.StartedAt.Time != nil {
+		problems = append(problems, "started_at should be empty")
+	}
+	if t.ClosedAt.Time != nil {
+		problems = append(problems, "closed_at should be empty")
+	}
+	if t.ClosureReason != "" {
+		problems = append(problems, "closure_reason should be empty")
+	}
+	return problems
+}
+
+// clearForbiddenTimestamps clears the fields reported by forbiddenTimestamps
+func clearForbiddenTimestamps(t *ticket.Ticket) {
+	if t.State() == config.StateTodo {
+		t.StartedAt = ticket.RFC3339TimePtr{}
+	}
+	t.ClosedAt = ticket.RFC3339TimePtr{}
+	t.ClosureReason = ""
+}
+
+// checkDuplicateTickets reports ticket IDs that exist in more than one directory
+func checkDuplicateTickets(state *doctorState) []DoctorIssue {
+	paths := make(map[string][]string)
+	for _, t := range state.tickets {
+		paths[t.ID] = append(paths[t.ID], t.Path)
+	}
+
+	var issues []DoctorIssue
+	for id, p := range paths {
+		if len(p) < 2 {
+			continue
+		}
+		issues = append(issues, DoctorIssue{
+			Code:     DoctorDuplicateTicket,
+			Severity: DoctorSeverityError,
+			TicketID: id,
+			Message:  fmt.Sprintf("Ticket exists in %d places: %s", len(p), strings.Join(p, ", ")),
+			Remedy:   "Keep the copy in the correct state directory and delete the others",
+		})
+	}
+	return issues
+}
+
+// checkDanglingRelations reports relations that point to tickets that do not exist
+func checkDanglingRelations(state *doctorState) []DoctorIssue {
+	var issues []DoctorIssue
+	for _, t := range state.tickets {
+		for _, rel := range t.Relations() {
+			if state.known[rel.TicketID] {
+				continue
+			}
+			issues = append(issues, DoctorIssue{
+				Code:     DoctorDanglingRelation,
+				Severity: DoctorSeverityWarning,
+				TicketID: t.ID,
+				Message:  fmt.Sprintf("Relation %s points to a ticket that does not exist", rel),
+				Remedy: fmt.Sprintf("Remove the '%s' entry from related, or merge the branch that adds ticket %s",
+					rel, rel.TicketID),
+			})
+		}
+	}
+	return issues
+}
+
+// checkTicketBranches reports doing tickets without a branch or worktree and
+// branches or worktrees left behind by closed or unknown tickets
+func (app *App) checkTicketBranches(state *doctorState) []DoctorIssue {
+	var issues []DoctorIssue
+
+	worktreeByBranch := make(map[string]git.WorktreeInfo, len(state.worktrees))
+	for _, wt := range state.worktrees {
+		worktreeByBranch[wt.Branch] = wt
+	}
+
+	for _, t := range state.tickets {
+		if t.State() != config.StateDoing {
+			continue
+		}
+		branch := t.BranchName()
+		if !state.branches[branch] {
+			issues = append(issues, DoctorIssue{
+				Code:     DoctorMissingBranch,
+				Severity: DoctorSeverityError,
+				TicketID: t.ID,
+				Message:  "Ticket is in doing but its branch does not exist",
+				Remedy:   fmt.Sprintf("Move it back and start again: ticketflow move %s todo && ticketflow start %s", t.ID, t.ID),
+			})
+			continue
+		}
+		if _, ok := worktreeByBranch[branch]; app.Config.Worktree.Enabled && !ok {
+			issues = append(issues, DoctorIssue{
+				Code:     DoctorMissingWorktree,
+				Severity: DoctorSeverityWarning,
+				TicketID: t.ID,
+				Message:  "Ticket is in doing but has no worktree",
+				Remedy: fmt.Sprintf("Recreate it: git worktree add %s %s",
+					filepath.Join(app.Config.GetWorktreePath(app.ProjectRoot), t.ID), branch),
+			})
+		}
+	}
+
+	for _, wt := range state.worktrees {
+		if wt.Branch == "" || wt.Branch == app.Config.Git.DefaultBranch {
+			continue
+		}
+		t, ok := state.byBranch[wt.Branch]
+		if ok && !t.IsClosed() {
+			continue
+		}
+		ticketID := wt.Branch
+		if ok {
+			ticketID = t.ID
+		}
+		message := "Worktree belongs to a closed ticket"
+		remedy := fmt.Sprintf("Remove it once its work is merged: ticketflow cleanup %s", ticketID)
+		if !ok {
+			message = "Worktree branch does not match any ticket"
+			remedy = fmt.Sprintf("Remove it if it is no longer needed: git worktree remove %s", wt.Path)
+		}
+		issues = append(issues, DoctorIssue{
+			Code:     DoctorOrphanedWorktree,
+			Severity: DoctorSeverityWarning,
+			TicketID: ticketID,
+			Message:  fmt.Sprintf("%s: %s", message, wt.Path),
+			Remedy:   remedy,
+		})
+	}
+
+	for branch := range state.branches {
+		t, ok := state.byBranch[branch]
+		if !ok || !t.IsClosed() {
+			continue
+		}
+		if _, hasWorktree := worktreeByBranch[branch]; hasWorktree {
+			// Already reported as an orphaned worktree
+			continue
+		}
+		issues = append(issues, DoctorIssue{
+			Code:     DoctorStaleBranch,
+			Severity: DoctorSeverityWarning,
+			TicketID: t.ID,
+			Message:  fmt.Sprintf("Branch %s of %s ticket still exists", branch, t.State()),
+			Remedy:   fmt.Sprintf("Delete it once its work is merged: ticketflow cleanup %s", t.ID),
+		})
+	}
+
+	return issues
+}
+
+// checkCurrentTicketLink reports a current-ticket.md symlink that is broken or
+// points to a ticket that is not in progress
+func (app *App) checkCurrentTicketLink(state *doctorState) []DoctorIssue {
+	linkPath := filepath.Join(app.ProjectRoot, ticket.CurrentTicketFile)
+	info, err := os.Lstat(linkPath)
+	if err != nil || info.Mode()&os.ModeSymlink == 0 {
+		return nil
+	}
+
+	target, err := os.Readlink(linkPath)
+	if err != nil {
+		return nil
+	}
+	id := ticket.ExtractIDFromFilename(filepath.Base(target))
+	removeLink := func(context.Context) error {
+		return os.Remove(linkPath)
+	}
+
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(app.ProjectRoot, target)
+	}
+	if _, err := os.Stat(target); err != nil {
+		issue := DoctorIssue{
+			Code:     DoctorBrokenCurrentTicket,
+			Severity: DoctorSeverityError,
+			TicketID: id,
+			Message:  fmt.Sprintf("%s points to a missing file: %s", ticket.CurrentTicketFile, target),
+			Remedy:   "Run 'ticketflow doctor --fix' to remove the link",
+			Fixable:  true,
+			fix:      removeLink,
+		}
+		// The ticket moved; point the link at its new location instead
+		if t, ok := state.byID[id]; ok && t.State() == config.StateDoing {
+			issue.Remedy = "Run 'ticketflow doctor --fix' to point the link at the ticket's current file"
+			issue.fix = func(ctx context.Context) error {
+				return app.Manager.SetCurrentTicket(ctx, t)
+			}
+		}
+		return []DoctorIssue{issue}
+	}
+
+	if t, ok := state.byID[id]; ok && t.State() != config.StateDoing {
+		return []DoctorIssue{{
+			Code:     DoctorStaleCurrentTicket,
+			Severity: DoctorSeverityWarning,
+			TicketID: id,
+			Message:  fmt.Sprintf("%s points to a ticket in %s, not doing", ticket.CurrentTicketFile, t.State()),
+			Remedy:   "Run 'ticketflow doctor --fix' to remove the link",
+			Fixable:  true,
+			fix:      removeLink,
+		}}
+	}
+
+	return nil
+}
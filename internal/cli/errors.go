@@ -44,12 +44,14 @@ const (
 	ErrTicketAlreadyStarted = "TICKET_ALREADY_STARTED"
 	ErrTicketAlreadyClosed  = "TICKET_ALREADY_CLOSED"
 	ErrTicketNotDone        = "TICKET_NOT_DONE"
+	ErrInvalidTransition    = "INVALID_TRANSITION"
 
 	// Git errors
 	ErrGitDirtyWorkspace = "GIT_DIRTY_WORKSPACE"
 	ErrGitBranchExists   = "GIT_BRANCH_EXISTS"
 	ErrGitMergeFailed    = "GIT_MERGE_FAILED"
 	ErrGitPushFailed     = "GIT_PUSH_FAILED"
+	ErrGitFetchFailed    = "GIT_FETCH_FAILED"
 
 	// Worktree errors
 	ErrWorktreeExists       = "WORKTREE_EXISTS"
@@ -285,6 +285,7 @@ func TestErrorCodes(t *testing.T) {
 		ErrGitBranchExists,
 		ErrGitMergeFailed,
 		ErrGitPushFailed,
+		ErrGitFetchFailed,
 		ErrWorktreeExists,
 		ErrWorktreeNotFound,
 		ErrWorktreeCreateFailed,
@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// FinishTicket squash-merges a ticket branch into the default branch, commits
+// the merge with a message built from the ticket, and closes the ticket.
+// With cleanup, the ticket's worktree and branch are removed afterwards.
+// A failed merge or close resets the default branch to where it was.
+func (app *App) FinishTicket(ctx context.Context, ticketID string, cleanup, dryRun bool) (*FinishResult, error) {
+	logger := log.Global().WithOperation("finish_ticket").WithTicket(ticketID)
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	if err := app.validateTicketForFinish(ctx, t); err != nil {
+		return nil, err
+	}
+
+	target := app.Config.Git.DefaultBranch
+	branch := t.BranchName()
+	result := &FinishResult{
+		Ticket:        t,
+		Branch:        branch,
+		TargetBranch:  target,
+		CommitMessage: finishCommitMessage(t),
+		Cleanup:       cleanup,
+		DryRun:        dryRun,
+	}
+
+	currentBranch, err := app.Git.CurrentBranch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current branch: %w", err)
+	}
+
+	var wtPath string
+	if app.Config.Worktree.Enabled {
+		wt, err := app.Git.FindWorktreeByBranch(ctx, branch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find worktree: %w", err)
+		}
+		if wt != nil {
+			wtPath = wt.Path
+		}
+	}
+	result.WorktreePath = wtPath
+
+	if wtPath != "" && currentBranch == branch {
+		return nil, NewError(ErrInvalidContext, "Cannot finish from the ticket worktree",
+			fmt.Sprintf("Ticket '%s' is checked out in this worktree, so %s cannot be checked out here", t.ID, target),
+			[]string{"Run this from the main repository: ticketflow finish " + t.ID})
+	}
+
+	if err := app.checkWorkspaceForFinish(ctx, t, wtPath); err != nil {
+		return nil, err
+	}
+
+	count, err := app.Git.Exec(ctx, git.SubcmdRevList, git.FlagCount, target+".."+branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count commits on branch %s: %w", branch, err)
+	}
+	result.Commits, _ = strconv.Atoi(strings.TrimSpace(count))
+	if result.Commits == 0 {
+		return nil, NewError(ErrValidation, "Nothing to merge",
+			fmt.Sprintf("Branch '%s' has no commits that are not in %s", branch, target),
+			[]string{fmt.Sprintf("Close the ticket instead: ticketflow close %s --reason \"explanation\"", t.ID)})
+	}
+
+	if dryRun {
+		return result, nil
+	}
+
+	origCommit, err := app.Git.GetBranchCommit(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentBranch != target {
+		if err := app.Git.Checkout(ctx, target); err != nil {
+			return nil, fmt.Errorf("failed to checkout %s: %w", target, err)
+		}
+	}
+
+	// From here on, any failure resets the default branch to origCommit
+	if err := app.Git.MergeSquash(ctx, branch); err != nil {
+		conflicts := app.conflictingFiles(ctx)
+		app.rollbackFinish(ctx, origCommit, currentBranch, target)
+		logger.WithError(err).Warn("squash merge failed", "conflicts", len(conflicts))
+
+		details := fmt.Sprintf("Squash-merging %s into %s failed; %s was left unchanged", branch, target, target)
+		if len(conflicts) > 0 {
+			details = fmt.Sprintf("Squash-merging %s into %s conflicts in: %s; %s was left unchanged",
+				branch, target, strings.Join(conflicts, ", "), target)
+		}
+		return nil, NewError(ErrGitMergeFailed, "Merge failed", details,
+			[]string{
+				fmt.Sprintf("Merge %s into the ticket branch and resolve the conflicts there, then run finish again", target),
+				fmt.Sprintf("Inspect the changes: git diff %s...%s", target, branch),
+			})
+	}
+
+	if err := app.Git.Commit(ctx, result.CommitMessage); err != nil {
+		app.rollbackFinish(ctx, origCommit, currentBranch, target)
+		return nil, fmt.Errorf("failed to commit squash merge of %s: %w", branch, err)
+	}
+
+	if result.MergeCommit, err = app.Git.GetBranchCommit(ctx, target); err != nil {
+		logger.WithError(err).Warn("failed to read merge commit")
+	}
+
+	closed, err := app.closeFinishedTicket(ctx, t.ID)
+	if err != nil {
+		app.rollbackFinish(ctx, origCommit, currentBranch, target)
+		return nil, err
+	}
+	result.Ticket = closed
+
+	if cleanup {
+		app.cleanupFinishedTicket(ctx, result)
+	}
+
+	// Go back to where the user was, unless that was the ticket branch
+	if currentBranch != target && currentBranch != branch {
+		if err := app.Git.Checkout(ctx, currentBranch); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to switch back to %s: %v", currentBranch, err))
+		}
+	}
+
+	logger.Info("ticket finished", "target", target, "commits", result.Commits, "cleanup", cleanup)
+	return result, nil
+}
+
+// validateTicketForFinish checks that a ticket is in progress and has a branch to merge
+func (app *App) validateTicketForFinish(ctx context.Context, t *ticket.Ticket) error {
+	if t.IsClosed() {
+		return NewError(ErrTicketAlreadyClosed, "Ticket already closed",
+			fmt.Sprintf("Ticket '%s' is already closed", t.ID), nil)
+	}
+	if t.Status() != ticket.StatusDoing {
+		return NewError(ErrTicketNotStarted, "Ticket not started",
+			fmt.Sprintf("Ticket '%s' is in '%s' status, not 'doing'", t.ID, t.Status()),
+			[]string{"Start the ticket first: ticketflow start " + t.ID})
+	}
+	if app.Config.Git.DefaultBranch == "" {
+		return NewError(ErrConfigInvalid, "No default branch configured",
+			"git.default_branch must be set to finish tickets",
+			[]string{"Set git.default_branch in .ticketflow.yaml"})
+	}
+
+	exists, err := app.Git.BranchExists(ctx, t.BranchName())
+	if err != nil {
+		return fmt.Errorf("failed to check branch %s: %w", t.BranchName(), err)
+	}
+	if !exists {
+		return NewError(ErrValidation, "Ticket branch not found",
+			fmt.Sprintf("Branch '%s' does not exist", t.BranchName()),
+			[]string{fmt.Sprintf("Close the ticket instead: ticketflow close %s --reason \"explanation\"", t.ID)})
+	}
+	return nil
+}
+
+// checkWorkspaceForFinish checks that neither the repository nor the ticket's
+// worktree has uncommitted changes
+func (app *App) checkWorkspaceForFinish(ctx context.Context, t *ticket.Ticket, wtPath string) error {
+	dirty, err := app.Git.HasUncommittedChanges(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check git status: %w", err)
+	}
+	if dirty {
+		return NewError(ErrGitDirtyWorkspace, "Uncommitted changes detected",
+			"Please commit or stash your changes before finishing the ticket",
+			[]string{"Commit your changes: git commit -am 'Your message'", "Stash your changes: git stash"})
+	}
+
+	if wtPath == "" {
+		return nil
+	}
+	wtGit := git.NewWithTimeout(wtPath, app.Config.GetGitTimeout())
+	dirty, err = wtGit.HasUncommittedChanges(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check worktree status: %w", err)
+	}
+	if dirty {
+		return NewError(ErrGitDirtyWorkspace, "Uncommitted changes in worktree",
+			fmt.Sprintf("Please commit your changes in %s before finishing the ticket", wtPath),
+			[]string{fmt.Sprintf("cd %s && git commit -am 'Your message'", wtPath)})
+	}
+	return nil
+}
+
+// closeFinishedTicket closes a ticket after its branch was squash-merged.
+// The ticket is read again, as the merge may have brought in a ticket that
+// was already closed on its branch.
+func (app *App) closeFinishedTicket(ctx context.Context, ticketID string) (*ticket.Ticket, error) {
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	if t.IsClosed() {
+		return t, nil
+	}
+
+	if err := t.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close ticket: %w", err)
+	}
+
+	current, _ := app.Manager.GetCurrentTicket(ctx)
+	isCurrent := current != nil && current.ID == t.ID
+	if err := app.moveTicketToDoneWithReason(ctx, t, "", isCurrent); err != nil {
+		return nil, fmt.Errorf("failed to move ticket to done: %w", err)
+	}
+	return t, nil
+}
+
+// cleanupFinishedTicket removes the worktree and branch of a finished ticket.
+// The merge is already committed, so failures are reported as warnings.
+func (app *App) cleanupFinishedTicket(ctx context.Context, result *FinishResult) {
+	if result.WorktreePath != "" {
+		if err := app.Git.RemoveWorktree(ctx, result.WorktreePath); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to remove worktree %s: %v", result.WorktreePath, err))
+			// The branch cannot be deleted while it is checked out in the worktree
+			return
+		}
+		result.WorktreeRemoved = true
+	}
+
+	// A squash merge leaves the branch unmerged as far as git knows, so force the delete
+	if _, err := app.Git.Exec(ctx, git.SubcmdBranch, git.FlagDeleteForce, result.Branch); err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to delete branch %s: %v", result.Branch, err))
+		return
+	}
+	result.BranchDeleted = true
+}
+
+// conflictingFiles lists the files left unmerged by a failed merge
+func (app *App) conflictingFiles(ctx context.Context) []string {
+	output, err := app.Git.Exec(ctx, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(output)
+}
+
+// rollbackFinish resets the default branch to the commit it was at before
+// the merge and switches back to the branch the user was on
+func (app *App) rollbackFinish(ctx context.Context, origCommit, originalBranch, target string) {
+	logger := log.Global().WithOperation("rollback_finish")
+
+	// The workspace was clean before the merge, so a hard reset loses nothing
+	if _, err := app.Git.Exec(ctx, git.SubcmdReset, git.FlagHard, origCommit); err != nil {
+		logger.WithError(err).Warn("failed to reset default branch", "branch", target, "commit", origCommit)
+		return
+	}
+	if originalBranch != target {
+		if err := app.Git.Checkout(ctx, originalBranch); err != nil {
+			logger.WithError(err).Warn("failed to switch back to original branch", "branch", originalBranch)
+		}
+	}
+}
+
+// finishCommitMessage builds the squash commit message for a ticket: the
+// ticket title as the subject and a Ticket trailer with its ID
+func finishCommitMessage(t *ticket.Ticket) string {
+	return fmt.Sprintf("%s\n\nTicket: %s", TicketTitle(t), t.ID)
+}
@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestFinishCommitMessage(t *testing.T) {
+	t.Parallel()
+
+	tk := &ticket.Ticket{ID: "250101-120000-add-feature", Slug: "add-feature", Description: "Add the feature"}
+	assert.Equal(t, "Add the feature\n\nTicket: 250101-120000-add-feature", finishCommitMessage(tk))
+
+	tk.Description = ""
+	assert.Equal(t, "add-feature\n\nTicket: 250101-120000-add-feature", finishCommitMessage(tk))
+}
@@ -37,6 +37,22 @@ func ExtractParentID(t *ticket.Ticket) string {
 	return ""
 }
 
+// TicketTitle returns a one-line title for a ticket: the first line of its
+// description, or its slug (or ID) when the description is empty.
+func TicketTitle(t *ticket.Ticket) string {
+	title := strings.TrimSpace(t.Description)
+	if i := strings.IndexByte(title, '\n'); i >= 0 {
+		title = strings.TrimSpace(title[:i])
+	}
+	if title == "" {
+		title = t.Slug
+	}
+	if title == "" {
+		title = t.ID
+	}
+	return title
+}
+
 // FormatDuration formats a duration as human-readable string (e.g., "2h 30m").
 // Returns empty string for zero or negative durations.
 //
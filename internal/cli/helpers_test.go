@@ -115,6 +115,43 @@ func TestExtractParentID(t *testing.T) {
 	}
 }
 
+func TestTicketTitle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		ticket *ticket.Ticket
+		want   string
+	}{
+		{
+			name:   "description",
+			ticket: &ticket.Ticket{ID: "250101-120000-add-feature", Slug: "add-feature", Description: "Add the feature"},
+			want:   "Add the feature",
+		},
+		{
+			name:   "first line of a multi-line description",
+			ticket: &ticket.Ticket{ID: "250101-120000-add-feature", Slug: "add-feature", Description: "  Add the feature\nwith details"},
+			want:   "Add the feature",
+		},
+		{
+			name:   "slug without description",
+			ticket: &ticket.Ticket{ID: "250101-120000-add-feature", Slug: "add-feature", Description: "  "},
+			want:   "add-feature",
+		},
+		{
+			name:   "id without slug",
+			ticket: &ticket.Ticket{ID: "250101-120000-add-feature"},
+			want:   "250101-120000-add-feature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TicketTitle(tt.ticket))
+		})
+	}
+}
+
 func TestHelperFormatDuration(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,411 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+const (
+	// HookPrepareCommitMsg adds the Ticket trailer before the commit message is edited
+	HookPrepareCommitMsg = "prepare-commit-msg"
+	// HookCommitMsg adds or, in validation mode, requires the Ticket trailer
+	HookCommitMsg = "commit-msg"
+
+	// TicketTrailer is the commit message trailer naming a commit's ticket
+	TicketTrailer = "Ticket"
+
+	// hookMarker identifies hook scripts installed by ticketflow
+	hookMarker = "# Installed by ticketflow"
+	// chainedHookSuffix is appended to the name of a hook that was present
+	// before ticketflow's hook was installed; ticketflow's hook runs it first
+	chainedHookSuffix = ".pre-ticketflow"
+
+	// scissorsLine marks the start of the diff in 'git commit --verbose'
+	// messages; git drops everything below it
+	scissorsLine = "# ------------------------ >8 ------------------------"
+)
+
+// ManagedHooks are the git hooks installed by 'ticketflow hooks install'
+var ManagedHooks = []string{HookPrepareCommitMsg, HookCommitMsg}
+
+// trailerPattern matches a "Key: value" commit message trailer
+var trailerPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*):\s*(.*)$`)
+
+// HookChange describes what installing or uninstalling did to one hook
+type HookChange struct {
+	Name string
+	Path string
+	// Chained is true when a hook that was already present is run before
+	// ticketflow's hook (install) or was put back in place (uninstall)
+	Chained bool
+	// Skipped explains why the hook was left unchanged; empty when it changed
+	Skipped string
+}
+
+// InstallHooks installs the prepare-commit-msg and commit-msg hooks into the
+// hooks directory git uses, which respects core.hooksPath. Existing hooks are
+// kept and run before ticketflow's. With validate, the commit-msg hook rejects
+// commits on ticket branches without a Ticket trailer instead of adding it.
+func (app *App) InstallHooks(ctx context.Context, validate bool) (*HooksResult, error) {
+	logger := log.Global().WithOperation("install_hooks")
+
+	dir, err := app.hooksDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	result := &HooksResult{Install: true, Dir: dir, Validate: validate}
+	for _, name := range ManagedHooks {
+		change := HookChange{Name: name, Path: filepath.Join(dir, name)}
+		chainedPath := change.Path + chainedHookSuffix
+
+		installed, err := isTicketflowHook(change.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !installed {
+			if _, err := os.Lstat(change.Path); err == nil {
+				// Keep the existing hook and run it from ours
+				if _, err := os.Lstat(chainedPath); err == nil {
+					return nil, NewError(ErrValidation, "Cannot chain existing hook",
+						fmt.Sprintf("Both %s and %s exist", change.Path, chainedPath),
+						[]string{"Merge or remove one of them, then run 'ticketflow hooks install' again"})
+				}
+				if err := os.Rename(change.Path, chainedPath); err != nil {
+					return nil, fmt.Errorf("failed to move existing hook %s: %w", name, err)
+				}
+			} else if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to check hook %s: %w", name, err)
+			}
+		}
+		if _, err := os.Stat(chainedPath); err == nil {
+			change.Chained = true
+		}
+
+		if err := os.WriteFile(change.Path, []byte(hookScript(name, validate)), 0755); err != nil {
+			return nil, fmt.Errorf("failed to write hook %s: %w", name, err)
+		}
+		result.Hooks = append(result.Hooks, change)
+	}
+
+	logger.Info("installed git hooks", "dir", dir, "validate", validate)
+	return result, nil
+}
+
+// UninstallHooks removes the hooks installed by InstallHooks and puts back the
+// hooks they chained. Hooks not installed by ticketflow are left alone.
+func (app *App) UninstallHooks(ctx context.Context) (*HooksResult, error) {
+	logger := log.Global().WithOperation("uninstall_hooks")
+
+	dir, err := app.hooksDir(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HooksResult{Dir: dir}
+	for _, name := range ManagedHooks {
+		change := HookChange{Name: name, Path: filepath.Join(dir, name)}
+		chainedPath := change.Path + chainedHookSuffix
+
+		installed, err := isTicketflowHook(change.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !installed {
+			change.Skipped = "not installed by ticketflow"
+			if _, err := os.Lstat(change.Path); os.IsNotExist(err) {
+				change.Skipped = "not installed"
+			}
+			result.Hooks = append(result.Hooks, change)
+			continue
+		}
+
+		if err := os.Remove(change.Path); err != nil {
+			return nil, fmt.Errorf("failed to remove hook %s: %w", name, err)
+		}
+		if _, err := os.Lstat(chainedPath); err == nil {
+			if err := os.Rename(chainedPath, change.Path); err != nil {
+				return nil, fmt.Errorf("failed to restore hook %s: %w", name, err)
+			}
+			change.Chained = true
+		}
+		result.Hooks = append(result.Hooks, change)
+	}
+
+	logger.Info("uninstalled git hooks", "dir", dir)
+	return result, nil
+}
+
+// RunHook runs ticketflow's part of an installed git hook. args are the
+// arguments git passed to the hook; the first is the commit message file.
+// Commits made outside a ticket are left alone.
+func (app *App) RunHook(ctx context.Context, name string, args []string, validate bool) error {
+	logger := log.Global().WithOperation("run_hook")
+
+	if name != HookPrepareCommitMsg && name != HookCommitMsg {
+		return NewError(ErrValidation, "Unknown hook",
+			fmt.Sprintf("ticketflow does not handle the %s hook", name),
+			[]string{fmt.Sprintf("Supported hooks: %s", strings.Join(ManagedHooks, ", "))})
+	}
+	if len(args) == 0 {
+		return NewError(ErrValidation, "Missing commit message file",
+			fmt.Sprintf("The %s hook expects the commit message file as its first argument", name), nil)
+	}
+
+	ticketID := app.activeTicketID(ctx)
+	if ticketID == "" {
+		logger.Debug("no active ticket, leaving commit message unchanged", "hook", name)
+		return nil
+	}
+
+	msgFile := args[0]
+	data, err := os.ReadFile(msgFile)
+	if err != nil {
+		return fmt.Errorf("failed to read commit message: %w", err)
+	}
+	msg := string(data)
+
+	var updated string
+	switch {
+	case name == HookPrepareCommitMsg:
+		// Shown in the editor, so the trailer can be reviewed before committing
+		updated = appendTicketTrailer(msg, ticketID)
+	case isTrailerOnlyMessage(msg):
+		// Leave a message that is empty apart from the trailer empty, so git
+		// aborts the commit as it would without the hook
+		updated = removeTicketTrailers(msg)
+	case hasTicketTrailer(msg, ticketID):
+		return nil
+	case validate:
+		return NewError(ErrValidation, "Missing Ticket trailer",
+			fmt.Sprintf("Commits for ticket %s must have a '%s: %s' trailer", ticketID, TicketTrailer, ticketID),
+			[]string{
+				fmt.Sprintf("Add the line '%s: %s' at the end of the commit message", TicketTrailer, ticketID),
+				"Reinstall the hooks without validation: ticketflow hooks install",
+			})
+	default:
+		updated = appendTicketTrailer(msg, ticketID)
+	}
+
+	if updated == msg {
+		return nil
+	}
+	if err := os.WriteFile(msgFile, []byte(updated), 0644); err != nil {
+		return fmt.Errorf("failed to write commit message: %w", err)
+	}
+	logger.Debug("updated commit message", "hook", name, "ticket_id", ticketID)
+	return nil
+}
+
+// activeTicketID returns the ticket commits are being made for: the ticket
+// worked on in the current branch, or the current ticket link. Commits on the
+// default branch belong to no ticket.
+func (app *App) activeTicketID(ctx context.Context) string {
+	branch, err := app.Git.CurrentBranch(ctx)
+	if err != nil || branch == "" || branch == app.Config.Git.DefaultBranch {
+		return ""
+	}
+	if t, err := app.ticketForBranch(ctx, branch); err == nil && t != nil {
+		return t.ID
+	}
+	if _, _, err := ticket.ParseID(branch); err == nil {
+		return branch
+	}
+
+	current, err := app.Manager.GetCurrentTicket(ctx)
+	if err != nil || current == nil {
+		return ""
+	}
+	return current.ID
+}
+
+// hooksDir returns the directory git runs hooks from. git resolves it from
+// core.hooksPath, falling back to the hooks directory of the repository.
+func (app *App) hooksDir(ctx context.Context) (string, error) {
+	output, err := app.Git.Exec(ctx, git.SubcmdRevParse, "--git-path", "hooks")
+	if err != nil {
+		return "", fmt.Errorf("failed to find git hooks directory: %w", err)
+	}
+	dir := strings.TrimSpace(output)
+	if !filepath.IsAbs(dir) {
+		// Relative paths are relative to the working tree git ran in
+		dir = filepath.Join(app.ProjectRoot, dir)
+	}
+	return dir, nil
+}
+
+// isTicketflowHook reports whether the hook at path was installed by ticketflow
+func isTicketflowHook(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read hook %s: %w", filepath.Base(path), err)
+	}
+	return strings.Contains(string(data), hookMarker), nil
+}
+
+// hookScript returns the shell script installed as the named hook. It runs the
+// chained hook, if any, then 'ticketflow hooks run'. Without ticketflow on the
+// PATH the script does nothing, so commits are never blocked by a missing binary.
+func hookScript(name string, validate bool) string {
+	run := "ticketflow hooks run " + name
+	if validate && name == HookCommitMsg {
+		run += " --validate"
+	}
+
+	return fmt.Sprintf(`#!/bin/sh
+%s; remove with 'ticketflow hooks uninstall'.
+# Adds a "%s: <id>" trailer to commits made for a ticket.
+chained="$0%s"
+if [ -x "$chained" ]; then
+	"$chained" "$@" || exit $?
+fi
+command -v ticketflow >/dev/null 2>&1 || exit 0
+exec %s -- "$@"
+`, hookMarker, TicketTrailer, chainedHookSuffix, run)
+}
+
+// messageLines returns the lines of a commit message up to the verbose diff
+// git drops, and the remaining lines
+func messageLines(msg string) (lines, rest []string) {
+	lines = strings.Split(msg, "\n")
+	for i, line := range lines {
+		if line == scissorsLine {
+			return lines[:i], lines[i:]
+		}
+	}
+	return lines, nil
+}
+
+// isCommentLine reports whether git strips line from the commit message
+func isCommentLine(line string) bool {
+	return strings.HasPrefix(line, "#")
+}
+
+// ticketTrailerID returns the ticket ID of a Ticket trailer line
+func ticketTrailerID(line string) (string, bool) {
+	m := trailerPattern.FindStringSubmatch(line)
+	if m == nil || !strings.EqualFold(m[1], TicketTrailer) {
+		return "", false
+	}
+	return strings.TrimSpace(m[2]), true
+}
+
+// hasTicketTrailer reports whether msg has a Ticket trailer for ticketID
+func hasTicketTrailer(msg, ticketID string) bool {
+	lines, _ := messageLines(msg)
+	for _, line := range lines {
+		if id, ok := ticketTrailerID(line); ok && id == ticketID {
+			return true
+		}
+	}
+	return false
+}
+
+// isTrailerOnlyMessage reports whether msg has Ticket trailers and no other text
+func isTrailerOnlyMessage(msg string) bool {
+	lines, _ := messageLines(msg)
+	found := false
+	for _, line := range lines {
+		if isCommentLine(line) || strings.TrimSpace(line) == "" {
+			continue
+		}
+		if _, ok := ticketTrailerID(line); !ok {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// removeTicketTrailers removes the Ticket trailer lines from msg
+func removeTicketTrailers(msg string) string {
+	lines, rest := messageLines(msg)
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if _, ok := ticketTrailerID(line); ok {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.Join(append(kept, rest...), "\n")
+}
+
+// appendTicketTrailer adds a Ticket trailer for ticketID after the last line
+// of text in msg, joining an existing trailer block or starting a new one.
+// Comments and the verbose diff stay after the trailer.
+func appendTicketTrailer(msg, ticketID string) string {
+	if hasTicketTrailer(msg, ticketID) {
+		return msg
+	}
+
+	lines, rest := messageLines(msg)
+	last := -1
+	for i, line := range lines {
+		if !isCommentLine(line) && strings.TrimSpace(line) != "" {
+			last = i
+		}
+	}
+
+	trailer := fmt.Sprintf("%s: %s", TicketTrailer, ticketID)
+	var insert []string
+	switch {
+	case last < 0:
+		// An empty message gets a blank subject line to write into
+		insert = []string{"", "", trailer}
+	case inTrailerBlock(lines, last):
+		insert = []string{trailer}
+	default:
+		insert = []string{"", trailer}
+	}
+
+	result := make([]string, 0, len(lines)+len(insert)+len(rest))
+	result = append(result, lines[:last+1]...)
+	result = append(result, insert...)
+	tail := lines[last+1:]
+	if len(tail) == 0 && len(rest) == 0 {
+		// Keep the message newline-terminated
+		tail = []string{""}
+	}
+	result = append(result, tail...)
+	return strings.Join(append(result, rest...), "\n")
+}
+
+// inTrailerBlock reports whether the paragraph ending at line last consists of
+// trailers, and is not the subject paragraph
+func inTrailerBlock(lines []string, last int) bool {
+	start := last
+	for start > 0 && !isCommentLine(lines[start-1]) && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+	// The paragraph must follow some other text
+	hasText := false
+	for _, line := range lines[:start] {
+		if !isCommentLine(line) && strings.TrimSpace(line) != "" {
+			hasText = true
+			break
+		}
+	}
+	if !hasText {
+		return false
+	}
+	for _, line := range lines[start : last+1] {
+		if !trailerPattern.MatchString(line) {
+			return false
+		}
+	}
+	return true
+}
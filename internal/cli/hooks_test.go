@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendTicketTrailer(t *testing.T) {
+	t.Parallel()
+	const id = "250101-120000-add-feature"
+
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{
+			name: "subject only",
+			msg:  "Implement feature\n",
+			want: "Implement feature\n\nTicket: " + id + "\n",
+		},
+		{
+			name: "no trailing newline",
+			msg:  "Implement feature",
+			want: "Implement feature\n\nTicket: " + id + "\n",
+		},
+		{
+			name: "body",
+			msg:  "Implement feature\n\nSome details.\n",
+			want: "Implement feature\n\nSome details.\n\nTicket: " + id + "\n",
+		},
+		{
+			name: "existing trailer block",
+			msg:  "Implement feature\n\nSigned-off-by: A <a@example.com>\n",
+			want: "Implement feature\n\nSigned-off-by: A <a@example.com>\nTicket: " + id + "\n",
+		},
+		{
+			name: "subject that looks like a trailer",
+			msg:  "Fix: handle empty input\n",
+			want: "Fix: handle empty input\n\nTicket: " + id + "\n",
+		},
+		{
+			name: "comments stay last",
+			msg:  "Implement feature\n\n# Please enter the commit message\n# Lines starting with '#' will be ignored\n",
+			want: "Implement feature\n\nTicket: " + id + "\n\n# Please enter the commit message\n# Lines starting with '#' will be ignored\n",
+		},
+		{
+			name: "empty editor message",
+			msg:  "\n# Please enter the commit message\n",
+			want: "\n\nTicket: " + id + "\n\n# Please enter the commit message\n",
+		},
+		{
+			name: "verbose diff",
+			msg:  "Implement feature\n" + scissorsLine + "\ndiff --git a/f b/f\n",
+			want: "Implement feature\n\nTicket: " + id + "\n" + scissorsLine + "\ndiff --git a/f b/f\n",
+		},
+		{
+			name: "already tagged",
+			msg:  "Implement feature\n\nticket: " + id + "\n",
+			want: "Implement feature\n\nticket: " + id + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, appendTicketTrailer(tt.msg, id))
+		})
+	}
+}
+
+func TestTrailerOnlyMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isTrailerOnlyMessage("\n\nTicket: 250101-120000-a\n\n# comment\n"))
+	assert.False(t, isTrailerOnlyMessage("Implement feature\n\nTicket: 250101-120000-a\n"))
+	assert.False(t, isTrailerOnlyMessage("\n# comment\n"))
+
+	assert.Equal(t, "\n\n\n# comment\n", removeTicketTrailers("\n\nTicket: 250101-120000-a\n\n# comment\n"))
+}
+
+func TestHookScript(t *testing.T) {
+	t.Parallel()
+
+	script := hookScript(HookCommitMsg, true)
+	assert.True(t, strings.HasPrefix(script, "#!/bin/sh\n"+hookMarker))
+	assert.Contains(t, script, `chained="$0`+chainedHookSuffix+`"`)
+	assert.Contains(t, script, `exec ticketflow hooks run commit-msg --validate -- "$@"`)
+
+	// Only commit-msg validates
+	script = hookScript(HookPrepareCommitMsg, true)
+	assert.Contains(t, script, `exec ticketflow hooks run prepare-commit-msg -- "$@"`)
+	assert.Equal(t, script, hookScript(HookPrepareCommitMsg, false))
+}
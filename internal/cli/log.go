@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+// TicketLog returns the git history of a ticket: every commit that touched the
+// ticket file across its status moves plus the commits on the ticket branch
+func (app *App) TicketLog(ctx context.Context, ticketID string) (*TicketLogResult, error) {
+	logger := log.Global().WithOperation("ticket_log").WithTicket(ticketID)
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	relPath, err := filepath.Rel(app.ProjectRoot, t.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ticket path: %w", err)
+	}
+
+	opts := git.HistoryOptions{
+		Path:       filepath.ToSlash(relPath),
+		Branch:     t.BranchName(),
+		BaseBranch: app.Config.Git.DefaultBranch,
+	}
+	if t.StartedAt.Time != nil {
+		opts.Since = *t.StartedAt.Time
+	}
+
+	commits, err := git.History(ctx, app.Git, opts)
+	if err != nil {
+		logger.WithError(err).Error("failed to read ticket history")
+		return nil, fmt.Errorf("failed to read history of ticket %s: %w", t.ID, err)
+	}
+
+	return &TicketLogResult{
+		Ticket:        t,
+		DefaultBranch: app.Config.Git.DefaultBranch,
+		Commits:       commits,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// MoveTicket moves a ticket to another workflow state.
+// The transition must be allowed by the state configuration. Timestamps are
+// adjusted so the ticket's lifecycle status stays consistent with the target state.
+func (app *App) MoveTicket(ctx context.Context, ticketID, state string) (*MoveResult, error) {
+	logger := log.Global().WithOperation("move_ticket").WithTicket(ticketID)
+
+	target, ok := app.Config.GetState(state)
+	if !ok {
+		return nil, NewError(ErrValidation, "Invalid state",
+			fmt.Sprintf("State '%s' is not a configured workflow state", state),
+			[]string{fmt.Sprintf("Use one of: %s", strings.Join(app.Config.GetStateNames(), ", "))})
+	}
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	from := string(t.State())
+	if from == target.Name {
+		return nil, NewError(ErrInvalidTransition, "Ticket already in state",
+			fmt.Sprintf("Ticket %s is already in '%s'", t.ID, from), nil)
+	}
+
+	if !app.Config.CanTransition(from, target.Name) {
+		suggestions := []string{fmt.Sprintf("Allowed transitions from '%s': none", from)}
+		if current, ok := app.Config.GetState(from); ok && len(current.Transitions) > 0 {
+			suggestions = []string{fmt.Sprintf("Allowed transitions from '%s': %s", from, strings.Join(current.Transitions, ", "))}
+		}
+		return nil, NewError(ErrInvalidTransition, "Transition not allowed",
+			fmt.Sprintf("Cannot move ticket %s from '%s' to '%s'", t.ID, from, target.Name),
+			suggestions)
+	}
+
+	// Remember whether the ticket is the current one before its file moves
+	current, _ := app.Manager.GetCurrentTicket(ctx)
+	isCurrent := current != nil && current.ID == t.ID
+
+	applyStateTimestamps(t, target.Name, time.Now())
+	if err := app.relocateTicket(ctx, t, target.Name,
+		fmt.Sprintf("Move ticket: %s (%s → %s)", t.ID, from, target.Name)); err != nil {
+		return nil, err
+	}
+
+	// Keep the current ticket link pointing at the moved file, or drop it once closed
+	if isCurrent {
+		var link *ticket.Ticket
+		if t.Status() != ticket.StatusDone {
+			link = t
+		}
+		if err := app.Manager.SetCurrentTicket(ctx, link); err != nil {
+			logger.WithError(err).Warn("failed to update current ticket link")
+		}
+	}
+
+	logger.Info("moved ticket", "from", from, "to", target.Name)
+
+	return &MoveResult{
+		Ticket: t,
+		From:   from,
+		To:     target.Name,
+	}, nil
+}
+
+// relocateTicket moves a ticket file into the directory of a workflow state,
+// saves it and commits the move with the given message
+func (app *App) relocateTicket(ctx context.Context, t *ticket.Ticket, state, commitMsg string) error {
+	statePath := app.Config.GetStatePath(app.ProjectRoot, state)
+	if err := os.MkdirAll(statePath, 0755); err != nil {
+		return fmt.Errorf("failed to create %s directory: %w", state, err)
+	}
+
+	oldPath := t.Path
+	newPath := filepath.Join(statePath, filepath.Base(oldPath))
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to move ticket to %s: %w", state, err)
+	}
+
+	t.Path = newPath
+	t.SetState(ticket.Status(state))
+
+	if err := app.Manager.Update(ctx, t); err != nil {
+		// Rollback file move
+		if renameErr := os.Rename(newPath, oldPath); renameErr != nil {
+			return fmt.Errorf("failed to update ticket and rollback file move: %w, rename error: %v", err, renameErr)
+		}
+		return fmt.Errorf("failed to update ticket: %w", err)
+	}
+
+	// Stage and commit the move - use -A to handle the rename properly
+	if err := app.Git.Add(ctx, "-A", filepath.Dir(oldPath), statePath); err != nil {
+		return fmt.Errorf("failed to stage ticket move: %w", err)
+	}
+	if err := app.Git.Commit(ctx, commitMsg); err != nil {
+		return fmt.Errorf("failed to commit ticket move: %w", err)
+	}
+
+	return nil
+}
+
+// applyStateTimestamps adjusts the lifecycle timestamps for a ticket entering a state.
+// Custom states keep the existing timestamps unless the ticket is leaving done.
+func applyStateTimestamps(t *ticket.Ticket, state string, now time.Time) {
+	switch state {
+	case config.StateTodo:
+		t.StartedAt = ticket.RFC3339TimePtr{}
+		t.ClosedAt = ticket.RFC3339TimePtr{}
+		t.ClosureReason = ""
+	case config.StateDoing:
+		if t.StartedAt.Time == nil {
+			t.StartedAt = ticket.NewRFC3339TimePtr(&now)
+		}
+		t.ClosedAt = ticket.RFC3339TimePtr{}
+		t.ClosureReason = ""
+	case config.StateDone:
+		if t.StartedAt.Time == nil {
+			t.StartedAt = ticket.NewRFC3339TimePtr(&now)
+		}
+		if t.ClosedAt.Time == nil {
+			t.ClosedAt = ticket.NewRFC3339TimePtr(&now)
+		}
+	case config.StateCancelled:
+		if t.ClosedAt.Time == nil {
+			t.ClosedAt = ticket.NewRFC3339TimePtr(&now)
+		}
+	default:
+		t.ClosedAt = ticket.RFC3339TimePtr{}
+		t.ClosureReason = ""
+	}
+}
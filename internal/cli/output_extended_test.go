@@ -32,7 +32,7 @@ func TestTicketToJSON(t *testing.T) {
 				StartedAt:   ticket.NewRFC3339TimePtr(&startTime),
 				ClosedAt:    ticket.NewRFC3339TimePtr(&closeTime),
 				Related:     []string{"parent:250101-110000-parent-feature"},
-				Content:     "# Test Feature\n\nThis is the content",
+				Content:     "# Test Feature\n\n- [x] Build it\n- [ ] Ship it\n",
 			},
 			expected: map[string]interface{}{
 				"id":           "250101-120000-test-feature",
@@ -44,6 +44,7 @@ func TestTicketToJSON(t *testing.T) {
 				"started_at":   startTime.Format(time.RFC3339),
 				"closed_at":    closeTime.Format(time.RFC3339),
 				"related":      []interface{}{"parent:250101-110000-parent-feature"},
+				"tasks":        map[string]interface{}{"done": float64(1), "total": float64(2)},
 				"has_worktree": false,
 			},
 		},
@@ -67,7 +68,9 @@ func TestTicketToJSON(t *testing.T) {
 				"started_at":   nil,
 				"closed_at":    nil,
 				"related":      nil,
+				"tasks":        map[string]interface{}{"done": float64(0), "total": float64(0)},
 				"has_worktree": false,
+				"extra":        map[string]interface{}{},
 			},
 		},
 		{
@@ -131,6 +134,15 @@ func TestTicketToJSON(t *testing.T) {
 	}
 }
 
+func TestTicketToJSON_Extra(t *testing.T) {
+	t.Parallel()
+	tk, err := ticket.Parse([]byte("---\npriority: 2\ndescription: Synced\ncreated_at: 2026-01-01T10:00:00Z\njira_key: PROJ-42\nsprint: 7\n---\n\n# Body\n"))
+	require.NoError(t, err)
+
+	result := ticketToJSON(tk, "")
+	assert.Equal(t, map[string]interface{}{"jira_key": "PROJ-42", "sprint": 7}, result["extra"])
+}
+
 func TestFormatDuration_EdgeCases(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -0,0 +1,384 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tabular is implemented by Printable results made of a list of records, such
+// as tickets or worktrees. Row-oriented formats (csv, markdown, ndjson and
+// template) render one row per record; other results are a single record.
+type Tabular interface {
+	// RecordsKey is the StructuredData key holding the records: a list of
+	// objects, or a single object
+	RecordsKey() string
+	// Columns lists the leading columns in display order; the other fields
+	// follow in alphabetical order
+	Columns() []string
+}
+
+// Verify interface compliance at compile time
+var (
+	_ Tabular = (*TicketListResult)(nil)
+	_ Tabular = (*TicketResult)(nil)
+	_ Tabular = (*WorktreeListResult)(nil)
+
+	_ OutputFormatter = (*yamlOutputFormatter)(nil)
+	_ OutputFormatter = (*tableOutputFormatter)(nil)
+	_ OutputFormatter = (*ndjsonOutputFormatter)(nil)
+	_ OutputFormatter = (*templateOutputFormatter)(nil)
+)
+
+// ParseOutputTemplate parses the Go template of a template= output format.
+// Templates are executed once per record, with the record's fields as the
+// data, e.g. '{{.id}} {{.status}}'.
+func ParseOutputTemplate(text string) (*template.Template, error) {
+	return template.New("output").Funcs(template.FuncMap{
+		"join": func(values []interface{}, sep string) string {
+			parts := make([]string, len(values))
+			for i, v := range values {
+				parts[i] = formatCell(v)
+			}
+			return strings.Join(parts, sep)
+		},
+		"json": func(v interface{}) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Option("missingkey=zero").Parse(text)
+}
+
+// yamlOutputFormatter outputs data as a YAML document
+type yamlOutputFormatter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (f *yamlOutputFormatter) PrintResult(data interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	value, err := normalizeStructuredData(structuredData(data))
+	if err != nil {
+		return err
+	}
+	encoder := yaml.NewEncoder(f.w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("failed to encode YAML output: %w", err)
+	}
+	return encoder.Close()
+}
+
+func (f *yamlOutputFormatter) PrintJSON(data interface{}) error {
+	return f.PrintResult(data)
+}
+
+// tableOutputFormatter outputs records as CSV or as a Markdown table
+type tableOutputFormatter struct {
+	mu       sync.Mutex
+	w        io.Writer
+	markdown bool
+}
+
+func (f *tableOutputFormatter) PrintResult(data interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records, columns, err := tableRecords(data)
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	rows := make([][]string, len(records))
+	for i, record := range records {
+		rows[i] = make([]string, len(columns))
+		for j, column := range columns {
+			rows[i][j] = formatCell(record[column])
+		}
+	}
+
+	if f.markdown {
+		return writeMarkdownTable(f.w, columns, rows)
+	}
+
+	writer := csv.NewWriter(f.w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return fmt.Errorf("failed to write CSV output: %w", err)
+	}
+	return nil
+}
+
+func (f *tableOutputFormatter) PrintJSON(data interface{}) error {
+	return f.PrintResult(data)
+}
+
+// ndjsonOutputFormatter outputs each record as a single line of JSON
+type ndjsonOutputFormatter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (f *ndjsonOutputFormatter) PrintResult(data interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	records, err := outputRecords(data)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(f.w)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *ndjsonOutputFormatter) PrintJSON(data interface{}) error {
+	return f.PrintResult(data)
+}
+
+// templateOutputFormatter executes a Go template once per record
+type templateOutputFormatter struct {
+	mu   sync.Mutex
+	w    io.Writer
+	tmpl *template.Template
+	err  error // Template parse error, reported on output
+}
+
+// newTemplateOutputFormatter creates a formatter for the template of a template= format
+func newTemplateOutputFormatter(w io.Writer, text string) *templateOutputFormatter {
+	tmpl, err := ParseOutputTemplate(text)
+	return &templateOutputFormatter{w: w, tmpl: tmpl, err: err}
+}
+
+func (f *templateOutputFormatter) PrintResult(data interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return fmt.Errorf("invalid output template: %w", f.err)
+	}
+	records, err := outputRecords(data)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		var buf bytes.Buffer
+		if err := f.tmpl.Execute(&buf, record); err != nil {
+			return fmt.Errorf("failed to execute output template: %w", err)
+		}
+		// Every record ends up on its own line(s)
+		if buf.Len() == 0 || buf.Bytes()[buf.Len()-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+		if _, err := f.w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *templateOutputFormatter) PrintJSON(data interface{}) error {
+	return f.PrintResult(data)
+}
+
+// structuredData returns the data to encode for a result
+func structuredData(data interface{}) interface{} {
+	if p, ok := data.(Printable); ok {
+		return p.StructuredData()
+	}
+	return data
+}
+
+// normalizeStructuredData converts data to the generic values of its JSON
+// encoding, so every format shows the same field names and values as JSON
+// output. Whole numbers are kept as integers.
+func normalizeStructuredData(data interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("failed to encode output: %w", err)
+	}
+	return convertNumbers(value), nil
+}
+
+// convertNumbers replaces json.Number values with int64 or float64
+func convertNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+	}
+	return value
+}
+
+// outputRecords returns the records of a result (see Tabular)
+func outputRecords(data interface{}) ([]interface{}, error) {
+	value, err := normalizeStructuredData(structuredData(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if tabular, ok := data.(Tabular); ok {
+		if object, ok := value.(map[string]interface{}); ok {
+			value = object[tabular.RecordsKey()]
+		}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	default:
+		return []interface{}{v}, nil
+	}
+}
+
+// tableRecords returns the records of a result flattened to one level, and
+// the columns to show them in
+func tableRecords(data interface{}) ([]map[string]interface{}, []string, error) {
+	values, err := outputRecords(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := make([]map[string]interface{}, len(values))
+	fields := make(map[string]bool)
+	for i, value := range values {
+		record := make(map[string]interface{})
+		if object, ok := value.(map[string]interface{}); ok {
+			flattenRecord(record, "", object)
+		} else {
+			record["value"] = value
+		}
+		for field := range record {
+			fields[field] = true
+		}
+		records[i] = record
+	}
+
+	var columns []string
+	if tabular, ok := data.(Tabular); ok {
+		// The leading columns are shown even without records, so the header
+		// of an empty list stays the same
+		columns = append(columns, tabular.Columns()...)
+		for _, column := range columns {
+			delete(fields, column)
+		}
+	}
+	rest := make([]string, 0, len(fields))
+	for field := range fields {
+		rest = append(rest, field)
+	}
+	sort.Strings(rest)
+
+	return records, append(columns, rest...), nil
+}
+
+// flattenRecord copies the fields of object into record, naming the fields of
+// nested objects with dotted paths such as "tasks.done"
+func flattenRecord(record map[string]interface{}, prefix string, object map[string]interface{}) {
+	for key, value := range object {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenRecord(record, prefix+key+".", nested)
+			continue
+		}
+		record[prefix+key] = value
+	}
+}
+
+// formatCell renders a field value for a table cell. Lists of plain values are
+// comma-separated; other lists and objects are rendered as JSON.
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				data, _ := json.Marshal(v)
+				return string(data)
+			}
+			parts[i] = formatCell(item)
+		}
+		return strings.Join(parts, ",")
+	case map[string]interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// writeMarkdownTable writes a GitHub-flavored Markdown table
+func writeMarkdownTable(w io.Writer, columns []string, rows [][]string) error {
+	var buf strings.Builder
+	writeRow := func(cells []string) {
+		buf.WriteString("|")
+		for _, cell := range cells {
+			buf.WriteString(" ")
+			buf.WriteString(escapeMarkdownCell(cell))
+			buf.WriteString(" |")
+		}
+		buf.WriteString("\n")
+	}
+
+	writeRow(columns)
+	buf.WriteString("|")
+	buf.WriteString(strings.Repeat(" --- |", len(columns)))
+	buf.WriteString("\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// escapeMarkdownCell escapes pipes and line breaks, which would end a table cell
+func escapeMarkdownCell(cell string) string {
+	cell = strings.ReplaceAll(cell, `|`, `\|`)
+	cell = strings.ReplaceAll(cell, "\r\n", "<br>")
+	return strings.ReplaceAll(cell, "\n", "<br>")
+}
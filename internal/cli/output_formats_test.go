@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func formatTestTickets() *TicketListResult {
+	created := ticket.NewRFC3339Time(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	return &TicketListResult{
+		Tickets: []ticket.Ticket{
+			{ID: "250102-030405-first", Priority: 1, Description: "First | piped", CreatedAt: created, Tags: []string{"api", "urgent"}, Content: "- [x] one\n- [ ] two\n"},
+			{ID: "250102-030405-second", Priority: 2, Description: "Second\nline", CreatedAt: created},
+		},
+		Count: map[string]int{"total": 2, "todo": 2},
+	}
+}
+
+func printWithFormat(t *testing.T, format OutputFormat, data interface{}) string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, NewOutputFormatter(&buf, format).PrintResult(data))
+	return buf.String()
+}
+
+func TestOutputFormatIsStructured(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []OutputFormat{FormatJSON, FormatYAML, FormatCSV, FormatMarkdown, FormatNDJSON, OutputFormat("template={{.id}}")} {
+		assert.True(t, format.IsStructured(), "format %s", format)
+	}
+	for _, format := range []OutputFormat{FormatText, "", "unknown"} {
+		assert.False(t, format.IsStructured(), "format %q", format)
+	}
+
+	text, ok := OutputFormat("template={{.id}}").Template()
+	assert.True(t, ok)
+	assert.Equal(t, "{{.id}}", text)
+	_, ok = FormatJSON.Template()
+	assert.False(t, ok)
+}
+
+func TestYAMLOutputFormatter(t *testing.T) {
+	t.Parallel()
+
+	output := printWithFormat(t, FormatYAML, formatTestTickets())
+	assert.Contains(t, output, "tickets:\n  - closed_at: null\n")
+	assert.Contains(t, output, "    id: 250102-030405-first\n")
+	assert.Contains(t, output, "    priority: 1\n")
+	assert.Contains(t, output, `    created_at: "2025-01-02T03:04:05Z"`)
+	assert.Contains(t, output, "summary:\n  todo: 2\n  total: 2\n")
+}
+
+func TestTableOutputFormatter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("csv", func(t *testing.T) {
+		output := printWithFormat(t, FormatCSV, formatTestTickets())
+		lines := strings.Split(output, "\n")
+		assert.Equal(t, "id,status,priority,description,tags,created_at,started_at,closed_at,has_worktree,path,related,tasks.done,tasks.total", lines[0])
+		assert.Equal(t, `250102-030405-first,todo,1,First | piped,"api,urgent",2025-01-02T03:04:05Z,,,false,,,1,2`, lines[1])
+		assert.Contains(t, output, "\"Second\nline\"")
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		output := printWithFormat(t, FormatMarkdown, formatTestTickets())
+		lines := strings.Split(output, "\n")
+		assert.True(t, strings.HasPrefix(lines[0], "| id | status | priority | description |"))
+		assert.True(t, strings.HasPrefix(lines[1], "| --- | --- |"))
+		assert.Contains(t, lines[2], `| First \| piped |`)
+		assert.Contains(t, lines[3], "| Second<br>line |")
+	})
+
+	t.Run("empty list keeps the header", func(t *testing.T) {
+		output := printWithFormat(t, FormatCSV, &TicketListResult{})
+		assert.Equal(t, "id,status,priority,description,tags,created_at,started_at,closed_at\n", output)
+	})
+
+	t.Run("non-tabular results are a single row", func(t *testing.T) {
+		output := printWithFormat(t, FormatCSV, &StatusResult{CurrentBranch: "main", Summary: map[string]int{"todo": 3}})
+		assert.Equal(t, "current_branch,current_ticket,summary.todo\nmain,,3\n", output)
+	})
+
+	t.Run("worktrees", func(t *testing.T) {
+		result := &WorktreeListResult{Worktrees: []git.WorktreeInfo{{Path: "/repo", Branch: "main", HEAD: "abc"}}}
+		assert.Equal(t, "Path,Branch,HEAD\n/repo,main,abc\n", printWithFormat(t, FormatCSV, result))
+	})
+}
+
+func TestNDJSONOutputFormatter(t *testing.T) {
+	t.Parallel()
+
+	output := printWithFormat(t, FormatNDJSON, formatTestTickets())
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[0], "{"))
+	assert.Contains(t, lines[0], `"id":"250102-030405-first"`)
+	assert.Contains(t, lines[1], `"id":"250102-030405-second"`)
+
+	single := printWithFormat(t, FormatNDJSON, &TicketResult{Ticket: &formatTestTickets().Tickets[0]})
+	assert.Equal(t, 1, strings.Count(single, "\n"))
+	assert.Contains(t, single, `"id":"250102-030405-first"`)
+}
+
+func TestTemplateOutputFormatter(t *testing.T) {
+	t.Parallel()
+
+	output := printWithFormat(t, OutputFormat(`template={{.id}} p{{.priority}} [{{join .tags ","}}] {{.tasks.done}}/{{.tasks.total}}`), formatTestTickets())
+	assert.Equal(t, "250102-030405-first p1 [api,urgent] 1/2\n250102-030405-second p2 [] 0/0\n", output)
+
+	status := printWithFormat(t, OutputFormat("template={{.current_branch}}{{if .current_ticket}} {{.current_ticket.id}}{{end}}"), &StatusResult{CurrentBranch: "main"})
+	assert.Equal(t, "main\n", status)
+
+	var buf bytes.Buffer
+	err := NewOutputFormatter(&buf, OutputFormat("template={{.id")).PrintResult(formatTestTickets())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid output template")
+}
+
+func TestOutputWriterStructuredFormatsSuppressText(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []OutputFormat{FormatYAML, FormatCSV, FormatMarkdown, FormatNDJSON, OutputFormat("template={{.id}}")} {
+		var stdout bytes.Buffer
+		w := NewOutputWriter(&stdout, &bytes.Buffer{}, format)
+		w.Printf("progress %d\n", 1)
+		w.Println("progress")
+		assert.Empty(t, stdout.String(), "format %s", format)
+	}
+}
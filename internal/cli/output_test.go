@@ -19,6 +19,11 @@ func TestParseOutputFormat(t *testing.T) {
 		{"TEXT", FormatText},
 		{"", FormatText},
 		{"invalid", FormatText},
+		{"yaml", FormatYAML},
+		{"csv", FormatCSV},
+		{"markdown", FormatMarkdown},
+		{"ndjson", FormatNDJSON},
+		{"template={{.ID}}", OutputFormat("template={{.ID}}")},
 	}
 
 	for _, tt := range tests {
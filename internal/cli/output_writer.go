@@ -16,20 +16,56 @@ import (
 type OutputFormat string
 
 const (
-	FormatText OutputFormat = "text"
-	FormatJSON OutputFormat = "json"
+	FormatText     OutputFormat = "text"
+	FormatJSON     OutputFormat = "json"
+	FormatYAML     OutputFormat = "yaml"
+	FormatCSV      OutputFormat = "csv"
+	FormatMarkdown OutputFormat = "markdown"
+	FormatNDJSON   OutputFormat = "ndjson"
+
+	// FormatTemplatePrefix starts a template format, followed by a Go template
+	// executed for each record, e.g. "template={{.id}} {{.status}}"
+	FormatTemplatePrefix = "template="
 )
 
 // ParseOutputFormat parses output format from string
 func ParseOutputFormat(format string) OutputFormat {
+	if strings.HasPrefix(format, FormatTemplatePrefix) {
+		return OutputFormat(format)
+	}
 	switch strings.ToLower(format) {
 	case "json":
 		return FormatJSON
+	case "yaml":
+		return FormatYAML
+	case "csv":
+		return FormatCSV
+	case "markdown":
+		return FormatMarkdown
+	case "ndjson":
+		return FormatNDJSON
 	default:
 		return FormatText
 	}
 }
 
+// IsStructured reports whether the format is meant for other programs rather
+// than people. Progress and status messages are suppressed for such formats,
+// so they never mix with the output.
+func (f OutputFormat) IsStructured() bool {
+	switch f {
+	case FormatJSON, FormatYAML, FormatCSV, FormatMarkdown, FormatNDJSON:
+		return true
+	}
+	_, ok := f.Template()
+	return ok
+}
+
+// Template returns the Go template of a template format
+func (f OutputFormat) Template() (string, bool) {
+	return strings.CutPrefix(string(f), FormatTemplatePrefix)
+}
+
 // OutputFormatter handles structured data output for CLI commands.
 // It formats the final results according to the selected output format.
 //
@@ -109,10 +145,23 @@ func (w *textOutputFormatter) PrintJSON(data interface{}) error {
 
 // NewOutputFormatter creates the appropriate output formatter based on the output format
 func NewOutputFormatter(w io.Writer, format OutputFormat) OutputFormatter {
-	if format == FormatJSON {
+	if text, ok := format.Template(); ok {
+		return newTemplateOutputFormatter(w, text)
+	}
+	switch format {
+	case FormatJSON:
 		return NewJSONOutputFormatter(w)
+	case FormatYAML:
+		return &yamlOutputFormatter{w: w}
+	case FormatCSV:
+		return &tableOutputFormatter{w: w}
+	case FormatMarkdown:
+		return &tableOutputFormatter{w: w, markdown: true}
+	case FormatNDJSON:
+		return &ndjsonOutputFormatter{w: w}
+	default:
+		return NewTextOutputFormatter(w)
 	}
-	return NewTextOutputFormatter(w)
 }
 
 // OutputWriter provides a thin wrapper around OutputFormatter for backward compatibility
@@ -158,8 +207,8 @@ func (w *OutputWriter) GetFormat() OutputFormat {
 // Printf writes formatted text - kept for backward compatibility with existing code
 // New code should use StatusWriter for progress messages or Printable types for output
 func (w *OutputWriter) Printf(format string, args ...interface{}) {
-	if w.format == FormatJSON {
-		// In JSON mode, suppress text output
+	if w.format.IsStructured() {
+		// In JSON and other structured modes, suppress text output
 		return
 	}
 	// In text mode, write to stdout
@@ -169,8 +218,8 @@ func (w *OutputWriter) Printf(format string, args ...interface{}) {
 // Println writes a line - kept for backward compatibility with existing code
 // New code should use StatusWriter for progress messages or Printable types for output
 func (w *OutputWriter) Println(args ...interface{}) {
-	if w.format == FormatJSON {
-		// In JSON mode, suppress text output
+	if w.format.IsStructured() {
+		// In JSON and other structured modes, suppress text output
 		return
 	}
 	_, _ = fmt.Fprintln(w.stdout, args...)
@@ -225,19 +274,55 @@ func ticketToJSON(t *ticket.Ticket, worktreePath string) map[string]interface{}
 	result := map[string]interface{}{
 		"id":           t.ID,
 		"path":         t.Path,
-		"status":       string(t.Status()),
+		"status":       string(t.State()),
 		"priority":     t.Priority,
 		"description":  t.Description,
 		"created_at":   t.CreatedAt.Time,
 		"started_at":   t.StartedAt.Time,
 		"closed_at":    t.ClosedAt.Time,
 		"related":      t.Related,
+		"tags":         ticketTags(t),
+		"relations":    ticketRelations(t),
+		"tasks":        ticketTasks(t),
 		"has_worktree": t.HasWorktree(),
+		"extra":        t.Extra(),
 	}
 
 	if worktreePath != "" {
 		result["worktree_path"] = worktreePath
 	}
+	if t.Branch != "" {
+		result["branch"] = t.Branch
+	}
+	if t.IsArchived() {
+		result["archived"] = true
+	}
 
 	return result
 }
+
+// ticketTags returns the ticket's tags, never nil, so JSON output is always an array
+func ticketTags(t *ticket.Ticket) []string {
+	if t.Tags == nil {
+		return []string{}
+	}
+	return t.Tags
+}
+
+// ticketTasks returns the ticket's checklist progress
+func ticketTasks(t *ticket.Ticket) map[string]int {
+	progress := t.TaskProgress()
+	return map[string]int{
+		"done":  progress.Done,
+		"total": progress.Total,
+	}
+}
+
+// ticketRelations groups the ticket's typed relations by relation type
+func ticketRelations(t *ticket.Ticket) map[string][]string {
+	relations := make(map[string][]string)
+	for _, rel := range t.Relations() {
+		relations[string(rel.Type)] = append(relations[string(rel.Type)], rel.TicketID)
+	}
+	return relations
+}
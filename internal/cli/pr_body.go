@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// defaultPRBodyTemplate is used when no pull request template is configured
+const defaultPRBodyTemplate = `## Summary
+
+{{.Title}}
+
+Ticket: ` + "`{{.Ticket.ID}}`" + `
+{{- with .Parent}}
+Parent: ` + "`{{.ID}}`" + `{{if .Description}} - {{.Description}}{{end}}{{if .Path}} ({{.Path}}){{end}}
+{{- end}}
+{{- if .Tasks}}
+
+## Tasks ({{.TaskProgress.Done}}/{{.TaskProgress.Total}})
+{{range .Tasks}}
+- [{{if .Done}}x{{else}} {{end}}] {{.Text}}
+{{- end}}
+{{- end}}
+{{- if .Commits}}
+
+## Commits
+{{range .Commits}}
+- {{.Subject}} ({{.ShortSHA}})
+{{- end}}
+{{- end}}
+`
+
+// PRBodyData holds the variables available to pull request templates
+type PRBodyData struct {
+	Ticket       *ticket.Ticket
+	Title        string        // First line of the description, or the slug
+	Parent       *PRBodyParent // nil when the ticket has no parent
+	Tasks        []ticket.Task
+	TaskProgress ticket.TaskProgress
+	Branch       string
+	BaseBranch   string
+	Commits      []git.CommitInfo // Commits on Branch not in BaseBranch, oldest first
+}
+
+// PRBodyParent describes the parent ticket of a pull request's ticket
+type PRBodyParent struct {
+	ID          string
+	Description string
+	Path        string // Ticket file relative to the project root; empty when not found
+}
+
+// PRBody renders a Markdown pull request description for a ticket, or for the
+// current ticket when ticketID is empty. templateFile overrides the configured
+// template. When outputFile is set, the description is written to it.
+func (app *App) PRBody(ctx context.Context, ticketID, templateFile, outputFile string) (*PRBodyResult, error) {
+	logger := log.Global().WithOperation("pr_body")
+
+	t, err := app.ticketOrCurrent(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.WithTicket(t.ID)
+
+	tmpl, err := app.prBodyTemplate(templateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data := PRBodyData{
+		Ticket:       t,
+		Title:        TicketTitle(t),
+		Parent:       app.prBodyParent(ctx, t),
+		Tasks:        t.Tasks(),
+		TaskProgress: t.TaskProgress(),
+		Branch:       t.BranchName(),
+		BaseBranch:   app.Config.Git.DefaultBranch,
+	}
+
+	// History lists the commits of a missing branch as none. Only commits not
+	// yet in the base branch are listed, so checking them for Merged is skipped.
+	commits, err := git.History(ctx, app.Git, git.HistoryOptions{Branch: data.Branch, BaseBranch: data.BaseBranch, SkipMerged: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commits of branch %s: %w", data.Branch, err)
+	}
+	for i := len(commits) - 1; i >= 0; i-- {
+		data.Commits = append(data.Commits, commits[i])
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, NewError(ErrConfigInvalid, "Failed to render pull request template", err.Error(), nil)
+	}
+	body := strings.TrimSpace(buf.String()) + "\n"
+
+	if outputFile != "" {
+		if err := os.WriteFile(outputFile, []byte(body), 0644); err != nil {
+			return nil, fmt.Errorf("failed to write pull request description: %w", err)
+		}
+	}
+
+	logger.Debug("rendered pull request description", "commits", len(data.Commits), "file", outputFile)
+	return &PRBodyResult{
+		Ticket:  t,
+		Body:    body,
+		Commits: data.Commits,
+		File:    outputFile,
+	}, nil
+}
+
+// ticketOrCurrent returns the ticket with the given ID, or the current ticket
+// when the ID is empty
+func (app *App) ticketOrCurrent(ctx context.Context, ticketID string) (*ticket.Ticket, error) {
+	if ticketID != "" {
+		t, err := app.Manager.Get(ctx, ticketID)
+		if err != nil {
+			return nil, ConvertError(err)
+		}
+		return t, nil
+	}
+
+	current, err := app.Manager.GetCurrentTicket(ctx)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	if current == nil {
+		return nil, NewError(ErrTicketNotStarted, "No active ticket",
+			"There is no ticket currently being worked on",
+			[]string{
+				"Pass a ticket ID explicitly",
+				"Start a ticket first: ticketflow start <ticket-id>",
+			})
+	}
+	return current, nil
+}
+
+// prBodyTemplate returns the pull request template: templateFile when set,
+// then the configured template, then the built-in one
+func (app *App) prBodyTemplate(templateFile string) (*template.Template, error) {
+	text := defaultPRBodyTemplate
+	source := "built-in template"
+
+	path := templateFile
+	if path == "" {
+		path = app.Config.GetPullRequestTemplatePath(app.ProjectRoot)
+	}
+	switch {
+	case path != "":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, NewError(ErrConfigInvalid, "Failed to read pull request template", err.Error(),
+				[]string{"Check pull_request.template_file in .ticketflow.yaml or the --template path"})
+		}
+		text = string(data)
+		source = path
+	case app.Config.PullRequest.Template != "":
+		text = app.Config.PullRequest.Template
+		source = "pull_request.template"
+	}
+
+	tmpl, err := template.New("pr-body").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, NewError(ErrConfigInvalid, "Invalid pull request template",
+			fmt.Sprintf("%s: %v", source, err), nil)
+	}
+	return tmpl, nil
+}
+
+// prBodyParent describes the parent of t, if any. A parent that cannot be
+// read is still listed by ID.
+func (app *App) prBodyParent(ctx context.Context, t *ticket.Ticket) *PRBodyParent {
+	parentID := ExtractParentID(t)
+	if parentID == "" {
+		return nil
+	}
+
+	parent := &PRBodyParent{ID: parentID}
+	pt, err := app.Manager.Get(ctx, parentID)
+	if err != nil {
+		log.Global().WithTicket(t.ID).WithError(err).Debug("failed to read parent ticket", "parent", parentID)
+		return parent
+	}
+	parent.Description = pt.Description
+	if rel, err := filepath.Rel(app.ProjectRoot, pt.Path); err == nil {
+		parent.Path = filepath.ToSlash(rel)
+	}
+	return parent
+}
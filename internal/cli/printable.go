@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/yshrsmz/ticketflow/internal/config"
 	"github.com/yshrsmz/ticketflow/internal/git"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 )
@@ -22,6 +24,9 @@ const (
 	mediumBufferSize = 512  // For single ticket details with metadata fields
 	largeBufferSize  = 1024 // For start results with multi-step instructions and worktree info
 
+	// maxTagsColumnLen caps the width of the TAGS column in ticket lists
+	maxTagsColumnLen = 30
+
 	// Common error messages for consistency across result types
 	ErrNoTicketAvailable = "Error: No ticket available\n"
 
@@ -65,6 +70,25 @@ var (
 	_ Printable = (*NewTicketResult)(nil)
 	_ Printable = (*CloseTicketResult)(nil)
 	_ Printable = (*RestoreTicketResult)(nil)
+	_ Printable = (*TagResult)(nil)
+	_ Printable = (*LinkResult)(nil)
+	_ Printable = (*ReadyTicketsResult)(nil)
+	_ Printable = (*MoveResult)(nil)
+	_ Printable = (*CancelTicketResult)(nil)
+	_ Printable = (*ReopenTicketResult)(nil)
+	_ Printable = (*ArchiveResult)(nil)
+	_ Printable = (*TaskListResult)(nil)
+	_ Printable = (*TaskResult)(nil)
+	_ Printable = (*TicketLogResult)(nil)
+	_ Printable = (*DoctorResult)(nil)
+	_ Printable = (*TicketSearchResult)(nil)
+	_ Printable = (*ViewListResult)(nil)
+	_ Printable = (*TicketTreeResult)(nil)
+	_ Printable = (*FinishResult)(nil)
+	_ Printable = (*PRBodyResult)(nil)
+	_ Printable = (*HooksResult)(nil)
+	_ Printable = (*WhichResult)(nil)
+	_ Printable = (*SyncResult)(nil)
 )
 
 // TextRepresentation returns human-readable format for CleanupResult
@@ -132,9 +156,58 @@ func (r *TicketListResult) TextRepresentation() string {
 		maxIDLen = 2
 	}
 
+	// Status column grows to fit custom workflow state names
+	maxStatusLen := len("STATUS")
+	for _, t := range r.Tickets {
+		if statusLen := len(getTicketStatus(&t)); statusLen > maxStatusLen {
+			maxStatusLen = statusLen
+		}
+	}
+
+	// Tags column is only shown when at least one ticket has tags
+	maxTagsLen := 0
+	for _, t := range r.Tickets {
+		if tagsLen := len(formatTags(t.Tags)); tagsLen > maxTagsLen {
+			maxTagsLen = tagsLen
+		}
+	}
+	if maxTagsLen > 0 {
+		if maxTagsLen < len("TAGS") {
+			maxTagsLen = len("TAGS")
+		}
+		if maxTagsLen > maxTagsColumnLen {
+			maxTagsLen = maxTagsColumnLen
+		}
+	}
+
+	// Tasks column is only shown when at least one ticket has a checklist
+	maxTasksLen := 0
+	for _, t := range r.Tickets {
+		if tasksLen := len(formatTaskProgress(t.TaskProgress())); tasksLen > maxTasksLen {
+			maxTasksLen = tasksLen
+		}
+	}
+	if maxTasksLen > 0 && maxTasksLen < len("TASKS") {
+		maxTasksLen = len("TASKS")
+	}
+
 	// Header
-	fmt.Fprintf(&buf, "%-*s  %-6s  %-3s  %s\n", maxIDLen, "ID", "STATUS", "PRI", "DESCRIPTION")
-	buf.WriteString(strings.Repeat("-", maxIDLen+50))
+	fmt.Fprintf(&buf, "%-*s  %-*s  %-3s  ", maxIDLen, "ID", maxStatusLen, "STATUS", "PRI")
+	if maxTasksLen > 0 {
+		fmt.Fprintf(&buf, "%-*s  ", maxTasksLen, "TASKS")
+	}
+	if maxTagsLen > 0 {
+		fmt.Fprintf(&buf, "%-*s  ", maxTagsLen, "TAGS")
+	}
+	buf.WriteString("DESCRIPTION\n")
+	separatorLen := maxIDLen + maxStatusLen + 44
+	if maxTasksLen > 0 {
+		separatorLen += maxTasksLen + 2
+	}
+	if maxTagsLen > 0 {
+		separatorLen += maxTagsLen + 2
+	}
+	buf.WriteString(strings.Repeat("-", separatorLen))
 	buf.WriteString("\n")
 
 	// Tickets
@@ -148,19 +221,53 @@ func (r *TicketListResult) TextRepresentation() string {
 			desc = desc[:maxDescLen-3] + "..."
 		}
 
-		fmt.Fprintf(&buf, "%-*s  %-6s  %-3d  %s\n",
+		fmt.Fprintf(&buf, "%-*s  %-*s  %-3d  ",
 			maxIDLen,
 			t.ID,
+			maxStatusLen,
 			status,
-			t.Priority,
-			desc)
+			t.Priority)
+		if maxTasksLen > 0 {
+			fmt.Fprintf(&buf, "%-*s  ", maxTasksLen, formatTaskProgress(t.TaskProgress()))
+		}
+		if maxTagsLen > 0 {
+			tags := formatTags(t.Tags)
+			if len(tags) > maxTagsLen {
+				tags = tags[:maxTagsLen-3] + "..."
+			}
+			fmt.Fprintf(&buf, "%-*s  ", maxTagsLen, tags)
+		}
+		buf.WriteString(desc)
+		buf.WriteByte('\n')
 	}
 
 	return buf.String()
 }
 
-// getTicketStatus determines the status of a ticket based on its time fields
+// formatTags joins tags for display in text output
+func formatTags(tags []string) string {
+	return strings.Join(tags, ",")
+}
+
+// formatTaskProgress renders checklist progress as "done/total", or "" without tasks
+func formatTaskProgress(p ticket.TaskProgress) string {
+	if p.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", p.Done, p.Total)
+}
+
+// getTicketStatus determines the status of a ticket.
+// Custom workflow states are shown as-is; otherwise the status is based on the time fields.
 func getTicketStatus(t *ticket.Ticket) string {
+	if t.IsArchived() {
+		return "archived"
+	}
+	switch state := t.State(); state {
+	case ticket.StatusTodo, ticket.StatusDoing, ticket.StatusDone:
+	default:
+		return string(state)
+	}
 	if isTimeSet(t.ClosedAt.Time) {
 		return "done"
 	}
@@ -182,6 +289,19 @@ func (r *TicketListResult) StructuredData() interface{} {
 	}
 }
 
+// ticketColumns are the leading columns of tickets in row-oriented formats
+var ticketColumns = []string{"id", "status", "priority", "description", "tags", "created_at", "started_at", "closed_at"}
+
+// RecordsKey returns the StructuredData key holding the tickets
+func (r *TicketListResult) RecordsKey() string {
+	return "tickets"
+}
+
+// Columns returns the leading columns of the tickets
+func (r *TicketListResult) Columns() []string {
+	return ticketColumns
+}
+
 // TicketResult wraps a single ticket to make it Printable
 type TicketResult struct {
 	Ticket *ticket.Ticket
@@ -198,7 +318,7 @@ func (r *TicketResult) TextRepresentation() string {
 
 	t := r.Ticket
 	fmt.Fprintf(&buf, "ID: %s\n", t.ID)
-	fmt.Fprintf(&buf, "Status: %s\n", t.Status())
+	fmt.Fprintf(&buf, "Status: %s\n", t.State())
 	fmt.Fprintf(&buf, "Priority: %d\n", t.Priority)
 	fmt.Fprintf(&buf, "Description: %s\n", t.Description)
 	fmt.Fprintf(&buf, "Created: %s\n", t.CreatedAt.Format(time.RFC3339))
@@ -215,6 +335,23 @@ func (r *TicketResult) TextRepresentation() string {
 		fmt.Fprintf(&buf, "Related: %s\n", strings.Join(t.Related, ", "))
 	}
 
+	if len(t.Tags) > 0 {
+		fmt.Fprintf(&buf, "Tags: %s\n", strings.Join(t.Tags, ", "))
+	}
+
+	if progress := t.TaskProgress(); progress.Total > 0 {
+		fmt.Fprintf(&buf, "Tasks: %d/%d done\n", progress.Done, progress.Total)
+	}
+
+	if relations := t.Relations(); len(relations) > 0 {
+		buf.WriteString("Relations:\n")
+		for _, relType := range ticket.RelationTypes() {
+			if ids := t.RelationsOfType(relType); len(ids) > 0 {
+				fmt.Fprintf(&buf, "  %s: %s\n", relType, strings.Join(ids, ", "))
+			}
+		}
+	}
+
 	fmt.Fprintf(&buf, "\n%s\n", t.Content)
 
 	return buf.String()
@@ -230,18 +367,30 @@ func (r *TicketResult) StructuredData() interface{} {
 		"ticket": map[string]interface{}{
 			"id":          r.Ticket.ID,
 			"path":        r.Ticket.Path,
-			"status":      string(r.Ticket.Status()),
+			"status":      string(r.Ticket.State()),
 			"priority":    r.Ticket.Priority,
 			"description": r.Ticket.Description,
 			"created_at":  r.Ticket.CreatedAt.Time,
 			"started_at":  r.Ticket.StartedAt.Time,
 			"closed_at":   r.Ticket.ClosedAt.Time,
 			"related":     r.Ticket.Related,
+			"tags":        ticketTags(r.Ticket),
+			"relations":   ticketRelations(r.Ticket),
 			"content":     r.Ticket.Content,
 		},
 	}
 }
 
+// RecordsKey returns the StructuredData key holding the ticket
+func (r *TicketResult) RecordsKey() string {
+	return "ticket"
+}
+
+// Columns returns the leading columns of the ticket
+func (r *TicketResult) Columns() []string {
+	return ticketColumns
+}
+
 // WorktreeListResult wraps worktree list to make it Printable
 type WorktreeListResult struct {
 	Worktrees []git.WorktreeInfo
@@ -280,12 +429,23 @@ func (r *WorktreeListResult) StructuredData() interface{} {
 	}
 }
 
+// RecordsKey returns the StructuredData key holding the worktrees
+func (r *WorktreeListResult) RecordsKey() string {
+	return "worktrees"
+}
+
+// Columns returns the leading columns of the worktrees
+func (r *WorktreeListResult) Columns() []string {
+	return []string{"Path", "Branch", "HEAD"}
+}
+
 // StatusResult wraps status information to make it Printable
 type StatusResult struct {
 	CurrentBranch string
 	CurrentTicket *ticket.Ticket
 	WorktreePath  string
 	Summary       map[string]int
+	States        []string // Configured workflow states in display order
 	TotalTickets  int
 }
 
@@ -299,7 +459,7 @@ func (r *StatusResult) TextRepresentation() string {
 	if r.CurrentTicket != nil {
 		fmt.Fprintf(&buf, "\n🎯 Active ticket: %s\n", r.CurrentTicket.ID)
 		fmt.Fprintf(&buf, "   Description: %s\n", r.CurrentTicket.Description)
-		fmt.Fprintf(&buf, "   Status: %s\n", r.CurrentTicket.Status())
+		fmt.Fprintf(&buf, "   Status: %s\n", r.CurrentTicket.State())
 		if r.CurrentTicket.StartedAt.Time != nil {
 			duration := time.Since(*r.CurrentTicket.StartedAt.Time)
 			fmt.Fprintf(&buf, "   Duration: %s\n", formatDuration(duration))
@@ -316,6 +476,14 @@ func (r *StatusResult) TextRepresentation() string {
 	fmt.Fprintf(&buf, "   📘 Todo:  %d\n", r.Summary["todo"])
 	fmt.Fprintf(&buf, "   🔨 Doing: %d\n", r.Summary["doing"])
 	fmt.Fprintf(&buf, "   ✅ Done:  %d\n", r.Summary["done"])
+	fmt.Fprintf(&buf, "   🚫 Cancelled: %d\n", r.Summary["cancelled"])
+	for _, state := range r.States {
+		switch state {
+		case string(ticket.StatusTodo), string(ticket.StatusDoing), string(ticket.StatusDone), string(ticket.StatusCancelled):
+			continue
+		}
+		fmt.Fprintf(&buf, "   📌 %s: %d\n", strings.ToUpper(state[:1])+state[1:], r.Summary[state])
+	}
 	fmt.Fprintf(&buf, "   ─────────\n")
 	fmt.Fprintf(&buf, "   🔢 Total: %d\n", r.TotalTickets)
 
@@ -366,9 +534,16 @@ func (r *StartResult) TextRepresentation() string {
 			fmt.Fprintf(&buf, "\n📁 Worktree created: %s\n", r.WorktreePath)
 		}
 		if r.ParentBranch != "" {
-			fmt.Fprintf(&buf, "   Parent ticket: %s\n", r.ParentBranch)
+			parent := r.ParentTicket
+			if parent == "" {
+				parent = r.ParentBranch
+			}
+			fmt.Fprintf(&buf, "   Parent ticket: %s\n", parent)
 			fmt.Fprintf(&buf, "   Branch from: %s\n", r.ParentBranch)
 		}
+		if base := r.baseDescription(); base != "" {
+			fmt.Fprintf(&buf, "   Base: %s\n", base)
+		}
 
 		// Show correct status transition
 		if r.IsRecreatingWorktree {
@@ -388,13 +563,16 @@ func (r *StartResult) TextRepresentation() string {
 		fmt.Fprintf(&buf, "2. Make your changes and commit regularly\n")
 		fmt.Fprintf(&buf, "   \n")
 		fmt.Fprintf(&buf, "3. Push branch to create PR:\n")
-		fmt.Fprintf(&buf, "   git push -u origin %s\n", r.Ticket.ID)
+		fmt.Fprintf(&buf, "   git push -u origin %s\n", r.Ticket.BranchName())
 		fmt.Fprintf(&buf, "   \n")
 		fmt.Fprintf(&buf, "4. When done, close the ticket:\n")
 		fmt.Fprintf(&buf, "   ticketflow close\n")
 	} else {
 		// Branch mode
-		fmt.Fprintf(&buf, "\n🌿 Switched to branch: %s\n", r.Ticket.ID)
+		fmt.Fprintf(&buf, "\n🌿 Switched to branch: %s\n", r.Ticket.BranchName())
+		if base := r.baseDescription(); base != "" {
+			fmt.Fprintf(&buf, "   Base: %s\n", base)
+		}
 
 		// Show status transition without "branch recreated" suffix
 		fmt.Fprintf(&buf, "   Status: %s → doing\n", orig)
@@ -407,7 +585,7 @@ func (r *StartResult) TextRepresentation() string {
 		fmt.Fprintf(&buf, "1. Make your changes and commit regularly\n")
 		fmt.Fprintf(&buf, "   \n")
 		fmt.Fprintf(&buf, "2. Push branch to create PR:\n")
-		fmt.Fprintf(&buf, "   git push -u origin %s\n", r.Ticket.ID)
+		fmt.Fprintf(&buf, "   git push -u origin %s\n", r.Ticket.BranchName())
 		fmt.Fprintf(&buf, "   \n")
 		fmt.Fprintf(&buf, "3. When done, close the ticket:\n")
 		fmt.Fprintf(&buf, "   ticketflow close\n")
@@ -416,6 +594,18 @@ func (r *StartResult) TextRepresentation() string {
 	return buf.String()
 }
 
+// baseDescription describes the base of the ticket branch, unless it is
+// already shown as the parent ticket's branch
+func (r *StartResult) baseDescription() string {
+	if r.Base == "" || r.Base == r.ParentBranch {
+		return ""
+	}
+	if r.BaseCommit != "" {
+		return fmt.Sprintf("%s (%s)", r.Base, shortCommit(r.BaseCommit))
+	}
+	return r.Base
+}
+
 // StructuredData returns start data for JSON serialization
 func (r *StartResult) StructuredData() interface{} {
 	return map[string]interface{}{
@@ -424,8 +614,11 @@ func (r *StartResult) StructuredData() interface{} {
 		"original_status":        string(r.OriginalStatus),
 		"is_recreating_worktree": r.IsRecreatingWorktree,
 		"worktree_path":          r.WorktreePath,
-		"branch":                 r.Ticket.ID,
+		"branch":                 r.Ticket.BranchName(),
 		"parent_branch":          r.ParentBranch,
+		"parent_ticket":          r.ParentTicket,
+		"base":                   r.Base,
+		"base_commit":            r.BaseCommit,
 		"init_commands_executed": r.InitCommandsExecuted,
 	}
 }
@@ -650,3 +843,1292 @@ func (r *RestoreTicketResult) StructuredData() interface{} {
 
 	return output
 }
+
+// TagResult represents the result of adding or removing ticket tags
+type TagResult struct {
+	Ticket  *ticket.Ticket
+	Action  TagAction
+	Changed []string // Tags actually added or removed
+}
+
+// TextRepresentation returns human-readable format for tag result
+func (r *TagResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	switch {
+	case len(r.Changed) == 0 && r.Action == TagActionAdd:
+		fmt.Fprintf(&buf, "Ticket %s already has the given tags\n", r.Ticket.ID)
+	case len(r.Changed) == 0:
+		fmt.Fprintf(&buf, "Ticket %s does not have the given tags\n", r.Ticket.ID)
+	case r.Action == TagActionAdd:
+		fmt.Fprintf(&buf, "✅ Added tags to %s: %s\n", r.Ticket.ID, strings.Join(r.Changed, ", "))
+	default:
+		fmt.Fprintf(&buf, "✅ Removed tags from %s: %s\n", r.Ticket.ID, strings.Join(r.Changed, ", "))
+	}
+
+	if len(r.Ticket.Tags) > 0 {
+		fmt.Fprintf(&buf, "   Tags: %s\n", strings.Join(r.Ticket.Tags, ", "))
+	} else {
+		fmt.Fprintf(&buf, "   Tags: (none)\n")
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TagResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"success":   true,
+		"ticket_id": r.Ticket.ID,
+		"action":    string(r.Action),
+		"changed":   r.Changed,
+		"tags":      ticketTags(r.Ticket),
+	}
+}
+
+// LinkResult represents the result of linking or unlinking two tickets
+type LinkResult struct {
+	Ticket       *ticket.Ticket
+	Target       *ticket.Ticket
+	RelationType ticket.RelationType
+	Linked       bool // true for link, false for unlink
+	Changed      bool // Whether any ticket file was modified
+}
+
+// TextRepresentation returns human-readable format for link result
+func (r *LinkResult) TextRepresentation() string {
+	if r.Ticket == nil || r.Target == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	switch {
+	case !r.Changed && r.Linked:
+		fmt.Fprintf(&buf, "%s already %s %s\n", r.Ticket.ID, r.RelationType, r.Target.ID)
+	case !r.Changed:
+		fmt.Fprintf(&buf, "%s does not %s %s\n", r.Ticket.ID, r.RelationType, r.Target.ID)
+	case r.Linked:
+		fmt.Fprintf(&buf, "✅ Linked: %s %s %s\n", r.Ticket.ID, r.RelationType, r.Target.ID)
+		fmt.Fprintf(&buf, "   Inverse: %s %s %s\n", r.Target.ID, r.RelationType.Inverse(), r.Ticket.ID)
+	default:
+		fmt.Fprintf(&buf, "✅ Unlinked: %s %s %s\n", r.Ticket.ID, r.RelationType, r.Target.ID)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *LinkResult) StructuredData() interface{} {
+	if r.Ticket == nil || r.Target == nil {
+		return nil
+	}
+
+	action := "unlink"
+	if r.Linked {
+		action = "link"
+	}
+
+	return map[string]interface{}{
+		"success":          true,
+		"action":           action,
+		"changed":          r.Changed,
+		"ticket_id":        r.Ticket.ID,
+		"target_id":        r.Target.ID,
+		"relation":         string(r.RelationType),
+		"inverse_relation": string(r.RelationType.Inverse()),
+		"relations":        ticketRelations(r.Ticket),
+	}
+}
+
+// ReadyTicketsResult represents the actionable todo tickets
+type ReadyTicketsResult struct {
+	Tickets  []ticket.Ticket
+	NotReady []NotReadyTicket
+}
+
+// TextRepresentation returns human-readable format for ready tickets
+func (r *ReadyTicketsResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	if len(r.Tickets) == 0 {
+		buf.WriteString("No tickets are ready to start\n")
+	} else {
+		list := &TicketListResult{Tickets: r.Tickets}
+		buf.WriteString(list.TextRepresentation())
+		fmt.Fprintf(&buf, "\nNext: ticketflow start %s\n", r.Tickets[0].ID)
+	}
+
+	if len(r.NotReady) > 0 {
+		fmt.Fprintf(&buf, "\n%d todo ticket(s) not ready:\n", len(r.NotReady))
+		for _, nr := range r.NotReady {
+			fmt.Fprintf(&buf, "  %s (%s: %s)\n", nr.ID, strings.ReplaceAll(nr.Reason, "_", " "), strings.Join(nr.Details, ", "))
+		}
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *ReadyTicketsResult) StructuredData() interface{} {
+	tickets := make([]map[string]interface{}, len(r.Tickets))
+	for i := range r.Tickets {
+		tickets[i] = ticketToJSON(&r.Tickets[i], "")
+	}
+
+	notReady := make([]map[string]interface{}, len(r.NotReady))
+	for i, nr := range r.NotReady {
+		notReady[i] = map[string]interface{}{
+			"id":      nr.ID,
+			"reason":  nr.Reason,
+			"details": nr.Details,
+		}
+	}
+
+	output := map[string]interface{}{
+		"tickets":   tickets,
+		"not_ready": notReady,
+	}
+	if len(r.Tickets) > 0 {
+		output["next"] = r.Tickets[0].ID
+	}
+	return output
+}
+
+// MoveResult represents the result of moving a ticket to another workflow state
+type MoveResult struct {
+	Ticket *ticket.Ticket
+	From   string
+	To     string
+}
+
+// TextRepresentation returns human-readable format for move result
+func (r *MoveResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	fmt.Fprintf(&buf, "✅ Moved ticket: %s\n", r.Ticket.ID)
+	fmt.Fprintf(&buf, "   %s → %s\n", r.From, r.To)
+	fmt.Fprintf(&buf, "   File: %s\n", r.Ticket.Path)
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *MoveResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"success":   true,
+		"ticket_id": r.Ticket.ID,
+		"from":      r.From,
+		"to":        r.To,
+		"ticket":    ticketToJSON(r.Ticket, ""),
+	}
+}
+
+// CancelTicketResult represents the result of cancelling a ticket
+type CancelTicketResult struct {
+	Ticket         *ticket.Ticket
+	Reason         string
+	OriginalStatus ticket.Status
+	WorktreePath   string // Worktree left behind for cleanup, if any
+}
+
+// TextRepresentation returns human-readable format for cancel result
+func (r *CancelTicketResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	fmt.Fprintf(&buf, "\n🚫 Ticket cancelled: %s\n", r.Ticket.ID)
+	if r.Ticket.Description != "" {
+		fmt.Fprintf(&buf, "   Description: %s\n", r.Ticket.Description)
+	}
+	fmt.Fprintf(&buf, "   Reason: %s\n", r.Reason)
+	fmt.Fprintf(&buf, "   Status: %s → %s\n", r.OriginalStatus, r.Ticket.State())
+	fmt.Fprintf(&buf, "   Committed: \"Cancel ticket: %s (%s)\"\n", r.Ticket.ID, r.Reason)
+
+	if r.WorktreePath != "" {
+		fmt.Fprintf(&buf, "\n💡 Ticket has a worktree. Run this to clean up:\n")
+		fmt.Fprintf(&buf, "   ticketflow cleanup %s\n", r.Ticket.ID)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *CancelTicketResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	output := map[string]interface{}{
+		"success":         true,
+		"ticket_id":       r.Ticket.ID,
+		"status":          string(r.Ticket.State()),
+		"original_status": string(r.OriginalStatus),
+		"reason":          r.Reason,
+	}
+
+	if r.Ticket.ClosedAt.Time != nil {
+		output["closed_at"] = r.Ticket.ClosedAt.Time.Format(time.RFC3339)
+	}
+
+	if r.WorktreePath != "" {
+		output["worktree_path"] = r.WorktreePath
+	}
+
+	return output
+}
+
+// ReopenTicketResult represents the result of reopening a closed ticket
+type ReopenTicketResult struct {
+	Ticket         *ticket.Ticket
+	OriginalStatus ticket.Status
+	Start          *StartResult // Set when the ticket was started right after reopening
+}
+
+// TextRepresentation returns human-readable format for reopen result
+func (r *ReopenTicketResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	fmt.Fprintf(&buf, "\n🔄 Ticket reopened: %s\n", r.Ticket.ID)
+	if r.Ticket.Description != "" {
+		fmt.Fprintf(&buf, "   Description: %s\n", r.Ticket.Description)
+	}
+	fmt.Fprintf(&buf, "   Status: %s → todo\n", r.OriginalStatus)
+	fmt.Fprintf(&buf, "   Committed: \"Reopen ticket: %s\"\n", r.Ticket.ID)
+
+	if r.Start != nil {
+		buf.WriteString(r.Start.TextRepresentation())
+	} else {
+		fmt.Fprintf(&buf, "\n💡 To continue working on it:\n")
+		fmt.Fprintf(&buf, "   ticketflow start %s\n", r.Ticket.ID)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *ReopenTicketResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	output := map[string]interface{}{
+		"success":         true,
+		"ticket_id":       r.Ticket.ID,
+		"status":          string(r.Ticket.State()),
+		"original_status": string(r.OriginalStatus),
+		"started":         r.Start != nil,
+	}
+
+	if r.Start != nil {
+		output["start"] = r.Start.StructuredData()
+	}
+
+	return output
+}
+
+// ArchivedTicket describes a done ticket moved (or to be moved) into the archive
+type ArchivedTicket struct {
+	Ticket *ticket.Ticket
+	From   string
+	To     string
+}
+
+// ArchiveResult represents the result of archiving old done tickets
+type ArchiveResult struct {
+	Tickets   []ArchivedTicket
+	OlderThan time.Duration
+	Cutoff    time.Time
+	DryRun    bool
+	Skipped   int // Done tickets without a closed_at timestamp
+}
+
+// TextRepresentation returns human-readable format for archive result
+func (r *ArchiveResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	if len(r.Tickets) == 0 {
+		fmt.Fprintf(&buf, "No done tickets closed before %s\n", r.Cutoff.Format(time.DateOnly))
+	} else {
+		if r.DryRun {
+			fmt.Fprintf(&buf, "\n🔍 Would archive %d ticket(s) closed before %s:\n", len(r.Tickets), r.Cutoff.Format(time.DateOnly))
+		} else {
+			fmt.Fprintf(&buf, "\n📦 Archived %d ticket(s) closed before %s:\n", len(r.Tickets), r.Cutoff.Format(time.DateOnly))
+		}
+		for _, a := range r.Tickets {
+			fmt.Fprintf(&buf, "   %s → %s\n", a.Ticket.ID, filepath.Dir(a.To))
+		}
+		if !r.DryRun {
+			fmt.Fprintf(&buf, "   Committed: \"Archive %d done ticket(s)\"\n", len(r.Tickets))
+		}
+	}
+
+	if r.Skipped > 0 {
+		fmt.Fprintf(&buf, "\n⚠️  Skipped %d done ticket(s) without closed_at\n", r.Skipped)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *ArchiveResult) StructuredData() interface{} {
+	tickets := make([]map[string]interface{}, len(r.Tickets))
+	for i, a := range r.Tickets {
+		tickets[i] = map[string]interface{}{
+			"id":        a.Ticket.ID,
+			"closed_at": a.Ticket.ClosedAt.Time,
+			"from":      a.From,
+			"to":        a.To,
+		}
+	}
+
+	return map[string]interface{}{
+		"success":        true,
+		"dry_run":        r.DryRun,
+		"older_than":     r.OlderThan.String(),
+		"cutoff":         r.Cutoff.Format(time.RFC3339),
+		"archived_count": len(r.Tickets),
+		"skipped":        r.Skipped,
+		"tickets":        tickets,
+	}
+}
+
+// TaskListResult represents the checklist items of a ticket
+type TaskListResult struct {
+	Ticket *ticket.Ticket
+	Tasks  []ticket.Task
+}
+
+// TextRepresentation returns human-readable format for task list result
+func (r *TaskListResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	if len(r.Tasks) == 0 {
+		return fmt.Sprintf("Ticket %s has no tasks\n", r.Ticket.ID)
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	progress := r.Ticket.TaskProgress()
+	fmt.Fprintf(&buf, "Tasks for %s (%d/%d done):\n", r.Ticket.ID, progress.Done, progress.Total)
+	for _, task := range r.Tasks {
+		mark := " "
+		if task.Done {
+			mark = "x"
+		}
+		fmt.Fprintf(&buf, "  %2d. [%s] %s\n", task.Number, mark, task.Text)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TaskListResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	tasks := make([]map[string]interface{}, len(r.Tasks))
+	for i, task := range r.Tasks {
+		tasks[i] = taskToJSON(task)
+	}
+
+	return map[string]interface{}{
+		"ticket_id": r.Ticket.ID,
+		"tasks":     tasks,
+		"progress":  ticketTasks(r.Ticket),
+	}
+}
+
+// TaskResult represents the result of checking or unchecking a task
+type TaskResult struct {
+	Ticket  *ticket.Ticket
+	Action  TaskAction
+	Task    ticket.Task
+	Changed bool // False when the task was already in the requested state
+}
+
+// TextRepresentation returns human-readable format for task result
+func (r *TaskResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	switch {
+	case !r.Changed && r.Action == TaskActionCheck:
+		fmt.Fprintf(&buf, "Task %d of %s is already checked: %s\n", r.Task.Number, r.Ticket.ID, r.Task.Text)
+	case !r.Changed:
+		fmt.Fprintf(&buf, "Task %d of %s is already unchecked: %s\n", r.Task.Number, r.Ticket.ID, r.Task.Text)
+	case r.Action == TaskActionCheck:
+		fmt.Fprintf(&buf, "✅ Checked task %d of %s: %s\n", r.Task.Number, r.Ticket.ID, r.Task.Text)
+	default:
+		fmt.Fprintf(&buf, "✅ Unchecked task %d of %s: %s\n", r.Task.Number, r.Ticket.ID, r.Task.Text)
+	}
+
+	progress := r.Ticket.TaskProgress()
+	fmt.Fprintf(&buf, "   Progress: %d/%d done\n", progress.Done, progress.Total)
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TaskResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"success":   true,
+		"ticket_id": r.Ticket.ID,
+		"action":    string(r.Action),
+		"changed":   r.Changed,
+		"task":      taskToJSON(r.Task),
+		"progress":  ticketTasks(r.Ticket),
+	}
+}
+
+// TicketLogResult represents the git history of a ticket
+type TicketLogResult struct {
+	Ticket        *ticket.Ticket
+	DefaultBranch string
+	Commits       []git.CommitInfo // Newest first
+}
+
+// TextRepresentation returns human-readable format for ticket log result
+func (r *TicketLogResult) TextRepresentation() string {
+	if r.Ticket == nil {
+		return ErrNoTicketAvailable
+	}
+
+	if len(r.Commits) == 0 {
+		return fmt.Sprintf("No commits found for ticket %s\n", r.Ticket.ID)
+	}
+
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	fmt.Fprintf(&buf, "History of %s (%d commit(s)):\n\n", r.Ticket.ID, len(r.Commits))
+	for _, c := range r.Commits {
+		merged := "  "
+		if c.Merged {
+			merged = "✓ "
+		}
+		fmt.Fprintf(&buf, "%s%s  %s  %-8s %s\n", merged, c.ShortSHA(), c.Date.Format("2006-01-02 15:04"), commitSource(c), c.Subject)
+		fmt.Fprintf(&buf, "                    %s <%s>\n", c.Author, c.Email)
+	}
+	fmt.Fprintf(&buf, "\n✓ = merged into %s\n", r.DefaultBranch)
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TicketLogResult) StructuredData() interface{} {
+	if r.Ticket == nil {
+		return nil
+	}
+
+	commits := make([]map[string]interface{}, len(r.Commits))
+	for i, c := range r.Commits {
+		commits[i] = map[string]interface{}{
+			"sha":          c.SHA,
+			"author":       c.Author,
+			"email":        c.Email,
+			"date":         c.Date.Format(time.RFC3339),
+			"subject":      c.Subject,
+			"touches_file": c.TouchesFile,
+			"on_branch":    c.OnBranch,
+			"merged":       c.Merged,
+		}
+	}
+
+	return map[string]interface{}{
+		"ticket_id":      r.Ticket.ID,
+		"default_branch": r.DefaultBranch,
+		"commits":        commits,
+	}
+}
+
+// commitSource labels where a commit in a ticket's history came from
+func commitSource(c git.CommitInfo) string {
+	switch {
+	case c.TouchesFile && c.OnBranch:
+		return "[both]"
+	case c.OnBranch:
+		return "[branch]"
+	default:
+		return "[file]"
+	}
+}
+
+// DoctorResult represents the problems found by the doctor command
+type DoctorResult struct {
+	Issues        []DoctorIssue
+	TicketCount   int
+	WorktreeCount int
+	BranchCount   int
+	FixRequested  bool
+}
+
+// FixedCount returns the number of issues repaired by --fix
+func (r *DoctorResult) FixedCount() int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Fixed {
+			count++
+		}
+	}
+	return count
+}
+
+// ProblemCount returns the number of issues other than info notes
+func (r *DoctorResult) ProblemCount() int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity != DoctorSeverityInfo {
+			count++
+		}
+	}
+	return count
+}
+
+// Healthy reports whether no unresolved problems remain
+func (r *DoctorResult) Healthy() bool {
+	return r.FixedCount() == r.ProblemCount()
+}
+
+// TextRepresentation returns human-readable format for doctor result
+func (r *DoctorResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	fmt.Fprintf(&buf, "Checked %d ticket(s), %d worktree(s), %d branch(es)\n\n", r.TicketCount, r.WorktreeCount, r.BranchCount)
+	if len(r.Issues) == 0 {
+		buf.WriteString("✅ No problems found\n")
+		return buf.String()
+	}
+
+	fixable := 0
+	for _, issue := range r.Issues {
+		icon := "⚠️ "
+		switch issue.Severity {
+		case DoctorSeverityError:
+			icon = "❌"
+		case DoctorSeverityInfo:
+			icon = "ℹ️ "
+		}
+		if issue.Fixed {
+			icon = "✅"
+		}
+
+		subject := ""
+		if issue.TicketID != "" {
+			subject = issue.TicketID + ": "
+		}
+		fmt.Fprintf(&buf, "%s [%s] %s%s\n", icon, issue.Code, subject, issue.Message)
+
+		switch {
+		case issue.Fixed:
+			buf.WriteString("   Fixed\n")
+		case issue.FixError != "":
+			fmt.Fprintf(&buf, "   Fix failed: %s\n", issue.FixError)
+		default:
+			fmt.Fprintf(&buf, "   Fix: %s\n", issue.Remedy)
+		}
+		if issue.Fixable && !issue.Fixed {
+			fixable++
+		}
+	}
+
+	fmt.Fprintf(&buf, "\nFound %d problem(s)", r.ProblemCount())
+	if r.FixRequested {
+		fmt.Fprintf(&buf, ", fixed %d", r.FixedCount())
+	}
+	buf.WriteString("\n")
+	if !r.FixRequested && fixable > 0 {
+		fmt.Fprintf(&buf, "Run 'ticketflow doctor --fix' to repair %d of them\n", fixable)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *DoctorResult) StructuredData() interface{} {
+	issues := make([]map[string]interface{}, len(r.Issues))
+	for i, issue := range r.Issues {
+		m := map[string]interface{}{
+			"code":     issue.Code,
+			"severity": issue.Severity,
+			"message":  issue.Message,
+			"remedy":   issue.Remedy,
+			"fixable":  issue.Fixable,
+			"fixed":    issue.Fixed,
+		}
+		if issue.TicketID != "" {
+			m["ticket_id"] = issue.TicketID
+		}
+		if issue.FixError != "" {
+			m["fix_error"] = issue.FixError
+		}
+		issues[i] = m
+	}
+
+	return map[string]interface{}{
+		"healthy": r.Healthy(),
+		"checked": map[string]interface{}{
+			"tickets":   r.TicketCount,
+			"worktrees": r.WorktreeCount,
+			"branches":  r.BranchCount,
+		},
+		"issues": issues,
+		"fixed":  r.FixedCount(),
+	}
+}
+
+// maxSearchSnippets is the number of matched lines shown per ticket in text output
+const maxSearchSnippets = 3
+
+// TicketSearchResult represents the tickets found by a full-text search
+type TicketSearchResult struct {
+	Query   string
+	Regex   bool
+	Results []ticket.SearchResult // Best match first
+	Total   int                   // Number of matches before the count limit
+}
+
+// TextRepresentation returns human-readable format for ticket search result
+func (r *TicketSearchResult) TextRepresentation() string {
+	query := describeSearch(r.Query, r.Regex)
+	if len(r.Results) == 0 {
+		return fmt.Sprintf("No tickets matching %s\n", query)
+	}
+
+	var buf strings.Builder
+	buf.Grow(largeBufferSize)
+
+	if r.Total > len(r.Results) {
+		fmt.Fprintf(&buf, "Showing %d of %d ticket(s) matching %s:\n", len(r.Results), r.Total, query)
+	} else {
+		fmt.Fprintf(&buf, "Found %d ticket(s) matching %s:\n", r.Total, query)
+	}
+
+	for _, result := range r.Results {
+		t := result.Ticket
+		fmt.Fprintf(&buf, "\n%s [%s] %s\n", t.ID, t.State(), t.Description)
+		fmt.Fprintf(&buf, "  Matched: %s\n", strings.Join(result.Fields, ", "))
+		for i, m := range result.Matches {
+			if i == maxSearchSnippets {
+				fmt.Fprintf(&buf, "  … %d more matching line(s)\n", len(result.Matches)-maxSearchSnippets)
+				break
+			}
+			fmt.Fprintf(&buf, "  %4d: %s\n", m.Line, m.Text)
+		}
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TicketSearchResult) StructuredData() interface{} {
+	results := make([]map[string]interface{}, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = map[string]interface{}{
+			"ticket":         ticketToJSON(&result.Ticket, ""),
+			"score":          result.Score,
+			"matched_fields": result.Fields,
+			"matches":        searchMatchesToJSON(result.Matches),
+		}
+	}
+
+	return map[string]interface{}{
+		"query":   r.Query,
+		"regex":   r.Regex,
+		"total":   r.Total,
+		"results": results,
+	}
+}
+
+// ViewListResult represents the saved views defined in the configuration
+type ViewListResult struct {
+	Views []*ticket.View
+}
+
+// TextRepresentation returns human-readable format for view list result
+func (r *ViewListResult) TextRepresentation() string {
+	if len(r.Views) == 0 {
+		return fmt.Sprintf("No saved views. Define them under 'views:' in %s\n", config.ConfigFileName)
+	}
+
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	for i, v := range r.Views {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(v.Name)
+		if v.Description != "" {
+			fmt.Fprintf(&buf, " - %s", v.Description)
+		}
+		buf.WriteString("\n")
+
+		states := string(ticket.StatusFilterActive)
+		if len(v.States) > 0 {
+			states = strings.Join(v.States, ", ")
+		}
+		if v.IncludeArchived {
+			states += " (including archived)"
+		}
+		fmt.Fprintf(&buf, "  Status: %s\n", states)
+		if v.Expression != "" {
+			fmt.Fprintf(&buf, "  Filter: %s\n", v.Expression)
+		}
+		if len(v.Sort) > 0 {
+			fmt.Fprintf(&buf, "  Sort:   %s\n", sortKeysString(v.Sort))
+		}
+		if v.Count > 0 {
+			fmt.Fprintf(&buf, "  Count:  %d\n", v.Count)
+		}
+	}
+	fmt.Fprintf(&buf, "\nShow a view with 'ticketflow list --view <name>'\n")
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *ViewListResult) StructuredData() interface{} {
+	views := make([]map[string]interface{}, len(r.Views))
+	for i, v := range r.Views {
+		states := v.States
+		if states == nil {
+			states = []string{}
+		}
+		views[i] = map[string]interface{}{
+			"name":             v.Name,
+			"description":      v.Description,
+			"status":           states,
+			"filter":           v.Expression,
+			"sort":             sortKeysString(v.Sort),
+			"count":            v.Count,
+			"include_archived": v.IncludeArchived,
+		}
+	}
+	return map[string]interface{}{
+		"views": views,
+	}
+}
+
+// TicketTreeResult represents tickets arranged by their parent relations
+type TicketTreeResult struct {
+	Roots []*TicketTreeNode
+	// RootID is set when only the subtree of one ticket is shown
+	RootID string
+	// Count holds the summary counts by state (list --tree only)
+	Count map[string]int
+}
+
+// TextRepresentation returns human-readable format for ticket tree result
+func (r *TicketTreeResult) TextRepresentation() string {
+	if len(r.Roots) == 0 {
+		return "No tickets found\n"
+	}
+
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	for _, root := range r.Roots {
+		writeTreeNode(&buf, root, "", "")
+	}
+
+	return buf.String()
+}
+
+// writeTreeNode writes a node line and its children, drawing the branches
+// with prefix for the node line and childPrefix for the lines below it
+func writeTreeNode(buf *strings.Builder, node *TicketTreeNode, prefix, childPrefix string) {
+	t := &node.Ticket
+	fmt.Fprintf(buf, "%s%s [%s]", prefix, t.ID, getTicketStatus(t))
+	if t.Description != "" {
+		fmt.Fprintf(buf, " %s", t.Description)
+	}
+
+	var progress []string
+	if tasks := formatTaskProgress(t.TaskProgress()); tasks != "" {
+		progress = append(progress, "tasks "+tasks)
+	}
+	if node.Rollup.Total > 0 {
+		progress = append(progress, fmt.Sprintf("sub-tickets %d/%d closed", node.Rollup.Closed, node.Rollup.Total))
+	}
+	if len(progress) > 0 {
+		fmt.Fprintf(buf, "  (%s)", strings.Join(progress, ", "))
+	}
+	if node.CycleParent != "" {
+		fmt.Fprintf(buf, "  ⚠️  circular parent: %s", node.CycleParent)
+	}
+	buf.WriteByte('\n')
+
+	for i, child := range node.Children {
+		if i == len(node.Children)-1 {
+			writeTreeNode(buf, child, childPrefix+"└── ", childPrefix+"    ")
+		} else {
+			writeTreeNode(buf, child, childPrefix+"├── ", childPrefix+"│   ")
+		}
+	}
+}
+
+// StructuredData returns data for JSON serialization
+func (r *TicketTreeResult) StructuredData() interface{} {
+	data := map[string]interface{}{
+		"tree": treeNodesToJSON(r.Roots),
+	}
+	if r.RootID != "" {
+		data["root"] = r.RootID
+	}
+	if r.Count != nil {
+		data["summary"] = r.Count
+	}
+	return data
+}
+
+// treeNodesToJSON converts tree nodes and their children to nested JSON objects
+func treeNodesToJSON(nodes []*TicketTreeNode) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(nodes))
+	for i, node := range nodes {
+		byState := node.Rollup.ByState
+		if byState == nil {
+			byState = map[string]int{}
+		}
+		result[i] = map[string]interface{}{
+			"ticket":   ticketToJSON(&node.Ticket, ""),
+			"children": treeNodesToJSON(node.Children),
+			"rollup": map[string]interface{}{
+				"total":    node.Rollup.Total,
+				"closed":   node.Rollup.Closed,
+				"by_state": byState,
+			},
+		}
+		if node.CycleParent != "" {
+			result[i]["circular_parent"] = node.CycleParent
+		}
+	}
+	return result
+}
+
+// FinishResult represents the result of squash-merging a ticket branch into
+// the default branch
+type FinishResult struct {
+	Ticket        *ticket.Ticket
+	Branch        string
+	TargetBranch  string
+	Commits       int // Commits on the ticket branch squashed into one
+	CommitMessage string
+	MergeCommit   string
+	WorktreePath  string
+	Cleanup       bool
+	DryRun        bool
+
+	WorktreeRemoved bool
+	BranchDeleted   bool
+	Warnings        []string
+}
+
+// TextRepresentation returns human-readable format for finish result
+func (r *FinishResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	if r.DryRun {
+		fmt.Fprintf(&buf, "\n🔍 Would finish ticket: %s\n", r.Ticket.ID)
+		fmt.Fprintf(&buf, "   Squash-merge %d commit(s) from %s into %s\n", r.Commits, r.Branch, r.TargetBranch)
+		buf.WriteString("   Commit message:\n")
+		for _, line := range strings.Split(r.CommitMessage, "\n") {
+			if line == "" {
+				buf.WriteByte('\n')
+				continue
+			}
+			fmt.Fprintf(&buf, "      %s\n", line)
+		}
+		buf.WriteString("   Close the ticket\n")
+		if r.Cleanup {
+			if r.WorktreePath != "" {
+				fmt.Fprintf(&buf, "   Remove worktree: %s\n", r.WorktreePath)
+			}
+			fmt.Fprintf(&buf, "   Delete branch: %s\n", r.Branch)
+		}
+		return buf.String()
+	}
+
+	fmt.Fprintf(&buf, "\n✅ Ticket finished: %s\n", r.Ticket.ID)
+	if r.Ticket.Description != "" {
+		fmt.Fprintf(&buf, "   Description: %s\n", r.Ticket.Description)
+	}
+	fmt.Fprintf(&buf, "   Squash-merged %d commit(s) from %s into %s", r.Commits, r.Branch, r.TargetBranch)
+	if r.MergeCommit != "" {
+		fmt.Fprintf(&buf, " (%s)", shortCommit(r.MergeCommit))
+	}
+	buf.WriteByte('\n')
+	if r.WorktreeRemoved {
+		fmt.Fprintf(&buf, "   Removed worktree: %s\n", r.WorktreePath)
+	}
+	if r.BranchDeleted {
+		fmt.Fprintf(&buf, "   Deleted branch: %s\n", r.Branch)
+	}
+
+	for _, warning := range r.Warnings {
+		fmt.Fprintf(&buf, "⚠️  %s\n", warning)
+	}
+
+	if !r.Cleanup {
+		buf.WriteString("\n💡 Remove the worktree and branch with:\n")
+		fmt.Fprintf(&buf, "   ticketflow cleanup %s\n", r.Ticket.ID)
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *FinishResult) StructuredData() interface{} {
+	output := map[string]interface{}{
+		"success":          true,
+		"dry_run":          r.DryRun,
+		"ticket":           ticketToJSON(r.Ticket, r.WorktreePath),
+		"branch":           r.Branch,
+		"target_branch":    r.TargetBranch,
+		"commits":          r.Commits,
+		"commit_message":   r.CommitMessage,
+		"cleanup":          r.Cleanup,
+		"worktree_removed": r.WorktreeRemoved,
+		"branch_deleted":   r.BranchDeleted,
+		"warnings":         r.Warnings,
+	}
+	if r.MergeCommit != "" {
+		output["merge_commit"] = r.MergeCommit
+	}
+	return output
+}
+
+// PRBodyResult represents a rendered pull request description
+type PRBodyResult struct {
+	Ticket  *ticket.Ticket
+	Body    string
+	Commits []git.CommitInfo
+	File    string // File the description was written to; empty for stdout
+}
+
+// TextRepresentation returns the description itself, so it can be piped into
+// other tools, or a note when it was written to a file
+func (r *PRBodyResult) TextRepresentation() string {
+	if r.File != "" {
+		return fmt.Sprintf("✅ Wrote pull request description for %s to %s\n", r.Ticket.ID, r.File)
+	}
+	return r.Body
+}
+
+// StructuredData returns data for JSON serialization
+func (r *PRBodyResult) StructuredData() interface{} {
+	commits := make([]map[string]interface{}, len(r.Commits))
+	for i, c := range r.Commits {
+		commits[i] = map[string]interface{}{
+			"sha":     c.SHA,
+			"subject": c.Subject,
+		}
+	}
+
+	output := map[string]interface{}{
+		"ticket_id": r.Ticket.ID,
+		"title":     TicketTitle(r.Ticket),
+		"body":      r.Body,
+		"commits":   commits,
+	}
+	if r.File != "" {
+		output["file"] = r.File
+	}
+	return output
+}
+
+// HooksResult represents the result of installing or uninstalling git hooks
+type HooksResult struct {
+	Install  bool // false for uninstall
+	Dir      string
+	Validate bool
+	Hooks    []HookChange
+}
+
+// TextRepresentation returns human-readable format for hooks result
+func (r *HooksResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(smallBufferSize)
+
+	if r.Install {
+		fmt.Fprintf(&buf, "✅ Installed git hooks in %s\n", r.Dir)
+	} else {
+		fmt.Fprintf(&buf, "✅ Uninstalled git hooks from %s\n", r.Dir)
+	}
+
+	for _, h := range r.Hooks {
+		fmt.Fprintf(&buf, "   %s", h.Name)
+		switch {
+		case h.Skipped != "":
+			fmt.Fprintf(&buf, ": %s, left unchanged", h.Skipped)
+		case h.Chained && r.Install:
+			fmt.Fprintf(&buf, " (runs the previous hook first: %s%s)", h.Name, chainedHookSuffix)
+		case h.Chained:
+			buf.WriteString(" (previous hook restored)")
+		}
+		buf.WriteByte('\n')
+	}
+
+	if r.Install {
+		if r.Validate {
+			fmt.Fprintf(&buf, "\nCommits for a ticket get a '%s: <id>' trailer; commits without it are rejected.\n", TicketTrailer)
+		} else {
+			fmt.Fprintf(&buf, "\nCommits for a ticket get a '%s: <id>' trailer.\n", TicketTrailer)
+		}
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *HooksResult) StructuredData() interface{} {
+	hooks := make([]map[string]interface{}, len(r.Hooks))
+	for i, h := range r.Hooks {
+		hook := map[string]interface{}{
+			"name":    h.Name,
+			"path":    h.Path,
+			"chained": h.Chained,
+			"changed": h.Skipped == "",
+		}
+		if h.Skipped != "" {
+			hook["skipped"] = h.Skipped
+		}
+		hooks[i] = hook
+	}
+
+	action := "uninstall"
+	if r.Install {
+		action = "install"
+	}
+	output := map[string]interface{}{
+		"success": true,
+		"action":  action,
+		"dir":     r.Dir,
+		"hooks":   hooks,
+	}
+	if r.Install {
+		output["validate"] = r.Validate
+	}
+	return output
+}
+
+// WhichResult represents the ticket a commit belongs to
+type WhichResult struct {
+	Commit git.CommitInfo
+	File   string // Set when looking up a file:line
+	Line   int
+	Source string // One of the WhichSource constants
+	Ref    string // Branch or merge commit the ticket was found through
+	Ticket *ticket.Ticket
+}
+
+// TextRepresentation returns human-readable format for which result
+func (r *WhichResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	fmt.Fprintf(&buf, "Commit: %s %s\n", r.Commit.ShortSHA(), r.Commit.Subject)
+	if r.File != "" {
+		fmt.Fprintf(&buf, "Line: %s:%d\n", r.File, r.Line)
+	}
+	fmt.Fprintf(&buf, "Found via: %s\n\n", r.sourceDescription())
+
+	buf.WriteString((&TicketResult{Ticket: r.Ticket}).TextRepresentation())
+	return buf.String()
+}
+
+// sourceDescription describes where the ticket was found
+func (r *WhichResult) sourceDescription() string {
+	switch r.Source {
+	case WhichSourceTrailer:
+		return "Ticket trailer"
+	case WhichSourceBranch:
+		return "branch " + r.Ref
+	case WhichSourceMessage:
+		return "commit message"
+	case WhichSourceMerge:
+		return "merge commit " + shortCommit(r.Ref)
+	default:
+		return r.Source
+	}
+}
+
+// StructuredData returns data for JSON serialization
+func (r *WhichResult) StructuredData() interface{} {
+	output := map[string]interface{}{
+		"commit": map[string]interface{}{
+			"sha":     r.Commit.SHA,
+			"subject": r.Commit.Subject,
+			"author":  r.Commit.Author,
+			"date":    r.Commit.Date,
+		},
+		"source": r.Source,
+	}
+	if r.Ref != "" {
+		output["ref"] = r.Ref
+	}
+	if r.File != "" {
+		output["file"] = r.File
+		output["line"] = r.Line
+	}
+	if data, ok := (&TicketResult{Ticket: r.Ticket}).StructuredData().(map[string]interface{}); ok {
+		output["ticket"] = data["ticket"]
+	}
+	return output
+}
+
+// SyncResult represents the result of syncing ticket branches
+type SyncResult struct {
+	Upstream string // Branch brought into the ticket branches
+	Strategy string // merge or rebase
+	Remote   string // Remote fetched before syncing, if any
+	Tickets  []SyncTicket
+}
+
+// HasConflicts reports whether any ticket stopped on conflicts
+func (r *SyncResult) HasConflicts() bool {
+	return r.count(SyncStatusConflict) > 0
+}
+
+// HasFailures reports whether any ticket could not be synced
+func (r *SyncResult) HasFailures() bool {
+	return r.count(SyncStatusFailed) > 0
+}
+
+// count returns the number of tickets with the given status
+func (r *SyncResult) count(status string) int {
+	n := 0
+	for _, t := range r.Tickets {
+		if t.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// TextRepresentation returns human-readable format for sync result
+func (r *SyncResult) TextRepresentation() string {
+	var buf strings.Builder
+	buf.Grow(mediumBufferSize)
+
+	if len(r.Tickets) == 0 {
+		return "No tickets in progress to sync\n"
+	}
+
+	fmt.Fprintf(&buf, "Syncing with %s (%s)\n", r.Upstream, r.Strategy)
+	if r.Remote != "" {
+		fmt.Fprintf(&buf, "Fetched %s from %s\n", strings.TrimPrefix(r.Upstream, r.Remote+"/"), r.Remote)
+	}
+	buf.WriteByte('\n')
+
+	for _, t := range r.Tickets {
+		switch t.Status {
+		case SyncStatusSynced:
+			verb := "Merged"
+			if r.Strategy == config.SyncStrategyRebase {
+				verb = "Rebased onto"
+			}
+			fmt.Fprintf(&buf, "✅ %s: %s %d commit(s) from %s\n", t.Ticket.ID, verb, t.Behind, r.Upstream)
+		case SyncStatusUpToDate:
+			fmt.Fprintf(&buf, "✅ %s: already up to date\n", t.Ticket.ID)
+		case SyncStatusSkipped:
+			fmt.Fprintf(&buf, "⚠️  %s: skipped, %s\n", t.Ticket.ID, t.Reason)
+		case SyncStatusFailed:
+			fmt.Fprintf(&buf, "❌ %s: %s\n", t.Ticket.ID, t.Reason)
+		case SyncStatusConflict:
+			fmt.Fprintf(&buf, "❌ %s: conflicts in %d file(s)\n", t.Ticket.ID, len(t.Conflicts))
+			for _, file := range t.Conflicts {
+				fmt.Fprintf(&buf, "   %s\n", file)
+			}
+			fmt.Fprintf(&buf, "   Resolve them in %s, then run:\n", t.Worktree)
+			fmt.Fprintf(&buf, "     git add <files> && git %s --continue\n", r.Strategy)
+			fmt.Fprintf(&buf, "   Or give up with: git %s --abort\n", r.Strategy)
+		}
+	}
+
+	return buf.String()
+}
+
+// StructuredData returns data for JSON serialization
+func (r *SyncResult) StructuredData() interface{} {
+	tickets := make([]map[string]interface{}, len(r.Tickets))
+	for i, t := range r.Tickets {
+		entry := map[string]interface{}{
+			"id":       t.Ticket.ID,
+			"branch":   t.Branch,
+			"status":   t.Status,
+			"worktree": t.Worktree,
+			"ahead":    t.Ahead,
+			"behind":   t.Behind,
+		}
+		if t.Reason != "" {
+			entry["reason"] = t.Reason
+		}
+		if len(t.Conflicts) > 0 {
+			entry["conflicts"] = t.Conflicts
+		}
+		tickets[i] = entry
+	}
+
+	output := map[string]interface{}{
+		"upstream": r.Upstream,
+		"strategy": r.Strategy,
+		"tickets":  tickets,
+	}
+	if r.Remote != "" {
+		output["remote"] = r.Remote
+	}
+	return output
+}
+
+// shortCommit abbreviates a commit hash for display
+func shortCommit(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
@@ -136,6 +136,57 @@ func TestTicketListResultPrintable(t *testing.T) {
 		// Summary is no longer included in TextRepresentation
 	})
 
+	t.Run("TextRepresentation with tags", func(t *testing.T) {
+		result := &TicketListResult{
+			Tickets: []ticket.Ticket{
+				{
+					ID:          "tagged-1",
+					Priority:    2,
+					Description: "Tagged ticket",
+					Tags:        []string{"backend", "auth"},
+				},
+				{
+					ID:          "untagged-1",
+					Priority:    2,
+					Description: "Untagged ticket",
+				},
+			},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "TAGS")
+		assert.Contains(t, text, "backend,auth")
+		assert.Contains(t, text, "Untagged ticket")
+	})
+
+	t.Run("TextRepresentation without tags omits column", func(t *testing.T) {
+		result := &TicketListResult{
+			Tickets: []ticket.Ticket{
+				{ID: "plain-1", Priority: 2, Description: "Plain ticket"},
+			},
+		}
+
+		assert.NotContains(t, result.TextRepresentation(), "TAGS")
+	})
+
+	t.Run("TextRepresentation shows task progress column", func(t *testing.T) {
+		result := &TicketListResult{
+			Tickets: []ticket.Ticket{
+				{ID: "tasks-1", Priority: 2, Description: "With tasks", Content: "- [x] One\n- [ ] Two\n- [ ] Three\n"},
+				{ID: "plain-1", Priority: 2, Description: "Plain ticket"},
+			},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "TASKS")
+		assert.Contains(t, text, "1/3")
+
+		plain := &TicketListResult{
+			Tickets: []ticket.Ticket{{ID: "plain-1", Priority: 2, Description: "Plain ticket"}},
+		}
+		assert.NotContains(t, plain.TextRepresentation(), "TASKS")
+	})
+
 	t.Run("TextRepresentation with nil time fields", func(t *testing.T) {
 		result := &TicketListResult{
 			Tickets: []ticket.Ticket{
@@ -384,6 +435,23 @@ func TestTicketResultPrintable(t *testing.T) {
 		assert.Equal(t, "test-456", ticketData["id"])
 		assert.Equal(t, 1, ticketData["priority"])
 	})
+
+	t.Run("Relations", func(t *testing.T) {
+		result := &TicketResult{
+			Ticket: &ticket.Ticket{
+				ID:      "test-789",
+				Related: []string{"parent:p1", "blocks:b1", "blocks:b2", "relates:r1"},
+			},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Relations:\n  parent: p1\n  blocks: b1, b2\n  relates: r1\n")
+
+		ticketData := result.StructuredData().(map[string]interface{})["ticket"].(map[string]interface{})
+		relations := ticketData["relations"].(map[string][]string)
+		assert.Equal(t, []string{"b1", "b2"}, relations["blocks"])
+		assert.Equal(t, []string{"p1"}, relations["parent"])
+	})
 }
 
 func TestWorktreeListResultPrintable(t *testing.T) {
@@ -439,6 +507,28 @@ func TestWorktreeListResultPrintable(t *testing.T) {
 	})
 }
 
+func TestMoveResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	moved := &ticket.Ticket{ID: "250101-120000-move", Path: "/tickets/review/250101-120000-move.md"}
+	moved.SetState("review")
+	result := &MoveResult{Ticket: moved, From: "doing", To: "review"}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "Moved ticket: 250101-120000-move")
+	assert.Contains(t, text, "doing → review")
+
+	data, ok := result.StructuredData().(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "doing", data["from"])
+	assert.Equal(t, "review", data["to"])
+	ticketData, ok := data["ticket"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "review", ticketData["status"])
+
+	assert.Equal(t, ErrNoTicketAvailable, (&MoveResult{}).TextRepresentation())
+}
+
 func TestStatusResultPrintable(t *testing.T) {
 	t.Parallel()
 
@@ -489,6 +579,20 @@ func TestStatusResultPrintable(t *testing.T) {
 		assert.Contains(t, text, "Start a ticket with: ticketflow start")
 	})
 
+	t.Run("TextRepresentation with custom states", func(t *testing.T) {
+		result := &StatusResult{
+			CurrentBranch: "main",
+			Summary:       map[string]int{"todo": 1, "doing": 1, "review": 2, "blocked": 0, "done": 3},
+			States:        []string{"todo", "doing", "review", "blocked", "done"},
+			TotalTickets:  7,
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Review: 2")
+		assert.Contains(t, text, "Blocked: 0")
+		assert.NotContains(t, text, "📌 Todo")
+	})
+
 	t.Run("StructuredData", func(t *testing.T) {
 		result := &StatusResult{
 			CurrentBranch: "test",
@@ -548,6 +652,51 @@ func TestStartResultPrintable(t *testing.T) {
 		assert.NotContains(t, text, "Navigate to worktree")
 	})
 
+	t.Run("TextRepresentation with a templated branch", func(t *testing.T) {
+		result := &StartResult{
+			StartTicketResult: &StartTicketResult{
+				Ticket:       &ticket.Ticket{ID: "250101-120000-child", Branch: "feature/child"},
+				WorktreePath: "/worktrees/250101-120000-child",
+				ParentBranch: "feature/parent",
+				ParentTicket: "250101-110000-parent",
+			},
+			WorktreeEnabled: true,
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Parent ticket: 250101-110000-parent")
+		assert.Contains(t, text, "Branch from: feature/parent")
+		assert.Contains(t, text, "git push -u origin feature/child")
+
+		m := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, "feature/child", m["branch"])
+		assert.Equal(t, "250101-110000-parent", m["parent_ticket"])
+	})
+
+	t.Run("TextRepresentation with a base", func(t *testing.T) {
+		result := &StartResult{
+			StartTicketResult: &StartTicketResult{
+				Ticket:     &ticket.Ticket{ID: "based-feature"},
+				Base:       "origin/main",
+				BaseCommit: "0123456789abcdef",
+			},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Switched to branch: based-feature")
+		assert.Contains(t, text, "Base: origin/main (0123456)")
+
+		m := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, "origin/main", m["base"])
+		assert.Equal(t, "0123456789abcdef", m["base_commit"])
+
+		// The parent ticket's branch is already shown as the branch point
+		result.Base = "feature/parent"
+		result.ParentBranch = "feature/parent"
+		result.WorktreeEnabled = true
+		assert.NotContains(t, result.TextRepresentation(), "Base:")
+	})
+
 	t.Run("StructuredData", func(t *testing.T) {
 		result := &StartResult{
 			StartTicketResult: &StartTicketResult{
@@ -1009,3 +1158,502 @@ func TestRestoreTicketResult_StructuredData(t *testing.T) {
 		})
 	}
 }
+
+func TestTagResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("added tags", func(t *testing.T) {
+		result := &TagResult{
+			Ticket:  &ticket.Ticket{ID: "tag-1", Tags: []string{"backend", "ui"}},
+			Action:  TagActionAdd,
+			Changed: []string{"ui"},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Added tags to tag-1: ui")
+		assert.Contains(t, text, "Tags: backend, ui")
+
+		data := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, "add", data["action"])
+		assert.Equal(t, []string{"ui"}, data["changed"])
+		assert.Equal(t, []string{"backend", "ui"}, data["tags"])
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		result := &TagResult{
+			Ticket:  &ticket.Ticket{ID: "tag-2"},
+			Action:  TagActionRemove,
+			Changed: []string{},
+		}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "does not have the given tags")
+		assert.Contains(t, text, "Tags: (none)")
+
+		data := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, []string{}, data["tags"])
+	})
+}
+
+func TestLinkResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &LinkResult{
+		Ticket:       &ticket.Ticket{ID: "source", Related: []string{"blocks:target"}},
+		Target:       &ticket.Ticket{ID: "target"},
+		RelationType: ticket.RelationBlocks,
+		Linked:       true,
+		Changed:      true,
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "Linked: source blocks target")
+	assert.Contains(t, text, "Inverse: target blocked_by source")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "link", data["action"])
+	assert.Equal(t, "blocks", data["relation"])
+	assert.Equal(t, "blocked_by", data["inverse_relation"])
+
+	result.Changed = false
+	assert.Contains(t, result.TextRepresentation(), "source already blocks target")
+}
+
+func TestTaskResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	tk := &ticket.Ticket{ID: "task-1", Content: "- [x] Write code\n- [ ] Ship it\n"}
+
+	t.Run("task list", func(t *testing.T) {
+		result := &TaskListResult{Ticket: tk, Tasks: tk.Tasks()}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Tasks for task-1 (1/2 done):")
+		assert.Contains(t, text, " 1. [x] Write code")
+		assert.Contains(t, text, " 2. [ ] Ship it")
+
+		data := result.StructuredData().(map[string]interface{})
+		assert.Len(t, data["tasks"], 2)
+		assert.Equal(t, map[string]int{"done": 1, "total": 2}, data["progress"])
+
+		empty := &TaskListResult{Ticket: &ticket.Ticket{ID: "task-2"}}
+		assert.Contains(t, empty.TextRepresentation(), "has no tasks")
+	})
+
+	t.Run("checked task", func(t *testing.T) {
+		result := &TaskResult{Ticket: tk, Action: TaskActionCheck, Task: tk.Tasks()[0], Changed: true}
+
+		text := result.TextRepresentation()
+		assert.Contains(t, text, "Checked task 1 of task-1: Write code")
+		assert.Contains(t, text, "Progress: 1/2 done")
+
+		data := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, "check", data["action"])
+		assert.Equal(t, true, data["changed"])
+	})
+
+	t.Run("unchanged task", func(t *testing.T) {
+		result := &TaskResult{Ticket: tk, Action: TaskActionUncheck, Task: tk.Tasks()[1]}
+		assert.Contains(t, result.TextRepresentation(), "already unchecked")
+	})
+}
+
+func TestTicketLogResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2026, 3, 4, 10, 30, 0, 0, time.UTC)
+	result := &TicketLogResult{
+		Ticket:        &ticket.Ticket{ID: "log-1"},
+		DefaultBranch: "main",
+		Commits: []git.CommitInfo{
+			{SHA: "0123456789abcdef", Author: "Alice", Email: "alice@example.com", Date: date, Subject: "Implement feature", OnBranch: true},
+			{SHA: "fedcba9876543210", Author: "Bob", Email: "bob@example.com", Date: date.Add(-time.Hour), Subject: "Start ticket: log-1", TouchesFile: true, OnBranch: true, Merged: true},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "History of log-1 (2 commit(s)):")
+	assert.Contains(t, text, "  0123456  2026-03-04 10:30  [branch] Implement feature")
+	assert.Contains(t, text, "✓ fedcba9  2026-03-04 09:30  [both]   Start ticket: log-1")
+	assert.Contains(t, text, "Alice <alice@example.com>")
+	assert.Contains(t, text, "✓ = merged into main")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "log-1", data["ticket_id"])
+	commits := data["commits"].([]map[string]interface{})
+	require.Len(t, commits, 2)
+	assert.Equal(t, "2026-03-04T10:30:00Z", commits[0]["date"])
+	assert.Equal(t, false, commits[0]["merged"])
+	assert.Equal(t, true, commits[1]["touches_file"])
+
+	empty := &TicketLogResult{Ticket: &ticket.Ticket{ID: "log-2"}}
+	assert.Equal(t, "No commits found for ticket log-2\n", empty.TextRepresentation())
+	assert.Equal(t, ErrNoTicketAvailable, (&TicketLogResult{}).TextRepresentation())
+}
+
+func TestDoctorResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &DoctorResult{
+		TicketCount:   3,
+		WorktreeCount: 1,
+		BranchCount:   2,
+		Issues: []DoctorIssue{
+			{Code: DoctorMissingBranch, Severity: DoctorSeverityError, TicketID: "doc-1", Message: "Ticket is in doing but its branch does not exist", Remedy: "Start it again"},
+			{Code: DoctorStaleCurrentTicket, Severity: DoctorSeverityWarning, TicketID: "doc-2", Message: "Link is stale", Remedy: "Remove the link", Fixable: true},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "Checked 3 ticket(s), 1 worktree(s), 2 branch(es)")
+	assert.Contains(t, text, "❌ [MISSING_BRANCH] doc-1: Ticket is in doing but its branch does not exist")
+	assert.Contains(t, text, "   Fix: Start it again")
+	assert.Contains(t, text, "[STALE_CURRENT_TICKET] doc-2: Link is stale")
+	assert.Contains(t, text, "Found 2 problem(s)")
+	assert.Contains(t, text, "Run 'ticketflow doctor --fix' to repair 1 of them")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, false, data["healthy"])
+	assert.Equal(t, 0, data["fixed"])
+	issues := data["issues"].([]map[string]interface{})
+	require.Len(t, issues, 2)
+	assert.Equal(t, "doc-1", issues[0]["ticket_id"])
+	assert.Equal(t, true, issues[1]["fixable"])
+
+	result.FixRequested = true
+	result.Issues[1].Fixed = true
+	result.Issues = result.Issues[1:]
+	assert.True(t, result.Healthy())
+	assert.Contains(t, result.TextRepresentation(), "Found 1 problem(s), fixed 1")
+
+	noted := &DoctorResult{Issues: []DoctorIssue{
+		{Code: DoctorTimestampsInconsistent, Severity: DoctorSeverityInfo, TicketID: "doc-3", Message: "Ticket in cancelled/ has no closed_at"},
+	}}
+	assert.True(t, noted.Healthy())
+	assert.Contains(t, noted.TextRepresentation(), "ℹ️  [TIMESTAMPS_INCONSISTENT] doc-3")
+	assert.Contains(t, noted.TextRepresentation(), "Found 0 problem(s)")
+
+	healthy := &DoctorResult{TicketCount: 1}
+	assert.Contains(t, healthy.TextRepresentation(), "✅ No problems found")
+	assert.Equal(t, true, healthy.StructuredData().(map[string]interface{})["healthy"])
+}
+
+func TestTicketSearchResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &TicketSearchResult{
+		Query: "login",
+		Total: 3,
+		Results: []ticket.SearchResult{
+			{
+				Ticket: ticket.Ticket{ID: "search-1", Description: "Fix login"},
+				Score:  12,
+				Fields: []string{ticket.SearchFieldDescription, ticket.SearchFieldContent},
+				Matches: []ticket.SearchMatch{
+					{Line: 7, Text: "login one"}, {Line: 8, Text: "login two"},
+					{Line: 9, Text: "login three"}, {Line: 12, Text: "login four"},
+				},
+			},
+			{Ticket: ticket.Ticket{ID: "search-2"}, Score: 100, Fields: []string{ticket.SearchFieldID}},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, `Showing 2 of 3 ticket(s) matching "login":`)
+	assert.Contains(t, text, "search-1 [todo] Fix login")
+	assert.Contains(t, text, "  Matched: description, content")
+	assert.Contains(t, text, "     7: login one")
+	assert.Contains(t, text, "     9: login three")
+	assert.NotContains(t, text, "login four")
+	assert.Contains(t, text, "  … 1 more matching line(s)")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "login", data["query"])
+	assert.Equal(t, 3, data["total"])
+	results := data["results"].([]map[string]interface{})
+	require.Len(t, results, 2)
+	assert.Equal(t, 12, results[0]["score"])
+	assert.Len(t, results[0]["matches"], 4)
+	assert.Equal(t, "search-1", results[0]["ticket"].(map[string]interface{})["id"])
+
+	regex := &TicketSearchResult{Query: "log(in|out)", Regex: true}
+	assert.Equal(t, "No tickets matching /log(in|out)/\n", regex.TextRepresentation())
+}
+
+func TestViewListResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &ViewListResult{
+		Views: []*ticket.View{
+			{
+				Name:        "mine-urgent",
+				Description: "Urgent work",
+				States:      []string{"todo", "doing"},
+				Expression:  "(status=todo or status=doing) and priority<=1",
+				Sort:        []ticket.SortKey{{Field: "priority"}, {Field: "created", Descending: true}},
+				Count:       5,
+			},
+			{Name: "recent", States: []string{"done"}, IncludeArchived: true},
+			{Name: "active"},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "mine-urgent - Urgent work\n")
+	assert.Contains(t, text, "  Status: todo, doing\n")
+	assert.Contains(t, text, "  Filter: (status=todo or status=doing) and priority<=1\n")
+	assert.Contains(t, text, "  Sort:   priority,-created\n")
+	assert.Contains(t, text, "  Count:  5\n")
+	assert.Contains(t, text, "  Status: done (including archived)\n")
+	assert.Contains(t, text, "active\n  Status: active\n")
+	assert.Contains(t, text, "ticketflow list --view <name>")
+
+	data := result.StructuredData().(map[string]interface{})
+	views := data["views"].([]map[string]interface{})
+	require.Len(t, views, 3)
+	assert.Equal(t, "mine-urgent", views[0]["name"])
+	assert.Equal(t, "priority,-created", views[0]["sort"])
+	assert.Equal(t, 5, views[0]["count"])
+	assert.Equal(t, true, views[1]["include_archived"])
+	assert.Equal(t, []string{}, views[2]["status"])
+
+	empty := &ViewListResult{}
+	assert.Contains(t, empty.TextRepresentation(), "No saved views")
+}
+
+func TestTicketTreeResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	leaf := &TicketTreeNode{Ticket: ticket.Ticket{ID: "leaf", Description: "Leaf"}}
+	first := &TicketTreeNode{
+		Ticket:   ticket.Ticket{ID: "first", Content: "- [x] one\n- [ ] two\n"},
+		Children: []*TicketTreeNode{leaf},
+	}
+	second := &TicketTreeNode{Ticket: ticket.Ticket{ID: "second"}}
+	epic := &TicketTreeNode{Ticket: ticket.Ticket{ID: "epic", Description: "Epic"}, Children: []*TicketTreeNode{first, second}}
+	looped := &TicketTreeNode{Ticket: ticket.Ticket{ID: "looped"}, CycleParent: "other"}
+	for _, node := range []*TicketTreeNode{epic, looped} {
+		rollUp(node)
+	}
+
+	result := &TicketTreeResult{Roots: []*TicketTreeNode{epic, looped}, Count: map[string]int{"total": 5}}
+	assert.Equal(t, "epic [todo] Epic  (sub-tickets 0/3 closed)\n"+
+		"├── first [todo]  (tasks 1/2, sub-tickets 0/1 closed)\n"+
+		"│   └── leaf [todo] Leaf\n"+
+		"└── second [todo]\n"+
+		"looped [todo]  ⚠️  circular parent: other\n", result.TextRepresentation())
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, map[string]int{"total": 5}, data["summary"])
+	assert.NotContains(t, data, "root")
+	tree := data["tree"].([]map[string]interface{})
+	require.Len(t, tree, 2)
+	assert.Equal(t, "epic", tree[0]["ticket"].(map[string]interface{})["id"])
+	assert.Equal(t, 3, tree[0]["rollup"].(map[string]interface{})["total"])
+	children := tree[0]["children"].([]map[string]interface{})
+	require.Len(t, children, 2)
+	assert.Len(t, children[0]["children"], 1)
+	assert.Equal(t, "other", tree[1]["circular_parent"])
+
+	assert.Equal(t, "No tickets found\n", (&TicketTreeResult{}).TextRepresentation())
+}
+
+func TestFinishResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	tk := &ticket.Ticket{ID: "250101-120000-add-feature", Description: "Add the feature"}
+	result := &FinishResult{
+		Ticket:        tk,
+		Branch:        tk.ID,
+		TargetBranch:  "main",
+		Commits:       3,
+		CommitMessage: "Add the feature\n\nTicket: " + tk.ID,
+		WorktreePath:  "/tmp/worktrees/" + tk.ID,
+		Cleanup:       true,
+		DryRun:        true,
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "Would finish ticket: "+tk.ID)
+	assert.Contains(t, text, "Squash-merge 3 commit(s) from "+tk.ID+" into main\n")
+	assert.Contains(t, text, "      Add the feature\n\n      Ticket: "+tk.ID+"\n")
+	assert.Contains(t, text, "Remove worktree: /tmp/worktrees/"+tk.ID)
+	assert.Contains(t, text, "Delete branch: "+tk.ID)
+
+	result.DryRun = false
+	result.MergeCommit = "0123456789abcdef"
+	result.WorktreeRemoved = true
+	result.Warnings = []string{"failed to delete branch " + tk.ID + ": boom"}
+	text = result.TextRepresentation()
+	assert.Contains(t, text, "Ticket finished: "+tk.ID)
+	assert.Contains(t, text, "Squash-merged 3 commit(s) from "+tk.ID+" into main (0123456)\n")
+	assert.Contains(t, text, "Removed worktree: /tmp/worktrees/"+tk.ID)
+	assert.NotContains(t, text, "Deleted branch")
+	assert.Contains(t, text, "⚠️  failed to delete branch")
+	assert.NotContains(t, text, "ticketflow cleanup")
+
+	result.Cleanup = false
+	assert.Contains(t, result.TextRepresentation(), "ticketflow cleanup "+tk.ID)
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, false, data["dry_run"])
+	assert.Equal(t, "main", data["target_branch"])
+	assert.Equal(t, 3, data["commits"])
+	assert.Equal(t, "0123456789abcdef", data["merge_commit"])
+	assert.Equal(t, true, data["worktree_removed"])
+	assert.Equal(t, false, data["branch_deleted"])
+	assert.Equal(t, tk.ID, data["ticket"].(map[string]interface{})["id"])
+}
+
+func TestPRBodyResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	tk := &ticket.Ticket{ID: "250101-120000-add-feature", Description: "Add the feature"}
+	result := &PRBodyResult{
+		Ticket: tk,
+		Body:   "## Summary\n\nAdd the feature\n",
+		Commits: []git.CommitInfo{
+			{SHA: "0123456789abcdef", Subject: "Implement feature"},
+		},
+	}
+
+	assert.Equal(t, "## Summary\n\nAdd the feature\n", result.TextRepresentation())
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, tk.ID, data["ticket_id"])
+	assert.Equal(t, "Add the feature", data["title"])
+	assert.Equal(t, result.Body, data["body"])
+	commits := data["commits"].([]map[string]interface{})
+	require.Len(t, commits, 1)
+	assert.Equal(t, "0123456789abcdef", commits[0]["sha"])
+	assert.NotContains(t, data, "file")
+
+	result.File = "pr.md"
+	assert.Equal(t, "✅ Wrote pull request description for "+tk.ID+" to pr.md\n", result.TextRepresentation())
+	assert.Equal(t, "pr.md", result.StructuredData().(map[string]interface{})["file"])
+}
+
+func TestHooksResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &HooksResult{
+		Install:  true,
+		Dir:      "/repo/.git/hooks",
+		Validate: true,
+		Hooks: []HookChange{
+			{Name: HookPrepareCommitMsg, Path: "/repo/.git/hooks/prepare-commit-msg"},
+			{Name: HookCommitMsg, Path: "/repo/.git/hooks/commit-msg", Chained: true},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.Contains(t, text, "✅ Installed git hooks in /repo/.git/hooks\n")
+	assert.Contains(t, text, "   commit-msg (runs the previous hook first: commit-msg.pre-ticketflow)\n")
+	assert.Contains(t, text, "commits without it are rejected")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "install", data["action"])
+	assert.Equal(t, true, data["validate"])
+	hooks := data["hooks"].([]map[string]interface{})
+	require.Len(t, hooks, 2)
+	assert.Equal(t, true, hooks[1]["chained"])
+	assert.Equal(t, true, hooks[1]["changed"])
+
+	result = &HooksResult{
+		Dir:   "/repo/.git/hooks",
+		Hooks: []HookChange{{Name: HookCommitMsg, Path: "/repo/.git/hooks/commit-msg", Skipped: "not installed by ticketflow"}},
+	}
+	text = result.TextRepresentation()
+	assert.Contains(t, text, "✅ Uninstalled git hooks from /repo/.git/hooks\n")
+	assert.Contains(t, text, "   commit-msg: not installed by ticketflow, left unchanged\n")
+
+	data = result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "uninstall", data["action"])
+	assert.NotContains(t, data, "validate")
+	hooks = data["hooks"].([]map[string]interface{})
+	assert.Equal(t, false, hooks[0]["changed"])
+	assert.Equal(t, "not installed by ticketflow", hooks[0]["skipped"])
+}
+
+func TestWhichResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	tk := &ticket.Ticket{ID: "250101-120000-add-feature", Description: "Add the feature", Content: "# Summary"}
+	result := &WhichResult{
+		Commit: git.CommitInfo{SHA: "0123456789abcdef", Subject: "Implement feature", Author: "Test User"},
+		File:   "main.go",
+		Line:   12,
+		Source: WhichSourceMerge,
+		Ref:    "fedcba9876543210",
+		Ticket: tk,
+	}
+
+	text := result.TextRepresentation()
+	assert.True(t, strings.HasPrefix(text, "Commit: 0123456 Implement feature\nLine: main.go:12\nFound via: merge commit fedcba9\n\nID: "+tk.ID+"\n"))
+	assert.Contains(t, text, "Description: Add the feature\n")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "merge", data["source"])
+	assert.Equal(t, "fedcba9876543210", data["ref"])
+	assert.Equal(t, "main.go", data["file"])
+	assert.Equal(t, 12, data["line"])
+	assert.Equal(t, "0123456789abcdef", data["commit"].(map[string]interface{})["sha"])
+	assert.Equal(t, tk.ID, data["ticket"].(map[string]interface{})["id"])
+
+	result = &WhichResult{Commit: result.Commit, Source: WhichSourceTrailer, Ticket: tk}
+	assert.Contains(t, result.TextRepresentation(), "\nFound via: Ticket trailer\n")
+	data = result.StructuredData().(map[string]interface{})
+	assert.NotContains(t, data, "ref")
+	assert.NotContains(t, data, "file")
+}
+
+func TestSyncResultPrintable(t *testing.T) {
+	t.Parallel()
+
+	result := &SyncResult{
+		Upstream: "origin/main",
+		Strategy: "rebase",
+		Remote:   "origin",
+		Tickets: []SyncTicket{
+			{Ticket: &ticket.Ticket{ID: "250101-120000-synced"}, Branch: "feature/synced", Worktree: "/wt/synced", Status: SyncStatusSynced, Ahead: 1, Behind: 3},
+			{Ticket: &ticket.Ticket{ID: "250101-120000-current"}, Branch: "250101-120000-current", Worktree: "/wt/current", Status: SyncStatusUpToDate, Ahead: 2},
+			{Ticket: &ticket.Ticket{ID: "250101-120000-dirty"}, Branch: "250101-120000-dirty", Worktree: "/wt/dirty", Status: SyncStatusSkipped, Reason: "worktree has uncommitted changes"},
+			{Ticket: &ticket.Ticket{ID: "250101-120000-conflict"}, Branch: "250101-120000-conflict", Worktree: "/wt/conflict", Status: SyncStatusConflict, Ahead: 1, Behind: 1, Conflicts: []string{"a.go", "b.go"}},
+			{Ticket: &ticket.Ticket{ID: "250101-120000-failed"}, Branch: "250101-120000-failed", Worktree: "/wt/failed", Status: SyncStatusFailed, Behind: 2, Reason: "git rebase origin/main failed: exit status 1"},
+		},
+	}
+
+	text := result.TextRepresentation()
+	assert.True(t, strings.HasPrefix(text, "Syncing with origin/main (rebase)\nFetched main from origin\n\n"))
+	assert.Contains(t, text, "✅ 250101-120000-synced: Rebased onto 3 commit(s) from origin/main\n")
+	assert.Contains(t, text, "✅ 250101-120000-current: already up to date\n")
+	assert.Contains(t, text, "⚠️  250101-120000-dirty: skipped, worktree has uncommitted changes\n")
+	assert.Contains(t, text, "❌ 250101-120000-conflict: conflicts in 2 file(s)\n   a.go\n   b.go\n   Resolve them in /wt/conflict, then run:\n")
+	assert.Contains(t, text, "git add <files> && git rebase --continue\n")
+	assert.Contains(t, text, "git rebase --abort\n")
+	assert.True(t, result.HasConflicts())
+	assert.True(t, result.HasFailures())
+	assert.Contains(t, text, "❌ 250101-120000-failed: git rebase origin/main failed: exit status 1\n")
+
+	data := result.StructuredData().(map[string]interface{})
+	assert.Equal(t, "origin/main", data["upstream"])
+	assert.Equal(t, "rebase", data["strategy"])
+	assert.Equal(t, "origin", data["remote"])
+	tickets := data["tickets"].([]map[string]interface{})
+	require.Len(t, tickets, 5)
+	assert.Equal(t, "feature/synced", tickets[0]["branch"])
+	assert.Equal(t, 3, tickets[0]["behind"])
+	assert.NotContains(t, tickets[0], "conflicts")
+	assert.Equal(t, "worktree has uncommitted changes", tickets[2]["reason"])
+	assert.Equal(t, []string{"a.go", "b.go"}, tickets[3]["conflicts"])
+	assert.Equal(t, "failed", tickets[4]["status"])
+	assert.Equal(t, "git rebase origin/main failed: exit status 1", tickets[4]["reason"])
+
+	result = &SyncResult{Upstream: "main", Strategy: "merge"}
+	assert.Equal(t, "No tickets in progress to sync\n", result.TextRepresentation())
+	assert.False(t, result.HasConflicts())
+	assert.False(t, result.HasFailures())
+	data = result.StructuredData().(map[string]interface{})
+	assert.NotContains(t, data, "remote")
+	assert.Empty(t, data["tickets"])
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"sort"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// Reasons a todo ticket is not ready to be picked up
+const (
+	NotReadyOpenSubTickets = "open_sub_tickets"
+	NotReadyParentClosed   = "parent_closed"
+	NotReadyBlocked        = "blocked"
+)
+
+// NotReadyTicket describes a todo ticket that is not actionable yet
+type NotReadyTicket struct {
+	ID      string
+	Reason  string
+	Details []string // Ticket IDs responsible for the reason
+}
+
+// ReadyTickets returns the todo tickets that can be started right away.
+// A ticket is ready when it has no open sub-tickets, its parent is not closed,
+// and no open ticket blocks it. Results are ordered by priority, then oldest first.
+func (app *App) ReadyTickets(ctx context.Context, count int) (*ReadyTicketsResult, error) {
+	logger := log.Global().WithOperation("ready_tickets")
+
+	todoTickets, err := app.Manager.List(ctx, ticket.StatusFilterTodo)
+	if err != nil {
+		return nil, err
+	}
+
+	allTickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*ticket.Ticket, len(allTickets))
+	openChildren := make(map[string][]string)
+	for i := range allTickets {
+		t := &allTickets[i]
+		byID[t.ID] = t
+		if t.IsClosed() {
+			continue
+		}
+		if parentID := ExtractParentID(t); parentID != "" {
+			openChildren[parentID] = append(openChildren[parentID], t.ID)
+		}
+	}
+
+	result := &ReadyTicketsResult{
+		Tickets:  make([]ticket.Ticket, 0, len(todoTickets)),
+		NotReady: make([]NotReadyTicket, 0),
+	}
+
+	for _, t := range todoTickets {
+		if children := openChildren[t.ID]; len(children) > 0 {
+			result.NotReady = append(result.NotReady, NotReadyTicket{ID: t.ID, Reason: NotReadyOpenSubTickets, Details: children})
+			continue
+		}
+
+		if parentID := ExtractParentID(&t); parentID != "" {
+			if parent, ok := byID[parentID]; ok && parent.IsClosed() {
+				result.NotReady = append(result.NotReady, NotReadyTicket{ID: t.ID, Reason: NotReadyParentClosed, Details: []string{parentID}})
+				continue
+			}
+		}
+
+		var blockers []string
+		for _, blockerID := range t.RelationsOfType(ticket.RelationBlockedBy) {
+			if blocker, ok := byID[blockerID]; ok && !blocker.IsClosed() {
+				blockers = append(blockers, blockerID)
+			}
+		}
+		if len(blockers) > 0 {
+			result.NotReady = append(result.NotReady, NotReadyTicket{ID: t.ID, Reason: NotReadyBlocked, Details: blockers})
+			continue
+		}
+
+		result.Tickets = append(result.Tickets, t)
+	}
+
+	// Highest priority first, then the oldest ticket
+	sort.SliceStable(result.Tickets, func(i, j int) bool {
+		if result.Tickets[i].Priority != result.Tickets[j].Priority {
+			return result.Tickets[i].Priority < result.Tickets[j].Priority
+		}
+		return result.Tickets[i].CreatedAt.Before(result.Tickets[j].CreatedAt.Time)
+	})
+
+	if count > 0 && len(result.Tickets) > count {
+		result.Tickets = result.Tickets[:count]
+	}
+
+	logger.Debug("computed ready tickets", "ready", len(result.Tickets), "not_ready", len(result.NotReady))
+	return result, nil
+}
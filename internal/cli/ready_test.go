@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/mocks"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func TestApp_ReadyTickets(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) ticket.RFC3339Time {
+		return ticket.NewRFC3339Time(base.Add(time.Duration(hours) * time.Hour))
+	}
+	started := base.Add(time.Hour)
+	closed := base.Add(2 * time.Hour)
+
+	todo := []ticket.Ticket{
+		{ID: "newer-p2", Priority: 2, CreatedAt: at(5)},
+		{ID: "older-p2", Priority: 2, CreatedAt: at(1)},
+		{ID: "urgent-p1", Priority: 1, CreatedAt: at(9)},
+		{ID: "has-open-child", Priority: 1, CreatedAt: at(0)},
+		{ID: "orphaned-child", Priority: 1, CreatedAt: at(0), Related: []string{"parent:closed-parent"}},
+		{ID: "blocked", Priority: 1, CreatedAt: at(0), Related: []string{"blocked_by:doing-blocker"}},
+		{ID: "unblocked", Priority: 3, CreatedAt: at(0), Related: []string{"blocked_by:closed-parent"}},
+		{ID: "blocker-cancelled", Priority: 3, CreatedAt: at(1), Related: []string{"blocked_by:cancelled-blocker"}},
+	}
+	cancelledBlocker := ticket.Ticket{ID: "cancelled-blocker"}
+	cancelledBlocker.SetState(ticket.StatusCancelled)
+	all := append([]ticket.Ticket{}, todo...)
+	all = append(all,
+		ticket.Ticket{ID: "open-child", Related: []string{"parent:has-open-child"}, StartedAt: ticket.NewRFC3339TimePtr(&started)},
+		ticket.Ticket{ID: "closed-parent", StartedAt: ticket.NewRFC3339TimePtr(&started), ClosedAt: ticket.NewRFC3339TimePtr(&closed)},
+		ticket.Ticket{ID: "doing-blocker", StartedAt: ticket.NewRFC3339TimePtr(&started)},
+		cancelledBlocker,
+	)
+
+	newApp := func() *App {
+		mockManager := new(mocks.MockTicketManager)
+		mockManager.On("List", mock.Anything, ticket.StatusFilterTodo).Return(todo, nil)
+		mockManager.On("List", mock.Anything, ticket.StatusFilterAll).Return(all, nil)
+		return &App{
+			Config:  config.Default(),
+			Manager: mockManager,
+			Output:  NewOutputWriter(nil, nil, FormatText),
+		}
+	}
+
+	t.Run("orders ready tickets by priority then age", func(t *testing.T) {
+		result, err := newApp().ReadyTickets(context.Background(), 0)
+		require.NoError(t, err)
+
+		ids := make([]string, len(result.Tickets))
+		for i, tk := range result.Tickets {
+			ids[i] = tk.ID
+		}
+		assert.Equal(t, []string{"urgent-p1", "older-p2", "newer-p2", "unblocked", "blocker-cancelled"}, ids)
+
+		reasons := make(map[string]string)
+		for _, nr := range result.NotReady {
+			reasons[nr.ID] = nr.Reason
+		}
+		assert.Equal(t, map[string]string{
+			"has-open-child": NotReadyOpenSubTickets,
+			"orphaned-child": NotReadyParentClosed,
+			"blocked":        NotReadyBlocked,
+		}, reasons)
+
+		data := result.StructuredData().(map[string]interface{})
+		assert.Equal(t, "urgent-p1", data["next"])
+	})
+
+	t.Run("limits count", func(t *testing.T) {
+		result, err := newApp().ReadyTickets(context.Background(), 1)
+		require.NoError(t, err)
+		require.Len(t, result.Tickets, 1)
+		assert.Equal(t, "urgent-p1", result.Tickets[0].ID)
+		assert.Contains(t, result.TextRepresentation(), "Next: ticketflow start urgent-p1")
+	})
+}
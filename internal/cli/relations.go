@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// LinkableRelationTypes are the relation types that can be managed with link/unlink.
+// Parent relations are managed by 'new --parent' instead.
+var LinkableRelationTypes = []ticket.RelationType{
+	ticket.RelationBlocks,
+	ticket.RelationBlockedBy,
+	ticket.RelationDuplicates,
+	ticket.RelationRelates,
+}
+
+// LinkTickets adds a typed relation from ticketID to targetID and
+// maintains the inverse relation on the target ticket
+func (app *App) LinkTickets(ctx context.Context, ticketID string, relType ticket.RelationType, targetID string) (*LinkResult, error) {
+	return app.changeTicketLink(ctx, ticketID, relType, targetID, true)
+}
+
+// UnlinkTickets removes a typed relation between two tickets on both sides
+func (app *App) UnlinkTickets(ctx context.Context, ticketID string, relType ticket.RelationType, targetID string) (*LinkResult, error) {
+	return app.changeTicketLink(ctx, ticketID, relType, targetID, false)
+}
+
+// changeTicketLink adds or removes a relation and its inverse
+func (app *App) changeTicketLink(ctx context.Context, ticketID string, relType ticket.RelationType, targetID string, link bool) (*LinkResult, error) {
+	operation := "unlink_tickets"
+	if link {
+		operation = "link_tickets"
+	}
+	logger := log.Global().WithOperation(operation).WithTicket(ticketID)
+
+	if !isLinkableRelationType(relType) {
+		return nil, NewError(ErrValidation, "Invalid relation type",
+			fmt.Sprintf("Relation type '%s' cannot be linked", relType),
+			[]string{"Use one of: --blocks, --blocked-by, --duplicates, --relates"})
+	}
+
+	source, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	target, err := app.findRelationTarget(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.ID == target.ID {
+		return nil, NewError(ErrTicketInvalid, "Invalid relation",
+			"A ticket cannot be linked to itself",
+			[]string{"Choose a different target ticket"})
+	}
+
+	inverse := relType.Inverse()
+	result := &LinkResult{
+		Ticket:       source,
+		Target:       target,
+		RelationType: relType,
+		Linked:       link,
+	}
+
+	var sourceChanged, targetChanged bool
+	if link {
+		sourceChanged = source.AddRelation(relType, target.ID)
+		targetChanged = target.AddRelation(inverse, source.ID)
+	} else {
+		sourceChanged = source.RemoveRelation(relType, target.ID)
+		targetChanged = target.RemoveRelation(inverse, source.ID)
+	}
+	result.Changed = sourceChanged || targetChanged
+
+	if sourceChanged {
+		if err := app.Manager.Update(ctx, source); err != nil {
+			logger.WithError(err).Error("failed to update ticket relations")
+			return nil, fmt.Errorf("failed to update ticket relations: %w", err)
+		}
+	}
+
+	if targetChanged {
+		if err := app.Manager.Update(ctx, target); err != nil {
+			logger.WithError(err).Error("failed to update inverse relation", slog.String("target", target.ID))
+			// Roll back the source ticket so both sides stay consistent
+			if sourceChanged {
+				if link {
+					source.RemoveRelation(relType, target.ID)
+				} else {
+					source.AddRelation(relType, target.ID)
+				}
+				if rollbackErr := app.Manager.Update(ctx, source); rollbackErr != nil {
+					logger.WithError(rollbackErr).Error("failed to roll back ticket relations")
+				}
+			}
+			return nil, fmt.Errorf("failed to update ticket %s: %w", target.ID, err)
+		}
+	}
+
+	if result.Changed {
+		logger.Info("updated ticket relation",
+			slog.String("type", string(relType)),
+			slog.String("target", target.ID),
+			slog.Bool("linked", link))
+	}
+
+	return result, nil
+}
+
+// findRelationTarget resolves the target ticket of a relation
+func (app *App) findRelationTarget(ctx context.Context, targetID string) (*ticket.Ticket, error) {
+	if _, err := app.Manager.FindTicket(ctx, targetID); err != nil {
+		if errors.Is(err, ticketerrors.ErrTicketNotFound) {
+			return nil, NewError(ErrTicketNotFound, "Related ticket not found",
+				fmt.Sprintf("Ticket '%s' does not exist", targetID),
+				[]string{
+					"Check the ticket ID is correct",
+					"Use 'ticketflow list --status all' to see available tickets",
+				})
+		}
+		return nil, ConvertError(err)
+	}
+
+	target, err := app.Manager.Get(ctx, targetID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	return target, nil
+}
+
+// isLinkableRelationType reports whether link/unlink can manage the relation type
+func isLinkableRelationType(relType ticket.RelationType) bool {
+	for _, linkable := range LinkableRelationTypes {
+		if relType == linkable {
+			return true
+		}
+	}
+	return false
+}
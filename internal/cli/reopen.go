@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+// ReopenTicket moves a done or cancelled ticket back to todo and commits the move.
+// When start is true the ticket is started right away, which recreates its
+// branch and worktree just like 'ticketflow start'.
+func (app *App) ReopenTicket(ctx context.Context, ticketID string, start, force bool) (*ReopenTicketResult, error) {
+	logger := log.Global().WithOperation("reopen_ticket").WithTicket(ticketID)
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	originalStatus := t.State()
+	if err := t.Reopen(); err != nil {
+		if errors.Is(err, ticketerrors.ErrTicketNotDone) {
+			return nil, NewError(ErrTicketNotDone, "Ticket is not closed",
+				fmt.Sprintf("Ticket %s is in '%s' status; only done or cancelled tickets can be reopened", t.ID, originalStatus),
+				[]string{fmt.Sprintf("Start it instead: ticketflow start %s", t.ID)})
+		}
+		return nil, fmt.Errorf("failed to reopen ticket: %w", err)
+	}
+
+	if err := app.relocateTicket(ctx, t, config.StateTodo, fmt.Sprintf("Reopen ticket: %s", t.ID)); err != nil {
+		return nil, err
+	}
+	logger.Info("ticket reopened", "original_status", originalStatus)
+
+	result := &ReopenTicketResult{
+		Ticket:         t,
+		OriginalStatus: originalStatus,
+	}
+
+	if start {
+		started, err := app.StartTicket(ctx, t.ID, force)
+		if err != nil {
+			logger.WithError(err).Error("failed to start reopened ticket")
+			return nil, err
+		}
+		result.Ticket = started.Ticket
+		result.Start = &StartResult{
+			StartTicketResult: started,
+			WorktreeEnabled:   app.Config.Worktree.Enabled,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// SearchOptions holds the options for searching tickets
+type SearchOptions struct {
+	// Query is the text or regular expression to search for
+	Query string
+	// Regex treats Query as a regular expression
+	Regex bool
+	// Status limits the search to a workflow state (empty for all states)
+	Status ticket.Status
+	// IncludeArchived also searches archived tickets when the status covers done tickets
+	IncludeArchived bool
+	// Count limits the number of results (0 for no limit)
+	Count int
+}
+
+// SearchTickets searches the IDs, descriptions and content of tickets
+func (app *App) SearchTickets(ctx context.Context, opts SearchOptions) (*TicketSearchResult, error) {
+	logger := log.Global().WithOperation("search_tickets")
+
+	searcher, err := ticket.NewSearcher(ticket.SearchOptions{Query: opts.Query, Regex: opts.Regex})
+	if err != nil {
+		return nil, NewError(ErrValidation, "Invalid search query", err.Error(),
+			[]string{"Check the regular expression syntax, or search for literal text without --regex"})
+	}
+
+	status := opts.Status
+	if status == "" {
+		status = StatusAll
+	}
+	statusFilter, err := app.statusFilter(status)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := app.Manager.Search(ctx, statusFilter, searcher)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	// Archived tickets are done tickets, so only search them when done tickets are searched
+	if opts.IncludeArchived && (statusFilter == ticket.StatusFilterAll || statusFilter == ticket.StatusFilterDone) {
+		archived, err := app.Manager.Search(ctx, ticket.StatusFilterArchived, searcher)
+		if err != nil {
+			return nil, ConvertError(err)
+		}
+		results = mergeSearchResults(results, archived)
+	}
+
+	total := len(results)
+	if opts.Count > 0 && len(results) > opts.Count {
+		results = results[:opts.Count]
+	}
+
+	logger.Debug("search finished", "query", opts.Query, "matches", total)
+	return &TicketSearchResult{
+		Query:   opts.Query,
+		Regex:   opts.Regex,
+		Results: results,
+		Total:   total,
+	}, nil
+}
+
+// mergeSearchResults merges two ranked result lists, keeping the order of a
+// before b for results with the same score
+func mergeSearchResults(a, b []ticket.SearchResult) []ticket.SearchResult {
+	merged := make([]ticket.SearchResult, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if b[j].Score > a[i].Score {
+			merged = append(merged, b[j])
+			j++
+		} else {
+			merged = append(merged, a[i])
+			i++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
+
+// searchMatchesToJSON converts the matched lines of a search result for JSON output
+func searchMatchesToJSON(matches []ticket.SearchMatch) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(matches))
+	for i, m := range matches {
+		result[i] = map[string]interface{}{
+			"line": m.Line,
+			"text": m.Text,
+		}
+	}
+	return result
+}
+
+// describeSearch describes the search query for text output
+func describeSearch(query string, regex bool) string {
+	if regex {
+		return fmt.Sprintf("/%s/", query)
+	}
+	return fmt.Sprintf("%q", query)
+}
@@ -72,7 +72,7 @@ func (s *nullStatusWriter) Println(args ...interface{}) {
 
 // NewStatusWriter creates the appropriate status writer based on the output format
 func NewStatusWriter(w io.Writer, format OutputFormat) StatusWriter {
-	if format == FormatJSON {
+	if format.IsStructured() {
 		return NewNullStatusWriter()
 	}
 	return NewTextStatusWriter(w)
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// What Sync did to a ticket branch
+const (
+	SyncStatusUpToDate = "up-to-date" // Already contained the upstream branch
+	SyncStatusSynced   = "synced"     // Upstream commits were merged or rebased onto
+	SyncStatusConflict = "conflict"   // Stopped on conflicts, left for the user to resolve
+	SyncStatusSkipped  = "skipped"    // Not touched, see Reason
+	SyncStatusFailed   = "failed"     // Could not be synced, see Reason
+)
+
+// SyncTicket is the outcome of syncing one ticket branch
+type SyncTicket struct {
+	Ticket    *ticket.Ticket
+	Branch    string
+	Worktree  string // Empty when the branch is not checked out
+	Status    string // One of the SyncStatus constants
+	Reason    string // Why the ticket was skipped or failed
+	Ahead     int    // Ticket commits not in upstream, before syncing
+	Behind    int    // Upstream commits not in the ticket branch, before syncing
+	Conflicts []string
+}
+
+// Sync brings the default branch into ticket branches: the given ticket, the
+// current one when ticketID is empty, or every doing ticket when all is set.
+// strategy overrides sync.strategy when set. Conflicts stop the merge or
+// rebase of that ticket and leave it in progress in its worktree; they and
+// other failures are recorded per ticket so that the rest are still synced.
+func (app *App) Sync(ctx context.Context, ticketID string, all bool, strategy string) (*SyncResult, error) {
+	logger := log.Global().WithOperation("sync")
+
+	if strategy == "" {
+		strategy = app.Config.GetSyncStrategy()
+	}
+
+	tickets, err := app.syncTargets(ctx, ticketID, all)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{
+		Upstream: app.Config.GetSyncUpstream(),
+		Strategy: strategy,
+		Remote:   app.Config.Sync.Remote,
+	}
+
+	if remote := app.Config.Sync.Remote; remote != "" {
+		if _, err := app.Git.Exec(ctx, "fetch", remote, app.Config.Git.DefaultBranch); err != nil {
+			return nil, NewError(ErrGitFetchFailed, "Failed to fetch the default branch",
+				fmt.Sprintf("git fetch %s %s: %v", remote, app.Config.Git.DefaultBranch, err),
+				[]string{
+					"Check your network connection and the remote: git remote -v",
+					"Set sync.remote in .ticketflow.yaml, or remove it to sync with the local default branch",
+				})
+		}
+	}
+
+	for i := range tickets {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		synced := app.syncTicket(ctx, &tickets[i], result.Upstream, strategy)
+		logger.Debug("synced ticket", "ticket", synced.Ticket.ID, "status", synced.Status, "reason", synced.Reason)
+		result.Tickets = append(result.Tickets, *synced)
+	}
+
+	return result, nil
+}
+
+// syncTargets returns the tickets to sync
+func (app *App) syncTargets(ctx context.Context, ticketID string, all bool) ([]ticket.Ticket, error) {
+	if all {
+		tickets, err := app.Manager.List(ctx, ticket.StatusFilterDoing)
+		if err != nil {
+			return nil, ConvertError(err)
+		}
+		return tickets, nil
+	}
+
+	t, err := app.ticketOrCurrent(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status() != ticket.StatusDoing {
+		return nil, NewError(ErrTicketNotStarted, "Ticket not in progress",
+			fmt.Sprintf("Ticket %s is %s; only doing tickets are synced", t.ID, t.Status()),
+			[]string{"Start the ticket first: ticketflow start " + t.ID})
+	}
+	return []ticket.Ticket{*t}, nil
+}
+
+// syncTicket merges or rebases the branch of t onto upstream in the worktree
+// the branch is checked out in
+func (app *App) syncTicket(ctx context.Context, t *ticket.Ticket, upstream, strategy string) *SyncTicket {
+	synced := &SyncTicket{Ticket: t, Branch: t.BranchName()}
+
+	wt, err := app.Git.FindWorktreeByBranch(ctx, synced.Branch)
+	if err != nil {
+		return synced.fail("failed to find the worktree", err)
+	}
+	if wt == nil {
+		synced.Status = SyncStatusSkipped
+		synced.Reason = "branch is not checked out in any worktree"
+		return synced
+	}
+	synced.Worktree = wt.Path
+
+	status, err := app.Git.RunInWorktree(ctx, wt.Path, git.SubcmdStatus, git.FlagPorcelain, "--untracked-files=no")
+	if err != nil {
+		return synced.fail("failed to check the worktree status", err)
+	}
+	if status != "" {
+		synced.Status = SyncStatusSkipped
+		synced.Reason = "worktree has uncommitted changes"
+		return synced
+	}
+
+	synced.Ahead, synced.Behind, err = app.Git.GetBranchDivergenceInfo(ctx, synced.Branch, upstream)
+	if err != nil {
+		return synced.fail("failed to compare with "+upstream, err)
+	}
+	if synced.Behind == 0 {
+		synced.Status = SyncStatusUpToDate
+		return synced
+	}
+
+	args := []string{git.SubcmdMerge, "--no-edit", upstream}
+	if strategy == config.SyncStrategyRebase {
+		args = []string{"rebase", upstream}
+	}
+	if _, err := app.Git.RunInWorktree(ctx, wt.Path, args...); err != nil {
+		conflicts, _ := app.Git.RunInWorktree(ctx, wt.Path, "diff", "--name-only", "--diff-filter=U")
+		if conflicts == "" {
+			return synced.fail("git "+strings.Join(args, " ")+" failed", err)
+		}
+		synced.Status = SyncStatusConflict
+		synced.Conflicts = strings.Split(conflicts, "\n")
+		return synced
+	}
+
+	synced.Status = SyncStatusSynced
+	return synced
+}
+
+// fail marks the ticket as failed to sync, with the git error on one line
+func (s *SyncTicket) fail(reason string, err error) *SyncTicket {
+	s.Status = SyncStatusFailed
+	s.Reason = reason + ": " + strings.Join(strings.Fields(err.Error()), " ")
+	return s
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// TagAction identifies the operation performed by a tag command
+type TagAction string
+
+const (
+	TagActionAdd    TagAction = "add"
+	TagActionRemove TagAction = "remove"
+)
+
+// AddTicketTags adds tags to a ticket and saves it
+func (app *App) AddTicketTags(ctx context.Context, ticketID string, tags []string) (*TagResult, error) {
+	return app.updateTicketTags(ctx, ticketID, tags, TagActionAdd)
+}
+
+// RemoveTicketTags removes tags from a ticket and saves it
+func (app *App) RemoveTicketTags(ctx context.Context, ticketID string, tags []string) (*TagResult, error) {
+	return app.updateTicketTags(ctx, ticketID, tags, TagActionRemove)
+}
+
+// updateTicketTags applies a tag action to a ticket
+func (app *App) updateTicketTags(ctx context.Context, ticketID string, tags []string, action TagAction) (*TagResult, error) {
+	logger := log.Global().WithOperation("tag_" + string(action)).WithTicket(ticketID)
+
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	result := &TagResult{
+		Ticket:  t,
+		Action:  action,
+		Changed: make([]string, 0, len(normalized)),
+	}
+
+	for _, tag := range normalized {
+		switch action {
+		case TagActionAdd:
+			added, err := t.AddTag(tag)
+			if err != nil {
+				return nil, err
+			}
+			if added {
+				result.Changed = append(result.Changed, tag)
+			}
+		case TagActionRemove:
+			if t.RemoveTag(tag) {
+				result.Changed = append(result.Changed, tag)
+			}
+		}
+	}
+
+	if len(result.Changed) == 0 {
+		logger.Debug("no tag changes")
+		return result, nil
+	}
+
+	if err := app.Manager.Update(ctx, t); err != nil {
+		logger.WithError(err).Error("failed to update ticket tags")
+		return nil, fmt.Errorf("failed to update ticket tags: %w", err)
+	}
+	logger.Info("updated ticket tags", "tags", result.Changed)
+
+	return result, nil
+}
+
+// normalizeTags normalizes and validates tags, dropping duplicates
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = ticket.NormalizeTag(tag)
+		if !ticket.IsValidTag(tag) {
+			return nil, NewError(ErrValidation, "Invalid tag format",
+				fmt.Sprintf("Tag '%s' contains invalid characters", tag),
+				[]string{
+					"Use only lowercase letters (a-z)",
+					"Use only numbers (0-9)",
+					"Use only hyphens (-) for separation",
+				})
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// filterTicketsByTags returns the tickets that have all of the given tags
+func filterTicketsByTags(tickets []ticket.Ticket, tags []string) []ticket.Ticket {
+	filtered := make([]ticket.Ticket, 0, len(tickets))
+	for _, t := range tickets {
+		matches := true
+		for _, tag := range tags {
+			if !t.HasTag(tag) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// TaskAction identifies the operation performed by a task command
+type TaskAction string
+
+const (
+	TaskActionCheck   TaskAction = "check"
+	TaskActionUncheck TaskAction = "uncheck"
+)
+
+// ListTicketTasks returns the checklist items of a ticket
+func (app *App) ListTicketTasks(ctx context.Context, ticketID string) (*TaskListResult, error) {
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	return &TaskListResult{
+		Ticket: t,
+		Tasks:  t.Tasks(),
+	}, nil
+}
+
+// CheckTicketTask marks the n-th checklist item (1-based) of a ticket as done
+func (app *App) CheckTicketTask(ctx context.Context, ticketID string, n int) (*TaskResult, error) {
+	return app.setTicketTask(ctx, ticketID, n, TaskActionCheck)
+}
+
+// UncheckTicketTask marks the n-th checklist item (1-based) of a ticket as not done
+func (app *App) UncheckTicketTask(ctx context.Context, ticketID string, n int) (*TaskResult, error) {
+	return app.setTicketTask(ctx, ticketID, n, TaskActionUncheck)
+}
+
+// setTicketTask applies a task action to a ticket, rewriting only the checkbox
+func (app *App) setTicketTask(ctx context.Context, ticketID string, n int, action TaskAction) (*TaskResult, error) {
+	logger := log.Global().WithOperation("task_" + string(action)).WithTicket(ticketID)
+
+	t, err := app.Manager.Get(ctx, ticketID)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	tasks := t.Tasks()
+	if n < 1 || n > len(tasks) {
+		return nil, NewError(ErrValidation, "Task not found",
+			fmt.Sprintf("Ticket %s has %d task(s); task %d does not exist", t.ID, len(tasks), n),
+			[]string{fmt.Sprintf("List the tasks: ticketflow task list %s", t.ID)})
+	}
+
+	changed, err := t.SetTaskDone(n, action == TaskActionCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TaskResult{
+		Ticket:  t,
+		Action:  action,
+		Task:    t.Tasks()[n-1],
+		Changed: changed,
+	}
+
+	if !changed {
+		logger.Debug("task already in requested state", "task", n)
+		return result, nil
+	}
+
+	if err := app.Manager.Update(ctx, t); err != nil {
+		logger.WithError(err).Error("failed to update ticket task")
+		return nil, fmt.Errorf("failed to update ticket task: %w", err)
+	}
+	logger.Info("updated ticket task", "task", n)
+
+	return result, nil
+}
+
+// taskToJSON converts a checklist item to its JSON representation
+func taskToJSON(task ticket.Task) map[string]interface{} {
+	return map[string]interface{}{
+		"number": task.Number,
+		"text":   task.Text,
+		"done":   task.Done,
+	}
+}
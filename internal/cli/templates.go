@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// resolveTemplate returns the named template, or the "default" template when name
+// is empty. It returns nil when no name is given and no default template exists.
+func (app *App) resolveTemplate(name string) (*ticket.Template, error) {
+	templates, err := ticket.LoadTemplates(app.Config, app.ProjectRoot)
+	if err != nil {
+		return nil, NewError(ErrConfigInvalid, "Failed to load ticket templates", err.Error(), nil)
+	}
+
+	if name == "" {
+		tmpl, _ := ticket.FindTemplate(templates, ticket.DefaultTemplateName)
+		return tmpl, nil
+	}
+
+	tmpl, ok := ticket.FindTemplate(templates, name)
+	if !ok {
+		suggestions := []string{
+			fmt.Sprintf("Add a template file: %s/%s.md", app.Config.GetTemplatesPath(app.ProjectRoot), name),
+		}
+		if len(templates) > 0 {
+			suggestions = append([]string{
+				fmt.Sprintf("Available templates: %s", strings.Join(ticket.TemplateNames(templates), ", ")),
+			}, suggestions...)
+		}
+		return nil, NewError(ErrValidation, "Template not found",
+			fmt.Sprintf("No ticket template named '%s'", name), suggestions)
+	}
+	return tmpl, nil
+}
+
+// templateData collects the variables available to a ticket template
+func (app *App) templateData(ctx context.Context, id, slug, parentID string) ticket.TemplateData {
+	// A missing user.name is not an error; templates just see an empty author
+	author, _ := app.Git.Exec(ctx, "config", "user.name")
+
+	return ticket.TemplateData{
+		Slug:     slug,
+		ID:       id,
+		ParentID: parentID,
+		Date:     time.Now().Format(time.DateOnly),
+		Author:   author,
+	}
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// TreeRollup summarizes the sub-tickets below a ticket, at any depth
+type TreeRollup struct {
+	Total   int
+	Closed  int
+	ByState map[string]int
+}
+
+// TicketTreeNode is a ticket and its sub-tickets
+type TicketTreeNode struct {
+	Ticket   ticket.Ticket
+	Children []*TicketTreeNode
+	Rollup   TreeRollup
+	// CycleParent is the parent the ticket was not attached to because its
+	// parent chain loops back to itself; the ticket is shown as a root instead
+	CycleParent string
+}
+
+// TicketTree returns the parent/sub-ticket hierarchy of every ticket, or only
+// the subtree of rootID when it is set
+func (app *App) TicketTree(ctx context.Context, rootID string) (*TicketTreeResult, error) {
+	logger := log.Global().WithOperation("ticket_tree")
+
+	var root *ticket.Ticket
+	if rootID != "" {
+		var err error
+		if root, err = app.Manager.Get(ctx, rootID); err != nil {
+			return nil, ConvertError(err)
+		}
+	}
+
+	tickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+
+	// Archived tickets are left out of the full tree, where sub-tickets of an
+	// archived parent are shown as roots. An archived root is shown with its
+	// sub-tickets, archived or not.
+	if root != nil && root.IsArchived() {
+		archived, err := app.Manager.List(ctx, ticket.StatusFilterArchived)
+		if err != nil {
+			return nil, ConvertError(err)
+		}
+		tickets = append(tickets, archived...)
+	}
+
+	roots, nodes := buildTicketTree(tickets)
+	result := &TicketTreeResult{Roots: roots}
+
+	if root != nil {
+		result.RootID = root.ID
+		node, ok := nodes[root.ID]
+		if !ok {
+			// The ticket was moved or removed after it was read
+			node = &TicketTreeNode{Ticket: *root, Rollup: TreeRollup{ByState: map[string]int{}}}
+		}
+		result.Roots = []*TicketTreeNode{node}
+	}
+
+	logger.Debug("built ticket tree", "tickets", len(nodes), "roots", len(result.Roots))
+	return result, nil
+}
+
+// buildTicketTree arranges tickets by their parent relations. Tickets whose
+// parent is not among tickets are roots. Roots and children keep the order of
+// tickets. A parent link that would close a cycle is not followed; the ticket
+// becomes a root with CycleParent set.
+func buildTicketTree(tickets []ticket.Ticket) ([]*TicketTreeNode, map[string]*TicketTreeNode) {
+	nodes := make(map[string]*TicketTreeNode, len(tickets))
+	for _, t := range tickets {
+		nodes[t.ID] = &TicketTreeNode{Ticket: t}
+	}
+
+	// attached holds the parent links followed so far, so cycles are detected
+	// the same way as when a sub-ticket is created
+	attached := make(map[string]string, len(tickets))
+	parentOf := func(id string) string { return attached[id] }
+
+	var roots []*TicketTreeNode
+	for i := range tickets {
+		node := nodes[tickets[i].ID]
+		parentID := ExtractParentID(&node.Ticket)
+		parent, ok := nodes[parentID]
+		if !ok {
+			roots = append(roots, node)
+			continue
+		}
+		if hasAncestor(parentID, node.Ticket.ID, parentOf) {
+			log.Warn("circular parent relationship", "ticket_id", node.Ticket.ID, "parent", parentID)
+			node.CycleParent = parentID
+			roots = append(roots, node)
+			continue
+		}
+		attached[node.Ticket.ID] = parentID
+		parent.Children = append(parent.Children, node)
+	}
+
+	for _, root := range roots {
+		rollUp(root)
+	}
+
+	return roots, nodes
+}
+
+// rollUp fills in the roll-up counts of node and its descendants
+func rollUp(node *TicketTreeNode) TreeRollup {
+	rollup := TreeRollup{ByState: make(map[string]int)}
+	for _, child := range node.Children {
+		childRollup := rollUp(child)
+		rollup.Total += childRollup.Total + 1
+		rollup.Closed += childRollup.Closed
+		if child.Ticket.IsClosed() {
+			rollup.Closed++
+		}
+		rollup.ByState[string(child.Ticket.State())]++
+		for state, n := range childRollup.ByState {
+			rollup.ByState[state] += n
+		}
+	}
+	node.Rollup = rollup
+	return rollup
+}
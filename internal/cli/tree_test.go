@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/mocks"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+func treeNodeIDs(nodes []*TicketTreeNode) []string {
+	ids := make([]string, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.Ticket.ID
+	}
+	return ids
+}
+
+func TestBuildTicketTree(t *testing.T) {
+	t.Parallel()
+
+	started := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	closed := started.Add(time.Hour)
+	tickets := []ticket.Ticket{
+		{ID: "epic"},
+		{ID: "child-done", Related: []string{"parent:epic"}, StartedAt: ticket.NewRFC3339TimePtr(&started), ClosedAt: ticket.NewRFC3339TimePtr(&closed)},
+		{ID: "grandchild", Related: []string{"parent:child-done"}, StartedAt: ticket.NewRFC3339TimePtr(&started)},
+		{ID: "child-todo", Related: []string{"parent:epic", "blocks:orphan"}},
+		{ID: "orphan", Related: []string{"parent:missing"}},
+		{ID: "cycle-a", Related: []string{"parent:cycle-b"}},
+		{ID: "cycle-b", Related: []string{"parent:cycle-a"}},
+	}
+
+	roots, nodes := buildTicketTree(tickets)
+	assert.Equal(t, []string{"epic", "orphan", "cycle-b"}, treeNodeIDs(roots))
+	assert.Len(t, nodes, len(tickets))
+
+	epic := roots[0]
+	assert.Equal(t, []string{"child-done", "child-todo"}, treeNodeIDs(epic.Children))
+	assert.Equal(t, []string{"grandchild"}, treeNodeIDs(epic.Children[0].Children))
+	assert.Equal(t, TreeRollup{Total: 3, Closed: 1, ByState: map[string]int{"todo": 1, "doing": 1, "done": 1}}, epic.Rollup)
+	assert.Equal(t, 1, epic.Children[0].Rollup.Total)
+	assert.Empty(t, epic.CycleParent)
+
+	// The link that closes the cycle is not followed
+	cycle := roots[2]
+	assert.Equal(t, "cycle-a", cycle.CycleParent)
+	assert.Equal(t, []string{"cycle-a"}, treeNodeIDs(cycle.Children))
+}
+
+func TestHasAncestor(t *testing.T) {
+	t.Parallel()
+
+	parents := map[string]string{"c": "b", "b": "a", "x": "y", "y": "x"}
+	parentOf := func(id string) string { return parents[id] }
+
+	assert.True(t, hasAncestor("c", "a", parentOf))
+	assert.True(t, hasAncestor("c", "c", parentOf))
+	assert.False(t, hasAncestor("a", "c", parentOf))
+	assert.False(t, hasAncestor("x", "a", parentOf), "existing cycles are walked once")
+}
+
+func TestApp_TicketTree(t *testing.T) {
+	t.Parallel()
+
+	tickets := []ticket.Ticket{
+		{ID: "epic"},
+		{ID: "child", Related: []string{"parent:epic"}},
+		{ID: "other"},
+	}
+	archived := ticket.Ticket{ID: "archived"}
+
+	mockManager := new(mocks.MockTicketManager)
+	mockManager.On("List", mock.Anything, ticket.StatusFilterAll).Return(tickets, nil)
+	mockManager.On("Get", mock.Anything, "epic").Return(&tickets[0], nil)
+	mockManager.On("Get", mock.Anything, "archived").Return(&archived, nil)
+	app := &App{
+		Config:  config.Default(),
+		Manager: mockManager,
+		Output:  NewOutputWriter(nil, nil, FormatText),
+	}
+
+	result, err := app.TicketTree(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"epic", "other"}, treeNodeIDs(result.Roots))
+	assert.Empty(t, result.RootID)
+
+	result, err = app.TicketTree(context.Background(), "epic")
+	require.NoError(t, err)
+	assert.Equal(t, "epic", result.RootID)
+	require.Equal(t, []string{"epic"}, treeNodeIDs(result.Roots))
+	assert.Equal(t, []string{"child"}, treeNodeIDs(result.Roots[0].Children))
+
+	result, err = app.TicketTree(context.Background(), "archived")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archived"}, treeNodeIDs(result.Roots))
+}
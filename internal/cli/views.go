@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// resolveView returns the saved view with the given name
+func (app *App) resolveView(name string) (*ticket.View, error) {
+	vc, ok := app.Config.GetView(name)
+	if !ok {
+		suggestions := []string{
+			fmt.Sprintf("Define the view under 'views:' in %s", config.ConfigFileName),
+		}
+		if names := app.Config.GetViewNames(); len(names) > 0 {
+			suggestions = append([]string{
+				fmt.Sprintf("Available views: %s", strings.Join(names, ", ")),
+			}, suggestions...)
+		}
+		return nil, NewError(ErrValidation, "View not found",
+			fmt.Sprintf("No saved view named '%s'", name), suggestions)
+	}
+
+	view, err := ticket.NewView(vc)
+	if err != nil {
+		return nil, NewError(ErrConfigInvalid, "Invalid saved view", err.Error(),
+			[]string{fmt.Sprintf("Fix the view's filter or sort in %s", config.ConfigFileName)})
+	}
+	return view, nil
+}
+
+// applyView fills in list options from the saved view named by opts.View.
+// Status, sort and count only apply when the options leave them unset; the
+// view's filter is combined with the options' filter.
+func (app *App) applyView(opts ListOptions) (ListOptions, error) {
+	view, err := app.resolveView(opts.View)
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.Status == "" {
+		switch view.Scope {
+		case ticket.StatusFilterActive:
+		case ticket.StatusFilterAll:
+			opts.Status = StatusAll
+		default:
+			opts.Status = ticket.Status(view.Scope)
+		}
+	}
+
+	switch {
+	case view.Expression == "":
+	case opts.Filter == "":
+		opts.Filter = view.Expression
+	default:
+		opts.Filter = fmt.Sprintf("(%s) and (%s)", view.Expression, opts.Filter)
+	}
+
+	if opts.Sort == "" {
+		opts.Sort = sortKeysString(view.Sort)
+	}
+	if opts.Count == 0 {
+		opts.Count = view.Count
+	}
+	opts.IncludeArchived = opts.IncludeArchived || view.IncludeArchived
+
+	return opts, nil
+}
+
+// ListViews returns the saved views defined in the configuration
+func (app *App) ListViews() (*ViewListResult, error) {
+	views := make([]*ticket.View, 0, len(app.Config.Views))
+	for _, vc := range app.Config.Views {
+		view, err := app.resolveView(vc.Name)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return &ViewListResult{Views: views}, nil
+}
+
+// sortKeysString formats sort keys as accepted by 'list --sort'
+func sortKeysString(keys []ticket.SortKey) string {
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = key.Field
+		if key.Descending {
+			parts[i] = "-" + key.Field
+		}
+	}
+	return strings.Join(parts, ",")
+}
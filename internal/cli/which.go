@@ -0,0 +1,271 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/git"
+	"github.com/yshrsmz/ticketflow/internal/log"
+	"github.com/yshrsmz/ticketflow/internal/ticket"
+)
+
+// Where Which found the ticket of a commit, in the order the sources are checked
+const (
+	WhichSourceTrailer = "trailer" // Ticket trailer of the commit
+	WhichSourceBranch  = "branch"  // Ticket branch the commit was made on
+	WhichSourceMessage = "message" // Ticket ID mentioned in the commit message
+	WhichSourceMerge   = "merge"   // Ticket ID in the message of the merge that brought the commit in
+)
+
+// ticketIDPattern matches ticket IDs mentioned in free text
+var ticketIDPattern = regexp.MustCompile(`\b\d{6}-\d{6}-[a-z0-9]+(?:-[a-z0-9]+)*\b`)
+
+// whichCandidate is a ticket ID found for a commit and where it came from
+type whichCandidate struct {
+	id     string
+	source string
+	ref    string // Branch name or merge commit, when relevant
+}
+
+// Which finds the ticket that introduced a commit. target is a revision, or
+// file:line to look up the commit that last changed that line.
+func (app *App) Which(ctx context.Context, target string) (*WhichResult, error) {
+	logger := log.Global().WithOperation("which")
+
+	result := &WhichResult{}
+	rev := target
+	if file, line, ok := splitFileLine(target); ok {
+		sha, err := app.blameLine(ctx, file, line)
+		if err != nil {
+			return nil, err
+		}
+		rev = sha
+		result.File = file
+		result.Line = line
+	}
+
+	sha, err := app.Git.Exec(ctx, git.SubcmdRevParse, git.FlagVerify, git.FlagQuiet, rev+"^{commit}")
+	if err != nil || sha == "" {
+		return nil, NewError(ErrValidation, "Unknown revision",
+			fmt.Sprintf("'%s' is neither a commit nor an existing file:line", target),
+			[]string{"Pass a commit SHA, branch or tag, or a path with a line number: ticketflow which main.go:42"})
+	}
+
+	result.Commit, err = git.ReadCommit(ctx, app.Git, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := app.whichCandidates(ctx, sha)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, c := range candidates {
+		t, err := app.Manager.Get(ctx, c.id)
+		if err != nil {
+			logger.WithError(err).Debug("candidate ticket not found", "ticket_id", c.id, "source", c.source)
+			missing = append(missing, c.id)
+			continue
+		}
+		result.Ticket = t
+		result.Source = c.source
+		result.Ref = c.ref
+		logger.Debug("found ticket for commit", "commit", sha, "ticket_id", t.ID, "source", c.source)
+		return result, nil
+	}
+
+	if len(missing) > 0 {
+		return nil, NewError(ErrTicketNotFound, "Ticket not found",
+			fmt.Sprintf("Commit %s refers to %s, but no such ticket exists", result.Commit.ShortSHA(), strings.Join(missing, ", ")),
+			[]string{"The ticket file may have been deleted; check the history: git log --all -- '*" + missing[0] + ".md'"})
+	}
+	return nil, NewError(ErrTicketNotFound, "No ticket found for commit",
+		fmt.Sprintf("Commit %s has no Ticket trailer, is not on a ticket branch, and was not merged with a ticket ID in the message",
+			result.Commit.ShortSHA()),
+		[]string{"Install the hooks so future commits are tagged: ticketflow hooks install"})
+}
+
+// whichCandidates lists the ticket IDs a commit may belong to, most reliable first
+func (app *App) whichCandidates(ctx context.Context, sha string) ([]whichCandidate, error) {
+	var candidates []whichCandidate
+	seen := make(map[string]bool)
+	add := func(id, source, ref string) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		candidates = append(candidates, whichCandidate{id: id, source: source, ref: ref})
+	}
+
+	msg, err := app.Git.Exec(ctx, git.SubcmdLog, "-1", "--format=%B", sha, "--")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit message: %w", err)
+	}
+	lines, _ := messageLines(msg)
+	for _, line := range lines {
+		if id, ok := ticketTrailerID(line); ok && id != "" {
+			add(id, WhichSourceTrailer, "")
+		}
+	}
+
+	branches, err := app.ticketBranchesContaining(ctx, sha)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range branches {
+		add(c.id, c.source, c.ref)
+	}
+
+	for _, id := range ticketIDsIn(msg) {
+		add(id, WhichSourceMessage, "")
+	}
+
+	merge, err := app.mergeOf(ctx, sha)
+	if err != nil {
+		return nil, err
+	}
+	if merge != nil {
+		for _, id := range ticketIDsIn(merge.message) {
+			add(id, WhichSourceMerge, merge.sha)
+		}
+	}
+
+	return candidates, nil
+}
+
+// ticketBranchesContaining returns the tickets whose branches a commit was
+// made on, oldest ticket first. Once the commit is in the default branch,
+// every branch created since contains it too, so none is returned.
+func (app *App) ticketBranchesContaining(ctx context.Context, sha string) ([]whichCandidate, error) {
+	defaultBranch := app.Config.Git.DefaultBranch
+	if defaultBranch != "" {
+		// --is-ancestor exits with status 1 when the commit is not merged
+		if _, err := app.Git.Exec(ctx, "merge-base", "--is-ancestor", sha, defaultBranch); err == nil {
+			return nil, nil
+		}
+	}
+
+	output, err := app.Git.Exec(ctx, "for-each-ref", "--contains", sha, "--format=%(refname:short)", "refs/heads/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches containing %s: %w", sha, err)
+	}
+
+	tickets, err := app.Manager.List(ctx, ticket.StatusFilterAll)
+	if err != nil {
+		return nil, ConvertError(err)
+	}
+	byBranch := ticketsByBranch(tickets)
+
+	var candidates []whichCandidate
+	for _, branch := range strings.Fields(output) {
+		if branch == defaultBranch {
+			continue
+		}
+		if t, ok := byBranch[branch]; ok {
+			candidates = append(candidates, whichCandidate{id: t.ID, source: WhichSourceBranch, ref: branch})
+		} else if _, _, err := ticket.ParseID(branch); err == nil {
+			// Reported as a missing ticket if its file is gone
+			candidates = append(candidates, whichCandidate{id: branch, source: WhichSourceBranch, ref: branch})
+		}
+	}
+	// Ticket IDs start with their creation time
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].id < candidates[j].id })
+	return candidates, nil
+}
+
+// mergeCommit is a merge commit and its message
+type mergeCommit struct {
+	sha     string
+	message string
+}
+
+// mergeOf returns the merge commit that brought a commit into the default
+// branch, or into HEAD when none is configured. It returns nil when the
+// commit was made on that branch directly or is not in it.
+func (app *App) mergeOf(ctx context.Context, sha string) (*mergeCommit, error) {
+	head := app.Config.Git.DefaultBranch
+	if head == "" {
+		head = "HEAD"
+	}
+
+	// The oldest merge along the first-parent chain that descends from the commit
+	output, err := app.Git.Exec(ctx, git.SubcmdLog, "--merges", "--first-parent", "--ancestry-path", "--reverse",
+		"--format=%x1e%H%x1f%B", sha+".."+head, "--")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merges of %s: %w", sha, err)
+	}
+
+	records := strings.Split(strings.TrimPrefix(output, "\x1e"), "\x1e")
+	fields := strings.SplitN(records[0], "\x1f", 2)
+	if len(fields) != 2 {
+		return nil, nil
+	}
+	merge := &mergeCommit{sha: strings.TrimSpace(fields[0]), message: fields[1]}
+
+	// The commit came in through the merge only if the branch did not have it before
+	if _, err := app.Git.Exec(ctx, "merge-base", "--is-ancestor", sha, merge.sha+"^1"); err == nil {
+		return nil, nil
+	}
+	return merge, nil
+}
+
+// blameLine returns the commit that last changed a line of a file
+func (app *App) blameLine(ctx context.Context, file string, line int) (string, error) {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", file, err)
+	}
+
+	// Run blame next to the file, so files in ticket worktrees work too
+	blameGit := git.NewWithTimeout(filepath.Dir(abs), app.Config.GetGitTimeout())
+	output, err := blameGit.Exec(ctx, "blame", git.FlagPorcelain, "-L", fmt.Sprintf("%d,%d", line, line), "--", filepath.Base(abs))
+	if err != nil {
+		return "", NewError(ErrValidation, "Cannot look up line",
+			fmt.Sprintf("git blame failed for %s:%d: %v", file, line, err),
+			[]string{"Check that the file is tracked by git and has that many lines"})
+	}
+
+	sha, _, _ := strings.Cut(output, " ")
+	if strings.Trim(sha, "0") == "" {
+		return "", NewError(ErrValidation, "Line not committed yet",
+			fmt.Sprintf("%s:%d has uncommitted changes", file, line),
+			[]string{"Commit the change first, or look up another line"})
+	}
+	return sha, nil
+}
+
+// splitFileLine splits file:line into its parts when file exists
+func splitFileLine(target string) (string, int, bool) {
+	i := strings.LastIndex(target, ":")
+	if i <= 0 {
+		return "", 0, false
+	}
+	line, err := strconv.Atoi(target[i+1:])
+	if err != nil || line < 1 {
+		return "", 0, false
+	}
+	file := target[:i]
+	if info, err := os.Stat(file); err != nil || info.IsDir() {
+		return "", 0, false
+	}
+	return file, line, true
+}
+
+// ticketIDsIn returns the ticket IDs mentioned in text, in order
+func ticketIDsIn(text string) []string {
+	var ids []string
+	for _, id := range ticketIDPattern.FindAllString(text, -1) {
+		if _, _, err := ticket.ParseID(id); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
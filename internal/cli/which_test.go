@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketIDsIn(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"250101-120000-add-feature"}, ticketIDsIn("Merge branch '250101-120000-add-feature'"))
+	assert.Equal(t, []string{"250101-120000-a", "250102-090000-fix-bug"},
+		ticketIDsIn("Squash 250101-120000-a and 250102-090000-fix-bug."))
+	// Not a valid timestamp
+	assert.Empty(t, ticketIDsIn("Bump 991399-999999-version"))
+	assert.Empty(t, ticketIDsIn("Fix typo"))
+}
+
+func TestSplitFileLine(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(file, []byte("package main\n"), 0644))
+
+	got, line, ok := splitFileLine(file + ":12")
+	assert.True(t, ok)
+	assert.Equal(t, file, got)
+	assert.Equal(t, 12, line)
+
+	for _, target := range []string{
+		file,
+		file + ":0",
+		file + ":abc",
+		filepath.Join(dir, "missing.go") + ":1",
+		dir + ":1",
+		"HEAD~1",
+	} {
+		_, _, ok := splitFileLine(target)
+		assert.False(t, ok, target)
+	}
+}
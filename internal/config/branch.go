@@ -0,0 +1,118 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"text/template"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// DefaultBranchTemplate names ticket branches after the ticket ID
+const DefaultBranchTemplate = "{{.ID}}"
+
+// Values of git.start_base besides a ref
+const (
+	StartBaseDefault = "default" // The configured default branch
+	StartBaseCurrent = "current" // Whatever is checked out when the ticket is started
+	StartBaseParent  = "parent"  // The parent ticket's branch, or the default branch without a parent
+)
+
+// BranchNameData holds the variables available to git.branch_template
+type BranchNameData struct {
+	ID   string // Ticket ID, e.g. 250101-120000-add-feature
+	Slug string // Ticket slug, e.g. add-feature
+	User string // Login name of the user starting the ticket
+}
+
+// BranchName renders git.branch_template for a ticket. The result is checked
+// against git's rules for branch names.
+func (c *GitConfig) BranchName(data BranchNameData) (string, error) {
+	text := c.BranchTemplate
+	if text == "" {
+		text = DefaultBranchTemplate
+	}
+
+	tmpl, err := template.New("branch").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid git.branch_template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render git.branch_template: %w", err)
+	}
+
+	name := strings.TrimSpace(buf.String())
+	if !IsValidBranchName(name) {
+		return "", fmt.Errorf("git.branch_template produced an invalid branch name %q", name)
+	}
+	return name, nil
+}
+
+// IsValidBranchName reports whether name is usable as a git branch name.
+// It follows the rules of git check-ref-format --branch.
+func IsValidBranchName(name string) bool {
+	if name == "" || name == "@" || strings.HasPrefix(name, "-") ||
+		strings.HasSuffix(name, "/") || strings.HasSuffix(name, ".") || strings.HasSuffix(name, ".lock") ||
+		strings.Contains(name, "..") || strings.Contains(name, "//") || strings.Contains(name, "@{") {
+		return false
+	}
+	for _, r := range name {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return false
+		}
+	}
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasPrefix(part, ".") {
+			return false
+		}
+	}
+	return true
+}
+
+// CurrentUserName returns the login name of the user running ticketflow, for
+// the User variable of git.branch_template
+func CurrentUserName() string {
+	if name := os.Getenv("USER"); name != "" {
+		return name
+	}
+	if u, err := user.Current(); err == nil {
+		// Windows user names are prefixed with the domain
+		if i := strings.LastIndex(u.Username, `\`); i >= 0 {
+			return u.Username[i+1:]
+		}
+		return u.Username
+	}
+	return ""
+}
+
+// validateBranchTemplate checks that git.branch_template renders a valid
+// branch name
+func (c *Config) validateBranchTemplate() error {
+	if c.Git.BranchTemplate == "" {
+		return nil
+	}
+
+	sample := BranchNameData{ID: "250101-120000-example", Slug: "example", User: "user"}
+	if _, err := c.Git.BranchName(sample); err != nil {
+		return ticketerrors.NewConfigError("git.branch_template", c.Git.BranchTemplate,
+			fmt.Errorf("%w: %v", ticketerrors.ErrConfigInvalid, err))
+	}
+	return nil
+}
+
+// validateStartBase checks that git.start_base is a keyword or a usable ref name
+func (c *Config) validateStartBase() error {
+	switch c.Git.StartBase {
+	case "", StartBaseDefault, StartBaseCurrent, StartBaseParent:
+		return nil
+	}
+	if !IsValidBranchName(c.Git.StartBase) {
+		return ticketerrors.NewConfigError("git.start_base", c.Git.StartBase,
+			fmt.Errorf("%w: must be default, current, parent or a branch or tag name", ticketerrors.ErrConfigInvalid))
+	}
+	return nil
+}
@@ -19,11 +19,28 @@ type Config struct {
 	Tickets  TicketsConfig  `yaml:"tickets"`
 	Output   OutputConfig   `yaml:"output"`
 	Timeouts TimeoutsConfig `yaml:"timeouts"`
+
+	// Views declares saved ticket lists shown with 'list --view' and in the TUI
+	Views []ViewConfig `yaml:"views,omitempty"`
+
+	// PullRequest configures the descriptions rendered by 'ticketflow pr-body'
+	PullRequest PullRequestConfig `yaml:"pull_request,omitempty"`
+
+	// Sync configures how 'ticketflow sync' updates ticket branches
+	Sync SyncConfig `yaml:"sync,omitempty"`
 }
 
 // GitConfig represents git-related configuration
 type GitConfig struct {
 	DefaultBranch string `yaml:"default_branch"`
+
+	// BranchTemplate is a Go template for the branches of started tickets,
+	// with .ID, .Slug and .User. Defaults to the ticket ID.
+	BranchTemplate string `yaml:"branch_template,omitempty"`
+
+	// StartBase is where 'ticketflow start' creates ticket branches from:
+	// default, current, parent, or a ref. Defaults to current.
+	StartBase string `yaml:"start_base,omitempty"`
 }
 
 // WorktreeConfig represents worktree-related configuration
@@ -35,11 +52,25 @@ type WorktreeConfig struct {
 
 // TicketsConfig represents ticket-related configuration
 type TicketsConfig struct {
-	Dir      string `yaml:"dir"`
-	TodoDir  string `yaml:"todo_dir"`
-	DoingDir string `yaml:"doing_dir"`
-	DoneDir  string `yaml:"done_dir"`
-	Template string `yaml:"template"`
+	Dir          string `yaml:"dir"`
+	TodoDir      string `yaml:"todo_dir"`
+	DoingDir     string `yaml:"doing_dir"`
+	DoneDir      string `yaml:"done_dir"`
+	CancelledDir string `yaml:"cancelled_dir,omitempty"`
+	Template     string `yaml:"template"`
+
+	// ArchiveDir is where 'ticketflow archive' moves old done tickets, relative
+	// to Dir. Tickets are grouped by closing year below it. Defaults to
+	// "<done_dir>/archive".
+	ArchiveDir string `yaml:"archive_dir,omitempty"`
+
+	// Templates declares named ticket templates selected with 'new --template'.
+	// Markdown files in <dir>/.templates are available as templates too.
+	Templates []TemplateConfig `yaml:"templates,omitempty"`
+
+	// States declares custom workflow states. When empty, the todo/doing/done
+	// workflow is derived from the directory settings above.
+	States []StateConfig `yaml:"states,omitempty"`
 }
 
 // OutputConfig represents output formatting configuration
@@ -68,10 +99,11 @@ func Default() *Config {
 			},
 		},
 		Tickets: TicketsConfig{
-			Dir:      DefaultTicketsDir,
-			TodoDir:  DefaultTodoDir,
-			DoingDir: DefaultDoingDir,
-			DoneDir:  DefaultDoneDir,
+			Dir:          DefaultTicketsDir,
+			TodoDir:      DefaultTodoDir,
+			DoingDir:     DefaultDoingDir,
+			DoneDir:      DefaultDoneDir,
+			CancelledDir: DefaultCancelledDir,
 			Template: `# Summary
 
 [Describe the ticket summary here]
@@ -175,11 +207,35 @@ func (c *Config) Validate() error {
 	if c.Git.DefaultBranch == "" {
 		return ticketerrors.NewConfigError("git.default_branch", "", ticketerrors.ErrConfigInvalid)
 	}
+	if err := c.validateBranchTemplate(); err != nil {
+		return err
+	}
+	if err := c.validateStartBase(); err != nil {
+		return err
+	}
 
 	// Validate Tickets config
 	if c.Tickets.Dir == "" {
 		return ticketerrors.NewConfigError("tickets.dir", "", ticketerrors.ErrConfigInvalid)
 	}
+	if err := c.validateStates(); err != nil {
+		return err
+	}
+	if err := c.validateArchiveDir(); err != nil {
+		return err
+	}
+	if err := c.validateTemplates(); err != nil {
+		return err
+	}
+	if err := c.validateViews(); err != nil {
+		return err
+	}
+	if err := c.validatePullRequest(); err != nil {
+		return err
+	}
+	if err := c.validateSync(); err != nil {
+		return err
+	}
 
 	// Validate Output config
 	if c.Output.DefaultFormat != FormatText && c.Output.DefaultFormat != FormatJSON {
@@ -207,17 +263,42 @@ func (c *Config) GetTicketsPath(projectRoot string) string {
 
 // GetTodoPath returns the full path to the todo directory
 func (c *Config) GetTodoPath(projectRoot string) string {
-	return filepath.Join(c.GetTicketsPath(projectRoot), c.Tickets.TodoDir)
+	return filepath.Join(c.GetTicketsPath(projectRoot), c.stateDir(StateTodo, c.Tickets.TodoDir))
 }
 
 // GetDoingPath returns the full path to the doing directory
 func (c *Config) GetDoingPath(projectRoot string) string {
-	return filepath.Join(c.GetTicketsPath(projectRoot), c.Tickets.DoingDir)
+	return filepath.Join(c.GetTicketsPath(projectRoot), c.stateDir(StateDoing, c.Tickets.DoingDir))
 }
 
 // GetDonePath returns the full path to the done directory
 func (c *Config) GetDonePath(projectRoot string) string {
-	return filepath.Join(c.GetTicketsPath(projectRoot), c.Tickets.DoneDir)
+	return filepath.Join(c.GetTicketsPath(projectRoot), c.stateDir(StateDone, c.Tickets.DoneDir))
+}
+
+// GetCancelledPath returns the full path to the cancelled directory
+func (c *Config) GetCancelledPath(projectRoot string) string {
+	return c.GetStatePath(projectRoot, StateCancelled)
+}
+
+// GetArchivePath returns the full path to the archive directory
+func (c *Config) GetArchivePath(projectRoot string) string {
+	if c.Tickets.ArchiveDir != "" {
+		return filepath.Join(c.GetTicketsPath(projectRoot), c.Tickets.ArchiveDir)
+	}
+	return filepath.Join(c.GetDonePath(projectRoot), DefaultArchiveDir)
+}
+
+// validateArchiveDir ensures the archive directory does not overlap a state directory
+func (c *Config) validateArchiveDir() error {
+	archive := filepath.Clean(c.GetArchivePath(""))
+	for _, s := range c.GetStates() {
+		if filepath.Clean(c.GetStatePath("", s.Name)) == archive {
+			return ticketerrors.NewConfigError("tickets.archive_dir", c.Tickets.ArchiveDir,
+				fmt.Errorf("%w: directory already used by state %q", ticketerrors.ErrConfigInvalid, s.Name))
+		}
+	}
+	return nil
 }
 
 // GetWorktreePath returns the full path to the worktree base directory
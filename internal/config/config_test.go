@@ -22,6 +22,7 @@ func TestDefault(t *testing.T) {
 	assert.Equal(t, "todo", cfg.Tickets.TodoDir)
 	assert.Equal(t, "doing", cfg.Tickets.DoingDir)
 	assert.Equal(t, "done", cfg.Tickets.DoneDir)
+	assert.Equal(t, "cancelled", cfg.Tickets.CancelledDir)
 	assert.Equal(t, "text", cfg.Output.DefaultFormat)
 	assert.True(t, cfg.Output.JSONPretty)
 	assert.Equal(t, 30, cfg.Timeouts.Git)
@@ -107,6 +108,196 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: "timeouts.init_commands",
 		},
+		{
+			name: "archive dir shared with a state",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Tickets.ArchiveDir = "done"
+				return cfg
+			}(),
+			wantErr: "tickets.archive_dir",
+		},
+		{
+			name: "valid templates",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Tickets.Templates = []TemplateConfig{{Name: "bug", Priority: 1}, {Name: "feature"}}
+				return cfg
+			}(),
+		},
+		{
+			name: "invalid template name",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Tickets.Templates = []TemplateConfig{{Name: "Bug Report"}}
+				return cfg
+			}(),
+			wantErr: "tickets.templates",
+		},
+		{
+			name: "duplicate template name",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Tickets.Templates = []TemplateConfig{{Name: "bug"}, {Name: "bug"}}
+				return cfg
+			}(),
+			wantErr: "tickets.templates",
+		},
+		{
+			name: "template priority out of range",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Tickets.Templates = []TemplateConfig{{Name: "bug", Priority: 5}}
+				return cfg
+			}(),
+			wantErr: "tickets.templates",
+		},
+		{
+			name: "valid views",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{
+					{Name: "mine-urgent", Status: []string{"todo", "doing"}, Match: "auth", Sort: "priority", Count: 10},
+					{Name: "everything", Status: []string{"all"}},
+				}
+				return cfg
+			}(),
+		},
+		{
+			name: "invalid view name",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{{Name: "Mine Urgent"}}
+				return cfg
+			}(),
+			wantErr: "views",
+		},
+		{
+			name: "duplicate view name",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{{Name: "mine"}, {Name: "mine"}}
+				return cfg
+			}(),
+			wantErr: "views",
+		},
+		{
+			name: "view with unknown state",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{{Name: "mine", Status: []string{"review"}}}
+				return cfg
+			}(),
+			wantErr: "views",
+		},
+		{
+			name: "view combining all with a state",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{{Name: "mine", Status: []string{"all", "todo"}}}
+				return cfg
+			}(),
+			wantErr: "views",
+		},
+		{
+			name: "view with negative count",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Views = []ViewConfig{{Name: "mine", Count: -1}}
+				return cfg
+			}(),
+			wantErr: "views",
+		},
+		{
+			name: "pull request template and template file",
+			config: func() Config {
+				cfg := *Default()
+				cfg.PullRequest = PullRequestConfig{Template: "{{.Title}}", TemplateFile: ".github/pr-template.md"}
+				return cfg
+			}(),
+			wantErr: "pull_request.template_file",
+		},
+		{
+			name: "valid branch template",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.BranchTemplate = "{{.User}}/{{.ID}}"
+				return cfg
+			}(),
+			wantErr: "",
+		},
+		{
+			name: "branch template with unknown variable",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.BranchTemplate = "feature/{{.Title}}"
+				return cfg
+			}(),
+			wantErr: "git.branch_template",
+		},
+		{
+			name: "branch template producing an invalid branch name",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.BranchTemplate = "feature/{{.Slug}}:wip"
+				return cfg
+			}(),
+			wantErr: "git.branch_template",
+		},
+		{
+			name: "start base keyword",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.StartBase = StartBaseParent
+				return cfg
+			}(),
+			wantErr: "",
+		},
+		{
+			name: "start base ref",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.StartBase = "origin/main"
+				return cfg
+			}(),
+			wantErr: "",
+		},
+		{
+			name: "invalid start base",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Git.StartBase = "origin main"
+				return cfg
+			}(),
+			wantErr: "git.start_base",
+		},
+		{
+			name: "sync with rebase from a remote",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Sync = SyncConfig{Strategy: SyncStrategyRebase, Remote: "origin"}
+				return cfg
+			}(),
+			wantErr: "",
+		},
+		{
+			name: "invalid sync strategy",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Sync.Strategy = "squash"
+				return cfg
+			}(),
+			wantErr: "sync.strategy",
+		},
+		{
+			name: "invalid sync remote",
+			config: func() Config {
+				cfg := *Default()
+				cfg.Sync.Remote = "my remote"
+				return cfg
+			}(),
+			wantErr: "sync.remote",
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,6 +313,53 @@ func TestConfigValidate(t *testing.T) {
 	}
 }
 
+func TestGitConfigBranchName(t *testing.T) {
+	t.Parallel()
+	data := BranchNameData{ID: "250101-120000-add-login", Slug: "add-login", User: "alice"}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{name: "default template", template: "", want: "250101-120000-add-login"},
+		{name: "prefix and slug", template: "feature/{{.Slug}}", want: "feature/add-login"},
+		{name: "user and ID", template: "{{.User}}/{{.ID}}", want: "alice/250101-120000-add-login"},
+		{name: "surrounding whitespace is trimmed", template: " feature/{{.Slug}}\n", want: "feature/add-login"},
+		{name: "unknown variable", template: "{{.Title}}", wantErr: true},
+		{name: "parse error", template: "{{.Slug", wantErr: true},
+		{name: "invalid branch name", template: "{{.Slug}}..x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := GitConfig{BranchTemplate: tt.template}
+			got, err := cfg.BranchName(data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsValidBranchName(t *testing.T) {
+	t.Parallel()
+	valid := []string{"main", "feature/add-login", "alice/250101-120000-x", "fix_1.2"}
+	invalid := []string{"", "@", "-x", "x/", "x.", "x.lock", "a..b", "a//b", "a@{b", "a b", "a~b", "a^b",
+		"a:b", "a?b", "a*b", "a[b", `a\b`, ".x", "a/.b", "a\tb"}
+
+	for _, name := range valid {
+		assert.True(t, IsValidBranchName(name), name)
+	}
+	for _, name := range invalid {
+		assert.False(t, IsValidBranchName(name), name)
+	}
+}
+
 func TestConfigSaveAndLoad(t *testing.T) {
 	t.Parallel()
 	// Create temp directory
@@ -217,8 +455,17 @@ func TestGetPaths(t *testing.T) {
 	assert.Equal(t, "/home/user/project/tickets/todo", cfg.GetTodoPath(projectRoot))
 	assert.Equal(t, "/home/user/project/tickets/doing", cfg.GetDoingPath(projectRoot))
 	assert.Equal(t, "/home/user/project/tickets/done", cfg.GetDonePath(projectRoot))
+	assert.Equal(t, "/home/user/project/tickets/done/archive", cfg.GetArchivePath(projectRoot))
+	assert.Equal(t, "/home/user/project/tickets/.templates", cfg.GetTemplatesPath(projectRoot))
 	assert.Equal(t, "/home/user/.worktrees", cfg.GetWorktreePath(projectRoot))
 
+	cfg.Tickets.ArchiveDir = "archive"
+	assert.Equal(t, "/home/user/project/tickets/archive", cfg.GetArchivePath(projectRoot))
+
+	assert.Equal(t, "", cfg.GetPullRequestTemplatePath(projectRoot))
+	cfg.PullRequest.TemplateFile = ".github/pr-template.md"
+	assert.Equal(t, "/home/user/project/.github/pr-template.md", cfg.GetPullRequestTemplatePath(projectRoot))
+
 	// Test absolute paths
 	cfg.Tickets.Dir = "/absolute/tickets"
 	cfg.Worktree.BaseDir = "/absolute/worktrees"
@@ -227,6 +474,18 @@ func TestGetPaths(t *testing.T) {
 	assert.Equal(t, "/absolute/worktrees", cfg.GetWorktreePath(projectRoot))
 }
 
+func TestGetSyncSettings(t *testing.T) {
+	t.Parallel()
+	cfg := Default()
+
+	assert.Equal(t, SyncStrategyMerge, cfg.GetSyncStrategy())
+	assert.Equal(t, "main", cfg.GetSyncUpstream())
+
+	cfg.Sync = SyncConfig{Strategy: SyncStrategyRebase, Remote: "origin"}
+	assert.Equal(t, SyncStrategyRebase, cfg.GetSyncStrategy())
+	assert.Equal(t, "origin/main", cfg.GetSyncUpstream())
+}
+
 func TestGetTimeouts(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
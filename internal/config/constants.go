@@ -15,6 +15,8 @@ const (
 	DefaultTodoDir      = "todo"
 	DefaultDoingDir     = "doing"
 	DefaultDoneDir      = "done"
+	DefaultCancelledDir = "cancelled"
+	DefaultArchiveDir   = "archive" // Created inside the done directory
 	DefaultOutputFormat = "text"
 )
 
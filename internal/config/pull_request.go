@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// PullRequestConfig represents the configuration of 'ticketflow pr-body'
+type PullRequestConfig struct {
+	// Template is a Go template for pull request descriptions. When empty,
+	// TemplateFile or the built-in template is used.
+	Template string `yaml:"template,omitempty"`
+	// TemplateFile is a file holding the template, relative to the project root
+	TemplateFile string `yaml:"template_file,omitempty"`
+}
+
+// GetPullRequestTemplatePath returns the full path to the pull request template
+// file, or an empty string when none is configured
+func (c *Config) GetPullRequestTemplatePath(projectRoot string) string {
+	if c.PullRequest.TemplateFile == "" {
+		return ""
+	}
+	if filepath.IsAbs(c.PullRequest.TemplateFile) {
+		return c.PullRequest.TemplateFile
+	}
+	return filepath.Join(projectRoot, c.PullRequest.TemplateFile)
+}
+
+// validatePullRequest checks the pull request configuration
+func (c *Config) validatePullRequest() error {
+	if c.PullRequest.Template != "" && c.PullRequest.TemplateFile != "" {
+		return ticketerrors.NewConfigError("pull_request.template_file", c.PullRequest.TemplateFile,
+			fmt.Errorf("%w: template and template_file cannot both be set", ticketerrors.ErrConfigInvalid))
+	}
+	return nil
+}
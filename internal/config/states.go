@@ -0,0 +1,193 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// Built-in workflow state names.
+// These states are always present because ticket lifecycle operations
+// (start, close, cancel) move tickets between them.
+const (
+	StateTodo      = "todo"
+	StateDoing     = "doing"
+	StateDone      = "done"
+	StateCancelled = "cancelled"
+)
+
+// stateNamePattern matches valid workflow state names
+var stateNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// IsValidStateName reports whether name can be used as a workflow state name.
+// State names use lowercase letters, digits, hyphens and underscores.
+func IsValidStateName(name string) bool {
+	return stateNamePattern.MatchString(name)
+}
+
+// StateConfig represents a workflow state declared in the configuration
+type StateConfig struct {
+	Name        string   `yaml:"name"`
+	Dir         string   `yaml:"dir"`
+	Active      bool     `yaml:"active"`      // Included in the default ticket listing
+	Transitions []string `yaml:"transitions"` // States a ticket may move to from this state
+}
+
+// CanTransitionTo reports whether a ticket in this state may move to the target state
+func (s StateConfig) CanTransitionTo(target string) bool {
+	for _, t := range s.Transitions {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStates returns the ordered workflow states.
+// When no states are configured, the classic todo/doing/done workflow is derived
+// from the todo_dir, doing_dir and done_dir settings. The cancelled state is
+// always available; it is appended when the configuration does not declare it.
+func (c *Config) GetStates() []StateConfig {
+	cancelled := StateConfig{Name: StateCancelled, Dir: c.cancelledDir(), Active: false, Transitions: []string{}}
+
+	if len(c.Tickets.States) > 0 {
+		for _, s := range c.Tickets.States {
+			if s.Name == StateCancelled {
+				return c.Tickets.States
+			}
+		}
+		states := make([]StateConfig, 0, len(c.Tickets.States)+1)
+		states = append(states, c.Tickets.States...)
+		return append(states, cancelled)
+	}
+
+	return []StateConfig{
+		{Name: StateTodo, Dir: c.Tickets.TodoDir, Active: true, Transitions: []string{StateDoing, StateDone}},
+		{Name: StateDoing, Dir: c.Tickets.DoingDir, Active: true, Transitions: []string{StateDone}},
+		{Name: StateDone, Dir: c.Tickets.DoneDir, Active: false, Transitions: []string{}},
+		cancelled,
+	}
+}
+
+// cancelledDir returns the cancelled directory, defaulting when unset
+func (c *Config) cancelledDir() string {
+	if c.Tickets.CancelledDir != "" {
+		return c.Tickets.CancelledDir
+	}
+	return DefaultCancelledDir
+}
+
+// GetState returns the workflow state with the given name
+func (c *Config) GetState(name string) (StateConfig, bool) {
+	for _, s := range c.GetStates() {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return StateConfig{}, false
+}
+
+// GetStateNames returns the names of all workflow states in order
+func (c *Config) GetStateNames() []string {
+	states := c.GetStates()
+	names := make([]string, len(states))
+	for i, s := range states {
+		names[i] = s.Name
+	}
+	return names
+}
+
+// GetStatePath returns the full path to the directory of the given state.
+// It returns an empty string for unknown states.
+func (c *Config) GetStatePath(projectRoot, name string) string {
+	state, ok := c.GetState(name)
+	if !ok {
+		return ""
+	}
+	return filepath.Join(c.GetTicketsPath(projectRoot), state.Dir)
+}
+
+// CanTransition reports whether a ticket may move from one state to another
+func (c *Config) CanTransition(from, to string) bool {
+	state, ok := c.GetState(from)
+	if !ok {
+		return false
+	}
+	return state.CanTransitionTo(to)
+}
+
+// stateDir returns the directory of a built-in state, falling back to the legacy setting
+func (c *Config) stateDir(name, fallback string) string {
+	if len(c.Tickets.States) > 0 {
+		if state, ok := c.GetState(name); ok {
+			return state.Dir
+		}
+	}
+	return fallback
+}
+
+// validateStates validates the configured workflow states
+func (c *Config) validateStates() error {
+	if len(c.Tickets.States) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool, len(c.Tickets.States))
+	dirs := make(map[string]string, len(c.Tickets.States))
+	for i, s := range c.Tickets.States {
+		field := fmt.Sprintf("tickets.states[%d]", i)
+		if s.Name == "" {
+			return ticketerrors.NewConfigError(field+".name", "", ticketerrors.ErrConfigInvalid)
+		}
+		if !IsValidStateName(s.Name) {
+			return ticketerrors.NewConfigError(field+".name", s.Name,
+				fmt.Errorf("%w: state names use lowercase letters, digits, '-' and '_'", ticketerrors.ErrConfigInvalid))
+		}
+		if s.Name == "all" || s.Name == "active" || s.Name == "archived" {
+			return ticketerrors.NewConfigError(field+".name", s.Name,
+				fmt.Errorf("%w: state name is reserved", ticketerrors.ErrConfigInvalid))
+		}
+		if names[s.Name] {
+			return ticketerrors.NewConfigError(field+".name", s.Name,
+				fmt.Errorf("%w: duplicate state name", ticketerrors.ErrConfigInvalid))
+		}
+		names[s.Name] = true
+
+		if s.Dir == "" {
+			return ticketerrors.NewConfigError(field+".dir", "", ticketerrors.ErrConfigInvalid)
+		}
+		if other, ok := dirs[s.Dir]; ok {
+			return ticketerrors.NewConfigError(field+".dir", s.Dir,
+				fmt.Errorf("%w: directory already used by state %q", ticketerrors.ErrConfigInvalid, other))
+		}
+		dirs[s.Dir] = s.Name
+	}
+
+	// The implicit cancelled state must not share a directory with a declared state
+	if !names[StateCancelled] {
+		if other, ok := dirs[c.cancelledDir()]; ok {
+			return ticketerrors.NewConfigError("tickets.cancelled_dir", c.cancelledDir(),
+				fmt.Errorf("%w: directory already used by state %q", ticketerrors.ErrConfigInvalid, other))
+		}
+	}
+
+	for _, required := range []string{StateTodo, StateDoing, StateDone} {
+		if !names[required] {
+			return ticketerrors.NewConfigError("tickets.states", required,
+				fmt.Errorf("%w: required state is missing", ticketerrors.ErrConfigInvalid))
+		}
+	}
+
+	for i, s := range c.Tickets.States {
+		for _, target := range s.Transitions {
+			if !names[target] {
+				return ticketerrors.NewConfigError(fmt.Sprintf("tickets.states[%d].transitions", i), target,
+					fmt.Errorf("%w: unknown state", ticketerrors.ErrConfigInvalid))
+			}
+		}
+	}
+
+	return nil
+}
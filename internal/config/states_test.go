@@ -0,0 +1,216 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func customStates() []StateConfig {
+	return []StateConfig{
+		{Name: "todo", Dir: "todo", Active: true, Transitions: []string{"doing", "blocked"}},
+		{Name: "doing", Dir: "doing", Active: true, Transitions: []string{"review", "blocked"}},
+		{Name: "review", Dir: "review", Active: true, Transitions: []string{"doing", "done"}},
+		{Name: "blocked", Dir: "blocked", Active: false, Transitions: []string{"todo", "doing"}},
+		{Name: "done", Dir: "closed", Active: false},
+	}
+}
+
+func TestGetStatesDefault(t *testing.T) {
+	t.Parallel()
+	cfg := Default()
+
+	assert.Equal(t, []string{"todo", "doing", "done", "cancelled"}, cfg.GetStateNames())
+
+	todo, ok := cfg.GetState("todo")
+	require.True(t, ok)
+	assert.True(t, todo.Active)
+	assert.Equal(t, "todo", todo.Dir)
+
+	done, ok := cfg.GetState("done")
+	require.True(t, ok)
+	assert.False(t, done.Active)
+
+	assert.True(t, cfg.CanTransition("todo", "doing"))
+	assert.True(t, cfg.CanTransition("doing", "done"))
+	assert.False(t, cfg.CanTransition("done", "todo"))
+	assert.False(t, cfg.CanTransition("unknown", "todo"))
+
+	cancelled, ok := cfg.GetState("cancelled")
+	require.True(t, ok)
+	assert.False(t, cancelled.Active)
+	assert.Equal(t, filepath.Join("/project", "tickets", "cancelled"), cfg.GetCancelledPath("/project"))
+
+	_, ok = cfg.GetState("review")
+	assert.False(t, ok)
+}
+
+func TestGetStatesCustom(t *testing.T) {
+	t.Parallel()
+	cfg := Default()
+	cfg.Tickets.States = customStates()
+
+	// The cancelled state is appended when not declared
+	assert.Equal(t, []string{"todo", "doing", "review", "blocked", "done", "cancelled"}, cfg.GetStateNames())
+	assert.True(t, cfg.CanTransition("doing", "review"))
+	assert.False(t, cfg.CanTransition("todo", "review"))
+
+	root := "/project"
+	assert.Equal(t, filepath.Join(root, "tickets", "review"), cfg.GetStatePath(root, "review"))
+	assert.Equal(t, filepath.Join(root, "tickets", "closed"), cfg.GetDonePath(root))
+	assert.Empty(t, cfg.GetStatePath(root, "unknown"))
+
+	// A declared cancelled state is used as-is
+	cfg.Tickets.States = append(customStates(), StateConfig{Name: "cancelled", Dir: "dropped"})
+	assert.Len(t, cfg.GetStates(), 6)
+	assert.Equal(t, filepath.Join(root, "tickets", "dropped"), cfg.GetCancelledPath(root))
+}
+
+func TestValidateStates(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		modify  func([]StateConfig) []StateConfig
+		wantErr string
+	}{
+		{
+			name:   "valid states",
+			modify: func(s []StateConfig) []StateConfig { return s },
+		},
+		{
+			name: "empty name",
+			modify: func(s []StateConfig) []StateConfig {
+				s[2].Name = ""
+				return s
+			},
+			wantErr: "tickets.states[2].name",
+		},
+		{
+			name: "invalid name",
+			modify: func(s []StateConfig) []StateConfig {
+				s[2].Name = "In Review"
+				return s
+			},
+			wantErr: "lowercase letters",
+		},
+		{
+			name: "reserved name",
+			modify: func(s []StateConfig) []StateConfig {
+				s[2].Name = "all"
+				return s
+			},
+			wantErr: "reserved",
+		},
+		{
+			name: "archived is reserved",
+			modify: func(s []StateConfig) []StateConfig {
+				s[2].Name = "archived"
+				return s
+			},
+			wantErr: "reserved",
+		},
+		{
+			name: "duplicate name",
+			modify: func(s []StateConfig) []StateConfig {
+				s[2].Name = "doing"
+				return s
+			},
+			wantErr: "duplicate state name",
+		},
+		{
+			name: "empty dir",
+			modify: func(s []StateConfig) []StateConfig {
+				s[3].Dir = ""
+				return s
+			},
+			wantErr: "tickets.states[3].dir",
+		},
+		{
+			name: "shared dir",
+			modify: func(s []StateConfig) []StateConfig {
+				s[3].Dir = "review"
+				return s
+			},
+			wantErr: "already used",
+		},
+		{
+			name: "dir clashes with implicit cancelled state",
+			modify: func(s []StateConfig) []StateConfig {
+				s[3].Dir = "cancelled"
+				return s
+			},
+			wantErr: "tickets.cancelled_dir",
+		},
+		{
+			name: "missing required state",
+			modify: func(s []StateConfig) []StateConfig {
+				return s[1:]
+			},
+			wantErr: "required state is missing",
+		},
+		{
+			name: "unknown transition",
+			modify: func(s []StateConfig) []StateConfig {
+				s[0].Transitions = append(s[0].Transitions, "qa")
+				return s
+			},
+			wantErr: "unknown state",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := Default()
+			cfg.Tickets.States = tt.modify(customStates())
+
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadWithStates(t *testing.T) {
+	t.Parallel()
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, ".ticketflow.yaml")
+
+	content := `git:
+  default_branch: main
+tickets:
+  dir: tickets
+  states:
+    - name: todo
+      dir: todo
+      active: true
+      transitions: [doing]
+    - name: doing
+      dir: doing
+      active: true
+      transitions: [review]
+    - name: review
+      dir: review
+      active: true
+      transitions: [doing, done]
+    - name: done
+      dir: done
+output:
+  default_format: text
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0644))
+
+	cfg, err := Load(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"todo", "doing", "review", "done", "cancelled"}, cfg.GetStateNames())
+	assert.True(t, cfg.CanTransition("review", "done"))
+	assert.False(t, cfg.CanTransition("todo", "done"))
+}
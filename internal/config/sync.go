@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// Ways 'ticketflow sync' brings the default branch into ticket branches
+const (
+	SyncStrategyMerge  = "merge"
+	SyncStrategyRebase = "rebase"
+)
+
+// SyncConfig represents the configuration of 'ticketflow sync'
+type SyncConfig struct {
+	// Strategy is merge or rebase. Defaults to merge.
+	Strategy string `yaml:"strategy,omitempty"`
+	// Remote is fetched before syncing, and its copy of the default branch is
+	// brought in instead of the local one. When empty, nothing is fetched.
+	Remote string `yaml:"remote,omitempty"`
+}
+
+// GetSyncStrategy returns the configured sync strategy, or merge
+func (c *Config) GetSyncStrategy() string {
+	if c.Sync.Strategy == "" {
+		return SyncStrategyMerge
+	}
+	return c.Sync.Strategy
+}
+
+// GetSyncUpstream returns the branch ticket branches are synced with: the
+// remote's copy of the default branch when a remote is configured
+func (c *Config) GetSyncUpstream() string {
+	if c.Sync.Remote == "" {
+		return c.Git.DefaultBranch
+	}
+	return c.Sync.Remote + "/" + c.Git.DefaultBranch
+}
+
+// validateSync checks the sync configuration
+func (c *Config) validateSync() error {
+	switch c.Sync.Strategy {
+	case "", SyncStrategyMerge, SyncStrategyRebase:
+	default:
+		return ticketerrors.NewConfigError("sync.strategy", c.Sync.Strategy,
+			fmt.Errorf("%w: must be merge or rebase", ticketerrors.ErrConfigInvalid))
+	}
+	if c.Sync.Remote != "" && !IsValidBranchName(c.Sync.Remote) {
+		return ticketerrors.NewConfigError("sync.remote", c.Sync.Remote,
+			fmt.Errorf("%w: not a valid remote name", ticketerrors.ErrConfigInvalid))
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// DefaultTemplatesDir is the directory below tickets.dir holding template files
+const DefaultTemplatesDir = ".templates"
+
+// TemplateConfig represents a named ticket template declared in the configuration.
+// Description and Content are Go templates rendered when a ticket is created.
+type TemplateConfig struct {
+	Name        string `yaml:"name"`
+	Priority    int    `yaml:"priority,omitempty"`    // Default priority for new tickets (1-3)
+	Description string `yaml:"description,omitempty"` // Default ticket description
+	Content     string `yaml:"content"`
+}
+
+// IsValidTemplateName reports whether name can be used as a template name.
+// Template names follow the same rules as workflow state names.
+func IsValidTemplateName(name string) bool {
+	return stateNamePattern.MatchString(name)
+}
+
+// GetTemplatesPath returns the full path to the directory of template files
+func (c *Config) GetTemplatesPath(projectRoot string) string {
+	return filepath.Join(c.GetTicketsPath(projectRoot), DefaultTemplatesDir)
+}
+
+// validateTemplates checks the templates declared in the configuration
+func (c *Config) validateTemplates() error {
+	seen := make(map[string]bool, len(c.Tickets.Templates))
+	for _, t := range c.Tickets.Templates {
+		if !IsValidTemplateName(t.Name) {
+			return ticketerrors.NewConfigError("tickets.templates", t.Name,
+				fmt.Errorf("%w: template names must match %s", ticketerrors.ErrConfigInvalid, stateNamePattern.String()))
+		}
+		if seen[t.Name] {
+			return ticketerrors.NewConfigError("tickets.templates", t.Name,
+				fmt.Errorf("%w: duplicate template name", ticketerrors.ErrConfigInvalid))
+		}
+		seen[t.Name] = true
+
+		if t.Priority < 0 || t.Priority > 3 {
+			return ticketerrors.NewConfigError("tickets.templates", t.Name,
+				fmt.Errorf("%w: priority must be between 1 and 3", ticketerrors.ErrConfigInvalid))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"fmt"
+
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
+)
+
+// ViewAll is the view status that includes tickets in every workflow state
+const ViewAll = "all"
+
+// ViewConfig represents a saved ticket list declared in the configuration.
+// It is shown with 'list --view <name>' and as a tab in the TUI.
+type ViewConfig struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"` // Shown by 'ticketflow views'
+
+	// Status lists the workflow states to include, or "all".
+	// When empty, the view includes active tickets like 'list' does.
+	Status []string `yaml:"status,omitempty"`
+	// Match is text that the slug or description must contain
+	Match string `yaml:"match,omitempty"`
+	// Parent only includes sub-tickets of this parent ticket ID
+	Parent string `yaml:"parent,omitempty"`
+	// Tags only includes tickets that have all of these tags
+	Tags []string `yaml:"tags,omitempty"`
+	// Filter is an additional filter expression, as accepted by 'list --filter'
+	Filter string `yaml:"filter,omitempty"`
+	// Sort is a comma-separated list of sort fields, as accepted by 'list --sort'
+	Sort string `yaml:"sort,omitempty"`
+	// Count limits the number of tickets shown (0 shows every matching ticket)
+	Count int `yaml:"count,omitempty"`
+	// IncludeArchived also includes archived tickets when done tickets are included
+	IncludeArchived bool `yaml:"include_archived,omitempty"`
+}
+
+// IsValidViewName reports whether name can be used as a view name.
+// View names follow the same rules as workflow state names.
+func IsValidViewName(name string) bool {
+	return stateNamePattern.MatchString(name)
+}
+
+// GetView returns the saved view with the given name
+func (c *Config) GetView(name string) (ViewConfig, bool) {
+	for _, v := range c.Views {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return ViewConfig{}, false
+}
+
+// GetViewNames returns the names of the saved views in order
+func (c *Config) GetViewNames() []string {
+	names := make([]string, len(c.Views))
+	for i, v := range c.Views {
+		names[i] = v.Name
+	}
+	return names
+}
+
+// validateViews checks the views declared in the configuration.
+// Filter and sort expressions are checked when a view is used.
+func (c *Config) validateViews() error {
+	seen := make(map[string]bool, len(c.Views))
+	for _, v := range c.Views {
+		if !IsValidViewName(v.Name) {
+			return ticketerrors.NewConfigError("views", v.Name,
+				fmt.Errorf("%w: view names must match %s", ticketerrors.ErrConfigInvalid, stateNamePattern.String()))
+		}
+		if seen[v.Name] {
+			return ticketerrors.NewConfigError("views", v.Name,
+				fmt.Errorf("%w: duplicate view name", ticketerrors.ErrConfigInvalid))
+		}
+		seen[v.Name] = true
+
+		for _, status := range v.Status {
+			if status == ViewAll {
+				if len(v.Status) > 1 {
+					return ticketerrors.NewConfigError("views", v.Name,
+						fmt.Errorf("%w: status %q cannot be combined with other states", ticketerrors.ErrConfigInvalid, ViewAll))
+				}
+				continue
+			}
+			if _, ok := c.GetState(status); !ok {
+				return ticketerrors.NewConfigError("views", v.Name,
+					fmt.Errorf("%w: unknown state %q", ticketerrors.ErrConfigInvalid, status))
+			}
+		}
+
+		if v.Count < 0 {
+			return ticketerrors.NewConfigError("views", v.Name,
+				fmt.Errorf("%w: count must be non-negative", ticketerrors.ErrConfigInvalid))
+		}
+	}
+	return nil
+}
@@ -33,6 +33,7 @@ const (
 	FlagGitCommonDir = "--git-common-dir"
 	FlagSquash       = "--squash"
 	FlagGitDir       = "--git-dir"
+	FlagAbsGitDir    = "--absolute-git-dir"
 	FlagUpstream     = "-u"
 	FlagBranch       = "-b"
 	FlagMessage      = "-m"
@@ -198,7 +198,17 @@ func (g *Git) CurrentBranch(ctx context.Context) (string, error) {
 
 // CreateBranch creates and checks out a new branch
 func (g *Git) CreateBranch(ctx context.Context, name string) error {
-	_, err := g.Exec(ctx, SubcmdCheckout, FlagBranch, name)
+	return g.CreateBranchFrom(ctx, name, "")
+}
+
+// CreateBranchFrom creates and checks out a new branch at startPoint, or at
+// HEAD when startPoint is empty
+func (g *Git) CreateBranchFrom(ctx context.Context, name, startPoint string) error {
+	args := []string{SubcmdCheckout, FlagBranch, name}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	_, err := g.Exec(ctx, args...)
 	return err
 }
 
@@ -460,6 +470,26 @@ func FindMainRepositoryRoot(ctx context.Context, startPath string) (string, erro
 	return filepath.Dir(commonDir), nil
 }
 
+// FindGitDir returns the absolute git directory for startPath.
+// In a linked worktree this is the worktree's own directory under .git/worktrees.
+func FindGitDir(ctx context.Context, startPath string) (string, error) {
+	cmd := exec.CommandContext(ctx, GitCmd, SubcmdRevParse, FlagAbsGitDir)
+	cmd.Dir = startPath
+
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to resolve git dir: %w", err)
+	}
+
+	gitDir := strings.TrimSpace(stdout.String())
+	if gitDir == "" {
+		return "", fmt.Errorf("git dir output is empty")
+	}
+	return gitDir, nil
+}
+
 // RootPath returns the git repository root path (thread-safe)
 func (g *Git) RootPath() (string, error) {
 	g.rootOnce.Do(func() {
@@ -166,6 +166,32 @@ func TestFindMainRepositoryRoot_FromWorktree(t *testing.T) {
 	assert.Equal(t, wantRoot, gotRoot)
 }
 
+func TestFindGitDir(t *testing.T) {
+	t.Parallel()
+	git, tmpDir := setupTestGitRepo(t)
+	ctx := context.Background()
+
+	wtPath := filepath.Join(tmpDir, ".worktrees", "wt-gitdir")
+	err := git.AddWorktree(ctx, wtPath, "wt-gitdir")
+	assert.NoError(t, err)
+
+	wantMain, err := filepath.EvalSymlinks(filepath.Join(tmpDir, ".git"))
+	assert.NoError(t, err)
+
+	mainDir, err := FindGitDir(ctx, tmpDir)
+	assert.NoError(t, err)
+	gotMain, err := filepath.EvalSymlinks(mainDir)
+	assert.NoError(t, err)
+	assert.Equal(t, wantMain, gotMain)
+
+	// Each worktree has its own git dir inside the main one
+	wtDir, err := FindGitDir(ctx, wtPath)
+	assert.NoError(t, err)
+	gotWt, err := filepath.EvalSymlinks(wtDir)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(wantMain, "worktrees", "wt-gitdir"), gotWt)
+}
+
 func TestIsValidBranchCharEdgeCases(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
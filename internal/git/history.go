@@ -0,0 +1,171 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// historyFormat prints one commit per line with unit-separated fields:
+// hash, author name, author email, author date (strict ISO 8601) and subject
+const historyFormat = "--format=%H%x1f%an%x1f%ae%x1f%aI%x1f%s"
+
+// CommitInfo describes a commit in a ticket's history
+type CommitInfo struct {
+	SHA     string
+	Author  string
+	Email   string
+	Date    time.Time
+	Subject string
+	// TouchesFile is true when the commit changed the followed file
+	TouchesFile bool
+	// OnBranch is true when the commit belongs to the ticket branch
+	OnBranch bool
+	// Merged is true when the commit is reachable from the base branch
+	Merged bool
+}
+
+// ShortSHA returns the abbreviated commit hash
+func (c CommitInfo) ShortSHA() string {
+	if len(c.SHA) > 7 {
+		return c.SHA[:7]
+	}
+	return c.SHA
+}
+
+// HistoryOptions selects the commits collected by History
+type HistoryOptions struct {
+	// Path is the file to follow across renames, relative to the repository root
+	Path string
+	// Branch is the ticket branch; it is skipped when empty or missing
+	Branch string
+	// BaseBranch is the branch commits are checked against for Merged
+	BaseBranch string
+	// Since limits branch commits to the first-parent chain after this time.
+	// When zero, only branch commits not yet in BaseBranch are listed.
+	Since time.Time
+	// SkipMerged leaves Merged unset instead of checking every commit against
+	// BaseBranch, which takes one git call per commit
+	SkipMerged bool
+}
+
+// History returns the commits that touched opts.Path, following renames, plus the
+// commits on opts.Branch, newest first. Each commit is marked as merged when it is
+// reachable from opts.BaseBranch, unless opts.SkipMerged is set.
+func History(ctx context.Context, client BasicGitClient, opts HistoryOptions) ([]CommitInfo, error) {
+	byHash := make(map[string]*CommitInfo)
+	var commits []*CommitInfo
+
+	collect := func(output string, mark func(*CommitInfo)) error {
+		parsed, err := parseHistory(output)
+		if err != nil {
+			return err
+		}
+		for i := range parsed {
+			c, ok := byHash[parsed[i].SHA]
+			if !ok {
+				c = &parsed[i]
+				byHash[c.SHA] = c
+				commits = append(commits, c)
+			}
+			mark(c)
+		}
+		return nil
+	}
+
+	if opts.Path != "" {
+		output, err := client.Exec(ctx, SubcmdLog, "--follow", historyFormat, "--", opts.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file history: %w", err)
+		}
+		if err := collect(output, func(c *CommitInfo) { c.TouchesFile = true }); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Branch != "" {
+		exists, err := client.BranchExists(ctx, opts.Branch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check branch %s: %w", opts.Branch, err)
+		}
+		if exists {
+			args := []string{SubcmdLog, historyFormat}
+			if !opts.Since.IsZero() {
+				args = append(args, "--first-parent", "--since="+opts.Since.Format(time.RFC3339), opts.Branch)
+			} else {
+				args = append(args, opts.BaseBranch+".."+opts.Branch)
+			}
+			output, err := client.Exec(ctx, append(args, "--")...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read branch history: %w", err)
+			}
+			if err := collect(output, func(c *CommitInfo) { c.OnBranch = true }); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opts.BaseBranch != "" && !opts.SkipMerged {
+		for _, c := range commits {
+			// --is-ancestor exits with status 1 when the commit is not merged
+			_, err := client.Exec(ctx, "merge-base", "--is-ancestor", c.SHA, opts.BaseBranch)
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, fmt.Errorf("operation cancelled: %w", ctxErr)
+			}
+			c.Merged = err == nil
+		}
+	}
+
+	result := make([]CommitInfo, len(commits))
+	for i, c := range commits {
+		result[i] = *c
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Date.After(result[j].Date)
+	})
+	return result, nil
+}
+
+// ReadCommit returns the commit rev resolves to
+func ReadCommit(ctx context.Context, client BasicGitClient, rev string) (CommitInfo, error) {
+	output, err := client.Exec(ctx, SubcmdLog, "-1", historyFormat, rev, "--")
+	if err != nil {
+		return CommitInfo{}, fmt.Errorf("failed to read commit %s: %w", rev, err)
+	}
+	commits, err := parseHistory(output)
+	if err != nil {
+		return CommitInfo{}, err
+	}
+	if len(commits) == 0 {
+		return CommitInfo{}, fmt.Errorf("commit %s not found", rev)
+	}
+	return commits[0], nil
+}
+
+// parseHistory parses git log output produced with historyFormat
+func parseHistory(output string) ([]CommitInfo, error) {
+	var commits []CommitInfo
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\x1f", 5)
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected git log output: %q", line)
+		}
+		date, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit date %q: %w", fields[3], err)
+		}
+		commits = append(commits, CommitInfo{
+			SHA:     fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Date:    date,
+			Subject: fields[4],
+		})
+	}
+	return commits, nil
+}
@@ -0,0 +1,156 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/testutil"
+)
+
+// TestHistory is not parallel because it pins commit dates through the environment
+func TestHistory(t *testing.T) {
+	tmpDir := t.TempDir()
+	g := New(tmpDir)
+	ctx := context.Background()
+
+	run := func(args ...string) string {
+		out, err := g.Exec(ctx, args...)
+		require.NoError(t, err)
+		return out
+	}
+	base := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	commit := func(hour int, message string) {
+		date := base.Add(time.Duration(hour) * time.Hour).Format(time.RFC3339)
+		t.Setenv("GIT_AUTHOR_DATE", date)
+		t.Setenv("GIT_COMMITTER_DATE", date)
+		run("commit", "--allow-empty", "-m", message)
+	}
+	write := func(path, content string) {
+		full := filepath.Join(tmpDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0755))
+		require.NoError(t, os.WriteFile(full, []byte(content), 0644))
+	}
+
+	run("init")
+	testutil.GitConfigApply(t, g)
+	run("symbolic-ref", "HEAD", "refs/heads/main")
+	commit(0, "Initial commit")
+
+	ticketContent := "---\npriority: 2\n---\n\n# Ticket\n\nSome description that keeps rename detection stable.\n"
+	write("tickets/todo/t1.md", ticketContent)
+	run("add", "-A")
+	commit(1, "Create ticket")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, "tickets", "doing"), 0755))
+	run("mv", "tickets/todo/t1.md", "tickets/doing/t1.md")
+	commit(2, "Start ticket: t1")
+	started := base.Add(2 * time.Hour)
+
+	run("checkout", "-b", "t1")
+	write("feature.go", "package main\n")
+	run("add", "-A")
+	commit(3, "Implement feature")
+	run("checkout", "main")
+
+	write("other.go", "package main\n")
+	run("add", "-A")
+	commit(4, "Unrelated change")
+
+	t.Run("follows renames and includes branch commits", func(t *testing.T) {
+		commits, err := History(ctx, g, HistoryOptions{
+			Path:       "tickets/doing/t1.md",
+			Branch:     "t1",
+			BaseBranch: "main",
+			Since:      started,
+		})
+		require.NoError(t, err)
+		require.Len(t, commits, 3)
+		assert.Equal(t, "Implement feature", commits[0].Subject, "newest commit comes first")
+
+		subjects := make(map[string]CommitInfo)
+		for _, c := range commits {
+			subjects[c.Subject] = c
+		}
+		assert.NotContains(t, subjects, "Unrelated change")
+
+		created := subjects["Create ticket"]
+		assert.True(t, created.TouchesFile)
+		assert.False(t, created.OnBranch)
+		assert.True(t, created.Merged)
+
+		feature := subjects["Implement feature"]
+		assert.False(t, feature.TouchesFile)
+		assert.True(t, feature.OnBranch)
+		assert.False(t, feature.Merged)
+		assert.Equal(t, "Test User", feature.Author)
+		assert.Len(t, feature.ShortSHA(), 7)
+
+		startCommit := subjects["Start ticket: t1"]
+		assert.True(t, startCommit.TouchesFile)
+		assert.True(t, startCommit.OnBranch)
+		assert.True(t, startCommit.Merged)
+	})
+
+	t.Run("without since lists only unmerged branch commits", func(t *testing.T) {
+		commits, err := History(ctx, g, HistoryOptions{Branch: "t1", BaseBranch: "main"})
+		require.NoError(t, err)
+		require.Len(t, commits, 1)
+		assert.Equal(t, "Implement feature", commits[0].Subject)
+	})
+
+	t.Run("skip merged leaves commits unmarked", func(t *testing.T) {
+		commits, err := History(ctx, g, HistoryOptions{
+			Path:       "tickets/doing/t1.md",
+			BaseBranch: "main",
+			SkipMerged: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		for _, c := range commits {
+			assert.False(t, c.Merged, c.Subject)
+		}
+	})
+
+	t.Run("missing branch is skipped", func(t *testing.T) {
+		commits, err := History(ctx, g, HistoryOptions{
+			Path:       "tickets/doing/t1.md",
+			Branch:     "no-such-branch",
+			BaseBranch: "main",
+		})
+		require.NoError(t, err)
+		assert.Len(t, commits, 2)
+	})
+
+	t.Run("reads a single commit", func(t *testing.T) {
+		c, err := ReadCommit(ctx, g, "t1")
+		require.NoError(t, err)
+		assert.Equal(t, "Implement feature", c.Subject)
+		assert.Equal(t, base.Add(3*time.Hour), c.Date.UTC())
+
+		_, err = ReadCommit(ctx, g, "no-such-rev")
+		assert.Error(t, err)
+	})
+}
+
+func TestParseHistory(t *testing.T) {
+	t.Parallel()
+
+	commits, err := parseHistory("abc123\x1fAlice\x1falice@example.com\x1f2026-01-02T03:04:05+09:00\x1fFix: a\x1fb\n")
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "Alice", commits[0].Author)
+	assert.Equal(t, "Fix: a\x1fb", commits[0].Subject)
+	assert.Equal(t, 2026, commits[0].Date.Year())
+
+	_, err = parseHistory("garbage")
+	assert.Error(t, err)
+
+	commits, err = parseHistory("")
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
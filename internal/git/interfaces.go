@@ -8,6 +8,7 @@ type BasicGitClient interface {
 	Exec(ctx context.Context, args ...string) (string, error)
 	CurrentBranch(ctx context.Context) (string, error)
 	CreateBranch(ctx context.Context, name string) error
+	CreateBranchFrom(ctx context.Context, name, startPoint string) error
 	BranchExists(ctx context.Context, branch string) (bool, error)
 	HasUncommittedChanges(ctx context.Context) (bool, error)
 	Add(ctx context.Context, files ...string) error
@@ -25,6 +26,7 @@ type WorktreeClient interface {
 	// Worktree-specific operations
 	ListWorktrees(ctx context.Context) ([]WorktreeInfo, error)
 	AddWorktree(ctx context.Context, path, branch string) error
+	AddWorktreeFrom(ctx context.Context, path, branch, startPoint string) error
 	RemoveWorktree(ctx context.Context, path string) error
 	PruneWorktrees(ctx context.Context) error
 	FindWorktreeByBranch(ctx context.Context, branch string) (*WorktreeInfo, error)
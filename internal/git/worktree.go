@@ -73,6 +73,12 @@ func (g *Git) ListWorktrees(ctx context.Context) ([]WorktreeInfo, error) {
 
 // AddWorktree creates a new worktree
 func (g *Git) AddWorktree(ctx context.Context, path, branch string) error {
+	return g.AddWorktreeFrom(ctx, path, branch, "")
+}
+
+// AddWorktreeFrom creates a new worktree for branch. A branch that does not
+// exist yet is created at startPoint, or at HEAD when startPoint is empty.
+func (g *Git) AddWorktreeFrom(ctx context.Context, path, branch, startPoint string) error {
 	// Create parent directory if needed
 	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return ticketerrors.NewWorktreeError("create", path, fmt.Errorf("failed to create worktree directory: %w", err))
@@ -123,7 +129,11 @@ func (g *Git) AddWorktree(ctx context.Context, path, branch string) error {
 		_, err = g.Exec(ctx, SubcmdWorktree, WorktreeAdd, path, branch)
 	} else {
 		// Branch doesn't exist, create it with -b flag
-		_, err = g.Exec(ctx, SubcmdWorktree, WorktreeAdd, path, FlagBranch, branch)
+		args := []string{SubcmdWorktree, WorktreeAdd, path, FlagBranch, branch}
+		if startPoint != "" {
+			args = append(args, startPoint)
+		}
+		_, err = g.Exec(ctx, args...)
 	}
 
 	return err
@@ -300,3 +300,33 @@ func TestAddWorktreeWithNewBranch(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "new-branch", branch)
 }
+
+func TestAddWorktreeFromStartPoint(t *testing.T) {
+	t.Parallel()
+	git, tmpDir := setupTestGitRepo(t)
+	ctx := context.Background()
+
+	// Remember the initial commit, then move HEAD past it
+	base, err := git.GetBranchCommit(ctx, "HEAD")
+	require.NoError(t, err)
+	_, err = git.Exec(ctx, "tag", "base")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "later.txt"), []byte("later\n"), 0644))
+	require.NoError(t, git.Add(ctx, "later.txt"))
+	require.NoError(t, git.Commit(ctx, "Later commit"))
+
+	worktreePath := filepath.Join(tmpDir, ".worktrees", "from-base")
+	require.NoError(t, git.AddWorktreeFrom(ctx, worktreePath, "from-base", "base"))
+
+	commit, err := git.GetBranchCommit(ctx, "from-base")
+	require.NoError(t, err)
+	assert.Equal(t, base, commit)
+	assert.NoFileExists(t, filepath.Join(worktreePath, "later.txt"))
+
+	// CreateBranchFrom checks out the new branch at the start point too
+	require.NoError(t, git.CreateBranchFrom(ctx, "also-from-base", "base"))
+	branch, err := git.CurrentBranch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "also-from-base", branch)
+	assert.NoFileExists(t, filepath.Join(tmpDir, "later.txt"))
+}
@@ -34,6 +34,12 @@ func (m *MockGitClient) CreateBranch(ctx context.Context, name string) error {
 	return args.Error(0)
 }
 
+// CreateBranchFrom creates a new git branch at a start point
+func (m *MockGitClient) CreateBranchFrom(ctx context.Context, name, startPoint string) error {
+	args := m.Called(ctx, name, startPoint)
+	return args.Error(0)
+}
+
 // HasUncommittedChanges checks if there are uncommitted changes
 func (m *MockGitClient) HasUncommittedChanges(ctx context.Context) (bool, error) {
 	args := m.Called(ctx)
@@ -101,6 +107,12 @@ func (m *MockGitClient) AddWorktree(ctx context.Context, path, branch string) er
 	return args.Error(0)
 }
 
+// AddWorktreeFrom creates a new worktree whose branch starts at a start point
+func (m *MockGitClient) AddWorktreeFrom(ctx context.Context, path, branch, startPoint string) error {
+	args := m.Called(ctx, path, branch, startPoint)
+	return args.Error(0)
+}
+
 // RemoveWorktree removes a worktree
 func (m *MockGitClient) RemoveWorktree(ctx context.Context, path string) error {
 	args := m.Called(ctx, path)
@@ -39,6 +39,15 @@ func (m *MockTicketManager) List(ctx context.Context, statusFilter ticket.Status
 	return args.Get(0).([]ticket.Ticket), args.Error(1)
 }
 
+// Search returns the tickets matching the searcher
+func (m *MockTicketManager) Search(ctx context.Context, statusFilter ticket.StatusFilter, searcher *ticket.Searcher) ([]ticket.SearchResult, error) {
+	args := m.Called(ctx, statusFilter, searcher)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]ticket.SearchResult), args.Error(1)
+}
+
 // Update updates an existing ticket
 func (m *MockTicketManager) Update(ctx context.Context, t *ticket.Ticket) error {
 	args := m.Called(ctx, t)
@@ -0,0 +1,164 @@
+package ticket
+
+import (
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// knownFrontmatterKeys are the frontmatter keys backed by Ticket fields.
+// Any other key is preserved as-is when the ticket is written back.
+var knownFrontmatterKeys = frontmatterKeys(reflect.TypeOf(Ticket{}))
+
+// frontmatterKeys returns the YAML keys of the exported fields of typ
+func frontmatterKeys(typ reflect.Type) map[string]bool {
+	keys := make(map[string]bool)
+	for i := 0; i < typ.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("yaml")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		keys[name] = true
+	}
+	return keys
+}
+
+// parseFrontmatter decodes the frontmatter into the ticket and returns the
+// mapping node it came from, so that the original document can be written back
+func parseFrontmatter(data []byte, t *Ticket) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		// Empty frontmatter
+		return nil, nil
+	}
+	if err := doc.Decode(t); err != nil {
+		return nil, err
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	return &doc, nil
+}
+
+// mergeFrontmatter returns a copy of the original frontmatter document with
+// the ticket's fields written over it. Unknown keys, comments, key order and
+// the formatting of unchanged values are kept; known keys that the ticket no
+// longer sets are removed.
+func mergeFrontmatter(original *yaml.Node, t *Ticket) (*yaml.Node, error) {
+	var fresh yaml.Node
+	if err := fresh.Encode(t); err != nil {
+		return nil, err
+	}
+
+	doc := cloneNode(original)
+	mapping := doc.Content[0]
+
+	values := make(map[string]*yaml.Node, len(fresh.Content)/2)
+	var order []string
+	for i := 0; i+1 < len(fresh.Content); i += 2 {
+		key := fresh.Content[i].Value
+		values[key] = fresh.Content[i+1]
+		order = append(order, key)
+	}
+
+	merged := make([]*yaml.Node, 0, len(mapping.Content)+len(fresh.Content))
+	seen := make(map[string]bool, len(values))
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key, value := mapping.Content[i], mapping.Content[i+1]
+		if !knownFrontmatterKeys[key.Value] {
+			merged = append(merged, key, value)
+			continue
+		}
+
+		updated, ok := values[key.Value]
+		if !ok || seen[key.Value] {
+			// Cleared by omitempty, or a duplicate key
+			continue
+		}
+		seen[key.Value] = true
+		if !sameNodeValue(value, updated) {
+			updated.HeadComment = value.HeadComment
+			updated.LineComment = value.LineComment
+			updated.FootComment = value.FootComment
+			value = updated
+		}
+		merged = append(merged, key, value)
+	}
+
+	// Append fields the original frontmatter did not have
+	for i, key := range order {
+		if seen[key] {
+			continue
+		}
+		merged = append(merged, fresh.Content[2*i], values[key])
+	}
+
+	mapping.Content = merged
+	return doc, nil
+}
+
+// extraFrontmatter decodes the frontmatter keys that are not backed by Ticket fields
+func extraFrontmatter(doc *yaml.Node) map[string]interface{} {
+	extra := make(map[string]interface{})
+	if doc == nil {
+		return extra
+	}
+
+	mapping := doc.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i].Value
+		if knownFrontmatterKeys[key] {
+			continue
+		}
+		var value interface{}
+		if err := mapping.Content[i+1].Decode(&value); err != nil {
+			// Keep undecodable values visible as their raw text
+			value = mapping.Content[i+1].Value
+		}
+		extra[key] = value
+	}
+	return extra
+}
+
+// sameNodeValue reports whether two nodes hold the same data, ignoring style and comments
+func sameNodeValue(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind {
+		return false
+	}
+	if a.Kind == yaml.ScalarNode {
+		if a.ShortTag() == "!!null" || b.ShortTag() == "!!null" {
+			return a.ShortTag() == b.ShortTag()
+		}
+		return a.Value == b.Value
+	}
+	if len(a.Content) != len(b.Content) {
+		return false
+	}
+	for i := range a.Content {
+		if !sameNodeValue(a.Content[i], b.Content[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// cloneNode returns a deep copy of a YAML node.
+// Aliases are not followed; their targets are shared with the original.
+func cloneNode(n *yaml.Node) *yaml.Node {
+	if n == nil {
+		return nil
+	}
+	c := *n
+	if n.Content != nil {
+		c.Content = make([]*yaml.Node, len(n.Content))
+		for i, child := range n.Content {
+			c.Content[i] = cloneNode(child)
+		}
+	}
+	return &c
+}
@@ -0,0 +1,283 @@
+package ticket
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+const (
+	// indexVersion is bumped whenever the layout of the cached entries changes.
+	// Index files written by another version are discarded.
+	indexVersion = 2
+
+	// indexRacyWindow is how long after a file's mtime an entry must have been
+	// cached to be trusted. A file rewritten within the filesystem's timestamp
+	// granularity can keep its size and mtime, so entries cached that soon
+	// after the write are re-parsed instead.
+	indexRacyWindow = 2 * time.Second
+)
+
+// Index is an on-disk cache of parsed tickets, keyed by file path and
+// validated against the file's size and modification time. Unchanged
+// tickets are loaded from the index instead of being parsed again.
+type Index struct {
+	path string
+
+	mu      sync.Mutex
+	loaded  bool
+	dirty   bool
+	entries map[string]*indexEntry
+}
+
+// indexFile is the gob-encoded layout of the index file
+type indexFile struct {
+	Version int
+	Entries map[string]*indexEntry
+}
+
+// indexEntry is a cached ticket and the file state it was parsed from
+type indexEntry struct {
+	Size     int64
+	ModTime  int64 // Unix nanoseconds
+	CachedAt int64 // Unix nanoseconds
+	Ticket   indexedTicket
+}
+
+// indexedTicket holds the parsed fields of a ticket file.
+// Computed fields such as the ID and state are derived from the path on load.
+type indexedTicket struct {
+	Priority      int
+	Description   string
+	CreatedAt     time.Time
+	StartedAt     *time.Time
+	ClosedAt      *time.Time
+	ClosureReason string
+	Related       []string
+	Tags          []string
+	Branch        string
+	Content       string
+	// gob does not distinguish empty slices from nil ones, so empty lists
+	// such as "tags: []" are recorded separately
+	EmptyRelated bool
+	EmptyTags    bool
+	ContentLine  int
+	Frontmatter  []byte
+}
+
+// NewIndex returns an index stored at path. The file is read on first use
+// and created by Save; a missing or unreadable file starts an empty index.
+func NewIndex(path string) *Index {
+	return &Index{path: path}
+}
+
+// Path returns the location of the index file
+func (ix *Index) Path() string {
+	return ix.path
+}
+
+// load reads the index file once. The caller must hold ix.mu.
+func (ix *Index) load() {
+	if ix.loaded {
+		return
+	}
+	ix.loaded = true
+	ix.entries = make(map[string]*indexEntry)
+
+	data, err := os.ReadFile(ix.path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Ignoring unreadable ticket index", "path", ix.path, "error", err)
+		}
+		return
+	}
+
+	var file indexFile
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&file); err != nil {
+		log.Debug("Ignoring corrupt ticket index", "path", ix.path, "error", err)
+		ix.dirty = true
+		return
+	}
+	if file.Version != indexVersion {
+		log.Debug("Ignoring ticket index from another version",
+			"path", ix.path,
+			"version", file.Version,
+			"expected", indexVersion)
+		ix.dirty = true
+		return
+	}
+	if file.Entries != nil {
+		ix.entries = file.Entries
+	}
+}
+
+// get returns the cached ticket for path if the file is unchanged since it was cached
+func (ix *Index) get(path string, info os.FileInfo) (*Ticket, bool) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.load()
+
+	entry, ok := ix.entries[path]
+	if !ok || !entry.matches(info) {
+		return nil, false
+	}
+	return entry.Ticket.toTicket(), true
+}
+
+// put caches a ticket parsed from the file described by info
+func (ix *Index) put(path string, info os.FileInfo, t *Ticket) {
+	now := time.Now()
+	entry := &indexEntry{
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UnixNano(),
+		CachedAt: now.UnixNano(),
+		Ticket:   newIndexedTicket(t),
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.load()
+	ix.entries[path] = entry
+	ix.dirty = true
+}
+
+// prune drops the entries for files in dirs that are not in present
+func (ix *Index) prune(dirs []string, present map[string]bool) {
+	listed := make(map[string]bool, len(dirs))
+	for _, dir := range dirs {
+		listed[filepath.Clean(dir)] = true
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.load()
+	for path := range ix.entries {
+		if listed[filepath.Dir(path)] && !present[path] {
+			delete(ix.entries, path)
+			ix.dirty = true
+		}
+	}
+}
+
+// Len returns the number of cached tickets
+func (ix *Index) Len() int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.load()
+	return len(ix.entries)
+}
+
+// Save writes the index to disk if it changed since it was loaded.
+// The file is replaced atomically, so concurrent readers see either the old
+// or the new index.
+func (ix *Index) Save() error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	if !ix.dirty {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(indexFile{Version: indexVersion, Entries: ix.entries}); err != nil {
+		return fmt.Errorf("failed to encode ticket index: %w", err)
+	}
+
+	dir := filepath.Dir(ix.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create index directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(ix.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create ticket index: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name()) // No-op once renamed
+	}()
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write ticket index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write ticket index: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), ix.path); err != nil {
+		return fmt.Errorf("failed to write ticket index: %w", err)
+	}
+
+	ix.dirty = false
+	return nil
+}
+
+// matches reports whether the entry is still valid for the file described by info
+func (e *indexEntry) matches(info os.FileInfo) bool {
+	if e.Size != info.Size() || e.ModTime != info.ModTime().UnixNano() {
+		return false
+	}
+	return time.Duration(e.CachedAt-e.ModTime) > indexRacyWindow
+}
+
+// newIndexedTicket captures the parsed fields of t
+func newIndexedTicket(t *Ticket) indexedTicket {
+	return indexedTicket{
+		Priority:      t.Priority,
+		Description:   t.Description,
+		CreatedAt:     t.CreatedAt.Time,
+		StartedAt:     t.StartedAt.Time,
+		ClosedAt:      t.ClosedAt.Time,
+		ClosureReason: t.ClosureReason,
+		Related:       t.Related,
+		Tags:          t.Tags,
+		Branch:        t.Branch,
+		Content:       t.Content,
+		EmptyRelated:  t.Related != nil && len(t.Related) == 0,
+		EmptyTags:     t.Tags != nil && len(t.Tags) == 0,
+		ContentLine:   t.contentLine,
+		Frontmatter:   t.rawFrontmatter,
+	}
+}
+
+// toTicket returns a new ticket with the cached fields
+func (c *indexedTicket) toTicket() *Ticket {
+	return &Ticket{
+		Priority:       c.Priority,
+		Description:    c.Description,
+		CreatedAt:      NewRFC3339Time(c.CreatedAt),
+		StartedAt:      NewRFC3339TimePtr(copyTime(c.StartedAt)),
+		ClosedAt:       NewRFC3339TimePtr(copyTime(c.ClosedAt)),
+		ClosureReason:  c.ClosureReason,
+		Related:        copyStrings(c.Related, c.EmptyRelated),
+		Tags:           copyStrings(c.Tags, c.EmptyTags),
+		Branch:         c.Branch,
+		Content:        c.Content,
+		contentLine:    c.ContentLine,
+		rawFrontmatter: c.Frontmatter,
+	}
+}
+
+// copyTime returns a copy of t so cached entries are never mutated through a ticket
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// copyStrings returns a copy of s, or an empty non-nil slice if empty is set
+func copyStrings(s []string, empty bool) []string {
+	if len(s) == 0 {
+		if empty {
+			return []string{}
+		}
+		return nil
+	}
+	return append([]string(nil), s...)
+}
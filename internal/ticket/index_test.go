@@ -0,0 +1,226 @@
+package ticket
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeAgedTicket writes a ticket file with an old mtime, so the index trusts it
+func writeAgedTicket(t *testing.T, path, content string, mtime time.Time) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	require.NoError(t, os.Chtimes(path, mtime, mtime))
+}
+
+const indexTestTicket = `---
+priority: 2
+description: Cached ticket
+created_at: "2025-01-01T10:00:00Z"
+started_at: null
+closed_at: null
+tags: [backend]
+branch: feature/cached
+owner: alice # kept by other tools
+---
+
+# Cached
+
+Body text
+`
+
+func TestIndexGetPut(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "250101-100000-cached.md")
+	writeAgedTicket(t, path, indexTestTicket, time.Now().Add(-time.Hour))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	parsed, err := Parse([]byte(indexTestTicket))
+	require.NoError(t, err)
+
+	ix := NewIndex(filepath.Join(dir, "index", "index.gob"))
+	_, ok := ix.get(path, info)
+	assert.False(t, ok)
+
+	ix.put(path, info, parsed)
+	cached, ok := ix.get(path, info)
+	require.True(t, ok)
+	assert.Equal(t, 2, cached.Priority)
+	assert.Equal(t, "Cached ticket", cached.Description)
+	assert.Equal(t, []string{"backend"}, cached.Tags)
+	assert.Equal(t, "feature/cached", cached.Branch)
+	assert.Equal(t, parsed.Content, cached.Content)
+	assert.Equal(t, parsed.contentLine, cached.contentLine)
+	assert.True(t, parsed.CreatedAt.Equal(cached.CreatedAt.Time))
+	assert.Nil(t, cached.StartedAt.Time)
+	assert.Equal(t, map[string]interface{}{"owner": "alice"}, cached.Extra())
+
+	// Mutating a cached ticket must not change the entry
+	cached.Tags[0] = "frontend"
+	again, ok := ix.get(path, info)
+	require.True(t, ok)
+	assert.Equal(t, []string{"backend"}, again.Tags)
+}
+
+func TestIndexInvalidation(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "250101-100000-cached.md")
+	mtime := time.Now().Add(-time.Hour)
+	writeAgedTicket(t, path, indexTestTicket, mtime)
+	parsed, err := Parse([]byte(indexTestTicket))
+	require.NoError(t, err)
+
+	ix := NewIndex(filepath.Join(dir, "index.gob"))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	ix.put(path, info, parsed)
+
+	t.Run("modification time changed", func(t *testing.T) {
+		newer := mtime.Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, newer, newer))
+		changed, err := os.Stat(path)
+		require.NoError(t, err)
+		_, ok := ix.get(path, changed)
+		assert.False(t, ok)
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	})
+
+	t.Run("size changed", func(t *testing.T) {
+		writeAgedTicket(t, path, indexTestTicket+"More\n", mtime)
+		changed, err := os.Stat(path)
+		require.NoError(t, err)
+		_, ok := ix.get(path, changed)
+		assert.False(t, ok)
+	})
+
+	t.Run("recently modified files are not trusted", func(t *testing.T) {
+		writeAgedTicket(t, path, indexTestTicket, time.Now())
+		fresh, err := os.Stat(path)
+		require.NoError(t, err)
+		ix.put(path, fresh, parsed)
+		_, ok := ix.get(path, fresh)
+		assert.False(t, ok)
+	})
+}
+
+func TestIndexSaveAndLoad(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "250101-100000-cached.md")
+	writeAgedTicket(t, path, indexTestTicket, time.Now().Add(-time.Hour))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	parsed, err := Parse([]byte(indexTestTicket))
+	require.NoError(t, err)
+
+	indexPath := filepath.Join(dir, "ticketflow", "index.gob")
+	ix := NewIndex(indexPath)
+	ix.put(path, info, parsed)
+	require.NoError(t, ix.Save())
+
+	reloaded := NewIndex(indexPath)
+	assert.Equal(t, 1, reloaded.Len())
+	cached, ok := reloaded.get(path, info)
+	require.True(t, ok)
+	assert.Equal(t, "Cached ticket", cached.Description)
+
+	t.Run("corrupt index starts empty", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(indexPath, []byte("not an index"), 0644))
+		corrupt := NewIndex(indexPath)
+		assert.Equal(t, 0, corrupt.Len())
+
+		// The broken file is replaced on the next save
+		require.NoError(t, corrupt.Save())
+		assert.Equal(t, 0, NewIndex(indexPath).Len())
+	})
+}
+
+func TestIndexPrune(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	todo := filepath.Join(dir, "todo")
+	done := filepath.Join(dir, "done")
+	kept := filepath.Join(todo, "250101-100000-kept.md")
+	removed := filepath.Join(todo, "250101-100000-removed.md")
+	other := filepath.Join(done, "250101-100000-other.md")
+	mtime := time.Now().Add(-time.Hour)
+	for _, path := range []string{kept, removed, other} {
+		writeAgedTicket(t, path, indexTestTicket, mtime)
+	}
+	info, err := os.Stat(kept)
+	require.NoError(t, err)
+	parsed, err := Parse([]byte(indexTestTicket))
+	require.NoError(t, err)
+
+	ix := NewIndex(filepath.Join(dir, "index.gob"))
+	for _, path := range []string{kept, removed, other} {
+		ix.put(path, info, parsed)
+	}
+
+	// Only entries in the listed directories are pruned
+	ix.prune([]string{todo}, map[string]bool{kept: true})
+	assert.Equal(t, 2, ix.Len())
+	_, ok := ix.get(removed, info)
+	assert.False(t, ok)
+	_, ok = ix.get(other, info)
+	assert.True(t, ok)
+}
+
+func TestManagerListWithIndex(t *testing.T) {
+	t.Parallel()
+	manager, tmpDir := setupTestManager(t)
+	ctx := context.Background()
+
+	todoDir := manager.config.GetTodoPath(tmpDir)
+	mtime := time.Now().Add(-time.Hour)
+	path := filepath.Join(todoDir, "250101-100000-cached.md")
+	writeAgedTicket(t, path, indexTestTicket, mtime)
+	writeAgedTicket(t, filepath.Join(todoDir, "250101-110000-gone.md"), indexTestTicket, mtime)
+
+	indexPath := filepath.Join(tmpDir, ".git", "ticketflow", "index.gob")
+	manager.SetIndex(NewIndex(indexPath))
+
+	tickets, err := manager.List(ctx, StatusFilterAll)
+	require.NoError(t, err)
+	require.Len(t, tickets, 2)
+	assert.FileExists(t, indexPath)
+	assert.Equal(t, 2, NewIndex(indexPath).Len())
+
+	// A fresh manager reads unchanged tickets from the index file, with
+	// computed fields derived from the path as usual
+	fresh := NewManager(manager.config, tmpDir)
+	fresh.SetIndex(NewIndex(indexPath))
+	tickets, err = fresh.List(ctx, StatusFilterTodo)
+	require.NoError(t, err)
+	require.Len(t, tickets, 2)
+	for _, tk := range tickets {
+		assert.Nil(t, tk.frontmatter, "ticket should come from the index")
+		assert.Equal(t, StatusTodo, tk.State())
+		assert.Equal(t, tk.ID, ExtractIDFromFilename(filepath.Base(tk.Path)))
+	}
+
+	// Cached tickets keep unknown keys and comments when written back
+	data, err := tickets[0].ToBytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "owner: alice # kept by other tools")
+
+	// Edits and deletions are picked up
+	edited := indexTestTicket + "\nEdited\n"
+	writeAgedTicket(t, path, edited, mtime.Add(time.Minute))
+	require.NoError(t, os.Remove(filepath.Join(todoDir, "250101-110000-gone.md")))
+
+	tickets, err = fresh.List(ctx, StatusFilterAll)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Contains(t, tickets[0].Content, "Edited")
+	assert.Equal(t, 1, NewIndex(indexPath).Len())
+}
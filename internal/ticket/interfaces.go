@@ -13,6 +13,9 @@ type TicketManager interface {
 	// List returns tickets based on the status filter
 	List(ctx context.Context, statusFilter StatusFilter) ([]Ticket, error)
 
+	// Search returns the tickets matching the searcher, best match first
+	Search(ctx context.Context, statusFilter StatusFilter, searcher *Searcher) ([]SearchResult, error)
+
 	// Update updates an existing ticket
 	Update(ctx context.Context, ticket *Ticket) error
 
@@ -26,11 +26,14 @@ type StatusFilter string
 
 // Status filter constants for List method
 const (
-	StatusFilterAll    StatusFilter = "all"    // Include all tickets (todo, doing, done)
-	StatusFilterActive StatusFilter = "active" // Include only active tickets (todo, doing)
+	StatusFilterAll    StatusFilter = "all"    // Include tickets in every workflow state
+	StatusFilterActive StatusFilter = "active" // Include only tickets in active states (todo, doing)
 	StatusFilterTodo   StatusFilter = "todo"   // Include only todo tickets
 	StatusFilterDoing  StatusFilter = "doing"  // Include only doing tickets
 	StatusFilterDone   StatusFilter = "done"   // Include only done tickets
+
+	StatusFilterCancelled StatusFilter = "cancelled" // Include only cancelled tickets
+	StatusFilterArchived  StatusFilter = "archived"  // Include only archived tickets
 )
 
 const (
@@ -55,6 +58,8 @@ const (
 type Manager struct {
 	config      *config.Config
 	projectRoot string
+	// index caches parsed tickets between runs; nil disables caching
+	index *Index
 }
 
 // NewManager creates a new ticket manager
@@ -65,6 +70,12 @@ func NewManager(cfg *config.Config, projectRoot string) *Manager {
 	}
 }
 
+// SetIndex makes the manager load unchanged tickets from the index instead of
+// parsing them. Passing nil disables the cache.
+func (m *Manager) SetIndex(index *Index) {
+	m.index = index
+}
+
 // calculateOptimalWorkers determines the optimal number of workers for concurrent operations
 // based on available CPUs and the number of files to process
 func calculateOptimalWorkers(numCPU, fileCount int) int {
@@ -158,43 +169,55 @@ func (m *Manager) List(ctx context.Context, statusFilter StatusFilter) ([]Ticket
 		return nil, fmt.Errorf("invalid status filter: %s", statusFilter)
 	}
 
-	// Count total files first to better estimate capacity
-	totalFiles := 0
-	for _, dir := range dirs {
-		entries, err := os.ReadDir(dir)
-		if err != nil {
-			// Skip directories that don't exist or can't be read
-			continue
-		}
-		for _, entry := range entries {
-			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
-				totalFiles++
-			}
-		}
+	ticketPaths, err := m.ticketFiles(dirs)
+	if err != nil {
+		return nil, err
 	}
+	defer m.saveIndex(dirs, ticketPaths)
 
 	// Use concurrent loading if we have enough files to benefit from it
-	if totalFiles >= concurrencyThreshold {
+	if len(ticketPaths) >= concurrencyThreshold {
 		log.Debug("Using concurrent loading strategy",
-			"totalFiles", totalFiles,
+			"totalFiles", len(ticketPaths),
 			"threshold", concurrencyThreshold)
-		return m.listConcurrent(ctx, dirs)
+		return m.listConcurrent(ctx, ticketPaths)
 	}
 
 	// Fall back to sequential for small numbers of tickets
 	log.Debug("Using sequential loading strategy",
-		"totalFiles", totalFiles,
+		"totalFiles", len(ticketPaths),
 		"threshold", concurrencyThreshold)
-	return m.listSequential(ctx, dirs)
+	return m.listSequential(ctx, ticketPaths)
 }
 
-// listSequential lists tickets sequentially (original implementation)
-func (m *Manager) listSequential(ctx context.Context, dirs []string) ([]Ticket, error) {
-	startTime := time.Now()
+// Search returns the tickets matching the searcher, best match first.
+// Searching reads every ticket in scope, so tickets are always loaded concurrently.
+func (m *Manager) Search(ctx context.Context, statusFilter StatusFilter, searcher *Searcher) ([]SearchResult, error) {
+	// Check context
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("operation cancelled: %w", err)
+	}
+	dirs := m.getDirectoriesForStatus(statusFilter)
+	if dirs == nil {
+		return nil, fmt.Errorf("invalid status filter: %s", statusFilter)
+	}
+
+	ticketPaths, err := m.ticketFiles(dirs)
+	if err != nil {
+		return nil, err
+	}
+	defer m.saveIndex(dirs, ticketPaths)
+
+	tickets, err := m.listConcurrent(ctx, ticketPaths)
+	if err != nil {
+		return nil, err
+	}
+	return searcher.Search(tickets), nil
+}
 
-	// Pre-allocate tickets slice with reasonable capacity based on typical usage
-	// This avoids multiple reallocations during append operations without double-reading directories
-	tickets := make([]Ticket, 0, initialTicketCapacity)
+// ticketFiles returns the ticket files in dirs. Missing directories are skipped.
+func (m *Manager) ticketFiles(dirs []string) ([]string, error) {
+	ticketPaths := make([]string, 0, initialTicketCapacity)
 	for _, dir := range dirs {
 		entries, err := os.ReadDir(dir)
 		if err != nil {
@@ -205,26 +228,50 @@ func (m *Manager) listSequential(ctx context.Context, dirs []string) ([]Ticket,
 		}
 
 		for _, entry := range entries {
-			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
-				continue
+			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
+				ticketPaths = append(ticketPaths, filepath.Join(dir, entry.Name()))
 			}
+		}
+	}
+	return ticketPaths, nil
+}
 
-			ticketPath := filepath.Join(dir, entry.Name())
-			// Check context in loop
-			if err := ctx.Err(); err != nil {
-				return nil, fmt.Errorf("operation cancelled: %w", err)
-			}
-			ticket, err := m.loadTicket(ctx, ticketPath)
-			if err != nil {
-				// Skip invalid tickets
-				log.Debug("Skipping invalid ticket",
-					"path", ticketPath,
-					"error", err)
-				continue
-			}
+// saveIndex drops index entries for tickets no longer in dirs and writes the
+// index if it changed. The cache is best effort, so failures are only logged.
+func (m *Manager) saveIndex(dirs []string, ticketPaths []string) {
+	if m.index == nil {
+		return
+	}
+	present := make(map[string]bool, len(ticketPaths))
+	for _, path := range ticketPaths {
+		present[path] = true
+	}
+	m.index.prune(dirs, present)
+	if err := m.index.Save(); err != nil {
+		log.Warn("Failed to save ticket index", "path", m.index.Path(), "error", err)
+	}
+}
 
-			tickets = append(tickets, *ticket)
+// listSequential loads the given ticket files sequentially
+func (m *Manager) listSequential(ctx context.Context, ticketPaths []string) ([]Ticket, error) {
+	startTime := time.Now()
+
+	tickets := make([]Ticket, 0, len(ticketPaths))
+	for _, ticketPath := range ticketPaths {
+		// Check context in loop
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("operation cancelled: %w", err)
 		}
+		ticket, err := m.loadTicket(ctx, ticketPath)
+		if err != nil {
+			// Skip invalid tickets
+			log.Debug("Skipping invalid ticket",
+				"path", ticketPath,
+				"error", err)
+			continue
+		}
+
+		tickets = append(tickets, *ticket)
 	}
 
 	// Sort by priority first, then by creation time (newest first)
@@ -242,28 +289,10 @@ func (m *Manager) listSequential(ctx context.Context, dirs []string) ([]Ticket,
 	return tickets, nil
 }
 
-// listConcurrent lists tickets using concurrent file operations
-func (m *Manager) listConcurrent(ctx context.Context, dirs []string) ([]Ticket, error) {
+// listConcurrent loads the given ticket files using concurrent file operations
+func (m *Manager) listConcurrent(ctx context.Context, ticketPaths []string) ([]Ticket, error) {
 	startTime := time.Now()
 
-	// Collect all ticket files to process
-	var ticketPaths []string
-	for _, dir := range dirs {
-		entries, err := os.ReadDir(dir)
-		if err != nil {
-			if os.IsNotExist(err) {
-				continue
-			}
-			return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
-		}
-
-		for _, entry := range entries {
-			if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".md") {
-				ticketPaths = append(ticketPaths, filepath.Join(dir, entry.Name()))
-			}
-		}
-	}
-
 	if len(ticketPaths) == 0 {
 		return []Ticket{}, nil
 	}
@@ -342,30 +371,69 @@ func (m *Manager) listConcurrent(ctx context.Context, dirs []string) ([]Ticket,
 	return tickets, nil
 }
 
-// getDirectoriesForStatus returns the directories to search based on status filter
+// getDirectoriesForStatus returns the directories to search based on status filter.
+// Besides "all" and "active", any configured workflow state name is accepted.
 func (m *Manager) getDirectoriesForStatus(statusFilter StatusFilter) []string {
+	states := m.config.GetStates()
+	dirs := make([]string, 0, len(states))
+
 	switch statusFilter {
-	case StatusFilterTodo:
-		return []string{m.config.GetTodoPath(m.projectRoot)}
-	case StatusFilterDoing:
-		return []string{m.config.GetDoingPath(m.projectRoot)}
-	case StatusFilterDone:
-		return []string{m.config.GetDonePath(m.projectRoot)}
-	case StatusFilterActive, "": // Active tickets (todo and doing)
-		return []string{
-			m.config.GetTodoPath(m.projectRoot),
-			m.config.GetDoingPath(m.projectRoot),
-		}
+	case StatusFilterArchived:
+		return m.archiveDirectories()
 	case StatusFilterAll:
-		return []string{
-			m.config.GetTodoPath(m.projectRoot),
-			m.config.GetDoingPath(m.projectRoot),
-			m.config.GetDonePath(m.projectRoot),
+		for _, s := range states {
+			dirs = append(dirs, m.config.GetStatePath(m.projectRoot, s.Name))
+		}
+	case StatusFilterActive, "": // Active states (todo and doing by default)
+		for _, s := range states {
+			if s.Active {
+				dirs = append(dirs, m.config.GetStatePath(m.projectRoot, s.Name))
+			}
 		}
 	default:
-		// Return nil to indicate invalid filter
-		return nil
+		if _, ok := m.config.GetState(string(statusFilter)); !ok {
+			// Return nil to indicate invalid filter
+			return nil
+		}
+		dirs = append(dirs, m.config.GetStatePath(m.projectRoot, string(statusFilter)))
+	}
+
+	return dirs
+}
+
+// archiveDirectories returns the archive directory and its per-year subdirectories
+func (m *Manager) archiveDirectories() []string {
+	archivePath := m.config.GetArchivePath(m.projectRoot)
+	dirs := []string{archivePath}
+
+	entries, err := os.ReadDir(archivePath)
+	if err != nil {
+		// The archive doesn't exist until the first ticket is archived
+		return dirs
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, filepath.Join(archivePath, entry.Name()))
+		}
+	}
+	return dirs
+}
+
+// isArchivePath reports whether the path lies inside the archive directory
+func (m *Manager) isArchivePath(path string) bool {
+	rel, err := filepath.Rel(m.config.GetArchivePath(m.projectRoot), path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// stateForPath returns the workflow state whose directory contains the path
+func (m *Manager) stateForPath(path string) (Status, bool) {
+	dir := filepath.Dir(path)
+	for _, s := range m.config.GetStates() {
+		if filepath.Clean(m.config.GetStatePath(m.projectRoot, s.Name)) == filepath.Clean(dir) {
+			return Status(s.Name), true
+		}
 	}
+	return "", false
 }
 
 // Update updates a ticket
@@ -447,20 +515,20 @@ func (m *Manager) loadTicket(ctx context.Context, path string) (*Ticket, error)
 	if err := ctx.Err(); err != nil {
 		return nil, fmt.Errorf("operation cancelled: %w", err)
 	}
-	data, err := readFileWithContext(ctx, path)
-	if err != nil {
-		return nil, ticketerrors.NewTicketError("read", filepath.Base(path), fmt.Errorf("failed to read ticket file: %w", err))
-	}
-
-	ticket, err := Parse(data)
+	ticket, err := m.parseTicketFile(ctx, path)
 	if err != nil {
-		return nil, ticketerrors.NewTicketError("parse", filepath.Base(path), fmt.Errorf("failed to parse ticket: %w", err))
+		return nil, err
 	}
 
 	// Set computed fields
 	filename := filepath.Base(path)
 	ticket.ID = ExtractIDFromFilename(filename)
 	ticket.Path = path
+	if state, ok := m.stateForPath(path); ok {
+		ticket.SetState(state)
+	} else if m.isArchivePath(path) {
+		ticket.archived = true
+	}
 
 	// Extract slug from ID
 	_, slug, err := ParseID(ticket.ID)
@@ -471,6 +539,37 @@ func (m *Manager) loadTicket(ctx context.Context, path string) (*Ticket, error)
 	return ticket, nil
 }
 
+// parseTicketFile parses a ticket file, or takes it from the index when the
+// file is unchanged since it was cached
+func (m *Manager) parseTicketFile(ctx context.Context, path string) (*Ticket, error) {
+	var info os.FileInfo
+	if m.index != nil {
+		var err error
+		if info, err = os.Stat(path); err == nil {
+			if ticket, ok := m.index.get(path, info); ok {
+				return ticket, nil
+			}
+		}
+	}
+
+	data, err := readFileWithContext(ctx, path)
+	if err != nil {
+		return nil, ticketerrors.NewTicketError("read", filepath.Base(path), fmt.Errorf("failed to read ticket file: %w", err))
+	}
+
+	ticket, err := Parse(data)
+	if err != nil {
+		return nil, ticketerrors.NewTicketError("parse", filepath.Base(path), fmt.Errorf("failed to parse ticket: %w", err))
+	}
+
+	// The file is stat'ed before reading, so a write in between leaves an
+	// entry that no longer matches the file and is re-parsed next time
+	if info != nil {
+		m.index.put(path, info, ticket)
+	}
+	return ticket, nil
+}
+
 // ReadContent reads the content portion of a ticket (without frontmatter)
 func (m *Manager) ReadContent(ctx context.Context, id string) (string, error) {
 	// Check context
@@ -547,12 +646,10 @@ func (m *Manager) FindTicket(ctx context.Context, ticketID string) (string, erro
 	if err := ctx.Err(); err != nil {
 		return "", fmt.Errorf("operation cancelled: %w", err)
 	}
-	// Search in todo -> doing -> done order
-	dirs := []string{
-		m.config.GetTodoPath(m.projectRoot),
-		m.config.GetDoingPath(m.projectRoot),
-		m.config.GetDonePath(m.projectRoot),
-	}
+	// Search in configured state order (todo -> doing -> done by default),
+	// then fall back to the archive so archived tickets still resolve
+	dirs := m.getDirectoriesForStatus(StatusFilterAll)
+	dirs = append(dirs, m.archiveDirectories()...)
 
 	var lastErr error
 	for _, dir := range dirs {
@@ -247,6 +247,173 @@ func TestManagerList(t *testing.T) {
 	assert.Equal(t, ticket2.ID, doingTickets[0].ID)
 }
 
+func TestManagerListCustomStates(t *testing.T) {
+	t.Parallel()
+	tmpDir := t.TempDir()
+	cfg := config.Default()
+	cfg.Tickets.Dir = "tickets"
+	cfg.Tickets.States = []config.StateConfig{
+		{Name: "todo", Dir: "todo", Active: true, Transitions: []string{"doing"}},
+		{Name: "doing", Dir: "doing", Active: true, Transitions: []string{"review"}},
+		{Name: "review", Dir: "in-review", Active: true, Transitions: []string{"done"}},
+		{Name: "blocked", Dir: "blocked", Active: false},
+		{Name: "done", Dir: "done", Active: false},
+	}
+	manager := NewManager(cfg, tmpDir)
+	ctx := context.Background()
+
+	moveTo := func(tk *Ticket, dir string) {
+		target := filepath.Join(tmpDir, "tickets", dir)
+		require.NoError(t, os.MkdirAll(target, 0755))
+		newPath := filepath.Join(target, filepath.Base(tk.Path))
+		require.NoError(t, os.Rename(tk.Path, newPath))
+		tk.Path = newPath
+	}
+
+	todo, err := manager.Create(ctx, "todo-ticket")
+	require.NoError(t, err)
+	review, err := manager.Create(ctx, "review-ticket")
+	require.NoError(t, err)
+	blocked, err := manager.Create(ctx, "blocked-ticket")
+	require.NoError(t, err)
+	moveTo(review, "in-review")
+	moveTo(blocked, "blocked")
+
+	// Active listing covers every active state
+	active, err := manager.List(ctx, StatusFilterActive)
+	require.NoError(t, err)
+	assert.Len(t, active, 2)
+
+	reviewTickets, err := manager.List(ctx, StatusFilter("review"))
+	require.NoError(t, err)
+	require.Len(t, reviewTickets, 1)
+	assert.Equal(t, review.ID, reviewTickets[0].ID)
+	assert.Equal(t, Status("review"), reviewTickets[0].State())
+
+	all, err := manager.List(ctx, StatusFilterAll)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	_, err = manager.List(ctx, StatusFilter("qa"))
+	assert.Error(t, err)
+
+	// Get resolves tickets in custom state directories
+	got, err := manager.Get(ctx, blocked.ID)
+	require.NoError(t, err)
+	assert.Equal(t, Status("blocked"), got.State())
+	assert.Equal(t, StatusTodo, got.Status())
+
+	got, err = manager.Get(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusTodo, got.State())
+}
+
+func TestManagerListCancelled(t *testing.T) {
+	t.Parallel()
+	manager, tmpDir := setupTestManager(t)
+	ctx := context.Background()
+
+	_, err := manager.Create(ctx, "open-ticket")
+	require.NoError(t, err)
+	dropped, err := manager.Create(ctx, "dropped-ticket")
+	require.NoError(t, err)
+
+	require.NoError(t, dropped.CloseWithReason("no longer needed"))
+	cancelledPath := filepath.Join(tmpDir, "tickets", "cancelled")
+	require.NoError(t, os.MkdirAll(cancelledPath, 0755))
+	newPath := filepath.Join(cancelledPath, filepath.Base(dropped.Path))
+	require.NoError(t, os.Rename(dropped.Path, newPath))
+	dropped.Path = newPath
+	require.NoError(t, manager.Update(ctx, dropped))
+
+	// Cancelled tickets are excluded from the default listing
+	active, err := manager.List(ctx, StatusFilterActive)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.NotEqual(t, dropped.ID, active[0].ID)
+
+	cancelled, err := manager.List(ctx, StatusFilterCancelled)
+	require.NoError(t, err)
+	require.Len(t, cancelled, 1)
+	assert.True(t, cancelled[0].IsCancelled())
+	assert.Equal(t, StatusDone, cancelled[0].Status())
+
+	all, err := manager.List(ctx, StatusFilterAll)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	got, err := manager.Get(ctx, dropped.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusCancelled, got.State())
+}
+
+func TestManagerListArchived(t *testing.T) {
+	t.Parallel()
+	manager, tmpDir := setupTestManager(t)
+	ctx := context.Background()
+
+	_, err := manager.Create(ctx, "open-ticket")
+	require.NoError(t, err)
+	old, err := manager.Create(ctx, "old-ticket")
+	require.NoError(t, err)
+
+	require.NoError(t, old.Start())
+	require.NoError(t, old.Close())
+	archivePath := filepath.Join(tmpDir, "tickets", "done", "archive", "2024")
+	require.NoError(t, os.MkdirAll(archivePath, 0755))
+	newPath := filepath.Join(archivePath, filepath.Base(old.Path))
+	require.NoError(t, os.Rename(old.Path, newPath))
+	old.Path = newPath
+	require.NoError(t, manager.Update(ctx, old))
+
+	// Archived tickets are excluded from every regular listing
+	all, err := manager.List(ctx, StatusFilterAll)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.NotEqual(t, old.ID, all[0].ID)
+
+	done, err := manager.List(ctx, StatusFilterDone)
+	require.NoError(t, err)
+	assert.Empty(t, done)
+
+	archived, err := manager.List(ctx, StatusFilterArchived)
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	assert.True(t, archived[0].IsArchived())
+	assert.Equal(t, StatusDone, archived[0].State())
+
+	// Archived tickets still resolve by ID
+	got, err := manager.Get(ctx, old.ID)
+	require.NoError(t, err)
+	assert.Equal(t, newPath, got.Path)
+	assert.True(t, got.IsArchived())
+}
+
+func TestManagerSearch(t *testing.T) {
+	t.Parallel()
+	manager, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	login, err := manager.Create(ctx, "login-page")
+	require.NoError(t, err)
+	require.NoError(t, manager.WriteContent(ctx, login.ID, "# Login\n\nThe login form hangs.\n"))
+	other, err := manager.Create(ctx, "docs")
+	require.NoError(t, err)
+	require.NoError(t, manager.WriteContent(ctx, other.ID, "Mention login once.\n"))
+
+	searcher, err := NewSearcher(SearchOptions{Query: "login"})
+	require.NoError(t, err)
+	results, err := manager.Search(ctx, StatusFilterAll, searcher)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, login.ID, results[0].Ticket.ID)
+	assert.Equal(t, other.ID, results[1].Ticket.ID)
+	assert.Equal(t, []string{SearchFieldContent}, results[1].Fields)
+
+	_, err = manager.Search(ctx, StatusFilter("nope"), searcher)
+	assert.Error(t, err)
+}
+
 func TestManagerUpdate(t *testing.T) {
 	t.Parallel()
 	manager, _ := setupTestManager(t)
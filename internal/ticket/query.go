@@ -0,0 +1,521 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query is a parsed filter expression that can be matched against tickets.
+//
+// An expression is a list of terms combined with "and" (the default when terms
+// are separated by spaces), "or" and "not", grouped with parentheses:
+//
+//	priority<=1 and slug~auth and created>2025-01-01 and has:worktree
+//	(status=todo or status=review) not tag:blocked
+//
+// A term is either "<field><op><value>" or free text, which matches the ID,
+// description, tags and content. Values containing spaces can be quoted.
+type Query struct {
+	source string
+	match  queryMatcher
+}
+
+// queryMatcher reports whether a ticket satisfies a part of a query
+type queryMatcher func(t *Ticket) bool
+
+// Query fields
+const (
+	queryFieldID          = "id"
+	queryFieldSlug        = "slug"
+	queryFieldDescription = "description"
+	queryFieldStatus      = "status"
+	queryFieldPriority    = "priority"
+	queryFieldCreated     = "created"
+	queryFieldStarted     = "started"
+	queryFieldClosed      = "closed"
+	queryFieldTag         = "tag"
+	queryFieldContent     = "content"
+	queryFieldReason      = "reason"
+	queryFieldHas         = "has"
+)
+
+// queryFieldAliases maps alternative field names to their canonical name
+var queryFieldAliases = map[string]string{
+	"desc":  queryFieldDescription,
+	"state": queryFieldStatus,
+	"tags":  queryFieldTag,
+}
+
+// queryHasValues are the properties accepted by "has:<property>"
+var queryHasValues = map[string]queryMatcher{
+	"worktree":  func(t *Ticket) bool { return t.HasWorktree() },
+	"parent":    func(t *Ticket) bool { return len(t.RelationsOfType(RelationParent)) > 0 },
+	"relations": func(t *Ticket) bool { return len(t.Relations()) > 0 },
+	"tags":      func(t *Ticket) bool { return len(t.Tags) > 0 },
+	"tasks":     func(t *Ticket) bool { return t.TaskProgress().Total > 0 },
+	"reason":    func(t *Ticket) bool { return t.ClosureReason != "" },
+}
+
+// queryTermPattern splits a term into field, operator and value.
+// Field names are case-insensitive.
+var queryTermPattern = regexp.MustCompile(`^([a-zA-Z_]+)(<=|>=|!=|!~|=|<|>|~|:)(.*)$`)
+
+// queryDateLayouts are the accepted formats of date values, most specific first
+var queryDateLayouts = []struct {
+	layout string
+	span   time.Duration
+}{
+	{time.RFC3339, time.Second},
+	{"2006-01-02T15:04", time.Minute},
+	{"2006-01-02", 24 * time.Hour},
+}
+
+// ParseQuery parses a filter expression.
+// An empty expression matches every ticket.
+func ParseQuery(expr string) (*Query, error) {
+	tokens, err := tokenizeQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{source: strings.TrimSpace(expr)}
+	if len(tokens) == 0 {
+		q.match = func(*Ticket) bool { return true }
+		return q, nil
+	}
+
+	p := &queryParser{tokens: tokens}
+	q.match, err = p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return q, nil
+}
+
+// Match reports whether the ticket satisfies the query.
+// A nil query matches every ticket.
+func (q *Query) Match(t *Ticket) bool {
+	if q == nil {
+		return true
+	}
+	return q.match(t)
+}
+
+// String returns the expression the query was parsed from
+func (q *Query) String() string {
+	if q == nil {
+		return ""
+	}
+	return q.source
+}
+
+// Filter returns the tickets that satisfy the query, keeping their order
+func (q *Query) Filter(tickets []Ticket) []Ticket {
+	filtered := make([]Ticket, 0, len(tickets))
+	for i := range tickets {
+		if q.Match(&tickets[i]) {
+			filtered = append(filtered, tickets[i])
+		}
+	}
+	return filtered
+}
+
+// queryToken is a word, keyword or parenthesis of a filter expression
+type queryToken struct {
+	text   string
+	quoted bool // The token started with a quote, so it is always free text
+}
+
+// tokenizeQuery splits an expression into tokens.
+// Quotes group text containing spaces or parentheses and are removed.
+func tokenizeQuery(expr string) ([]queryToken, error) {
+	var tokens []queryToken
+	var current strings.Builder
+	inToken := false
+	quoted := false
+	var quote rune
+
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, queryToken{text: current.String(), quoted: quoted})
+		}
+		current.Reset()
+		inToken = false
+		quoted = false
+	}
+
+	for _, r := range expr {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+				continue
+			}
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			if !inToken {
+				quoted = true
+			}
+			inToken = true
+			quote = r
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, queryToken{text: string(r)})
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			inToken = true
+			current.WriteRune(r)
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", expr)
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// queryParser is a recursive descent parser over query tokens
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+// isKeyword reports whether the next token is the given keyword
+func (p *queryParser) isKeyword(keyword string) bool {
+	if p.done() {
+		return false
+	}
+	tok := p.peek()
+	return !tok.quoted && strings.EqualFold(tok.text, keyword)
+}
+
+// parseOr parses terms separated by "or"
+func (p *queryParser) parseOr() (queryMatcher, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(t *Ticket) bool { return l(t) || right(t) }
+	}
+	return left, nil
+}
+
+// parseAnd parses terms separated by "and" or whitespace
+func (p *queryParser) parseAnd() (queryMatcher, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for !p.done() && !p.isKeyword("or") && p.peek().text != ")" {
+		if p.isKeyword("and") {
+			p.pos++
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(t *Ticket) bool { return l(t) && right(t) }
+	}
+	return left, nil
+}
+
+// parseNot parses an optionally negated term
+func (p *queryParser) parseNot() (queryMatcher, error) {
+	if p.isKeyword("not") {
+		p.pos++
+		inner, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(t *Ticket) bool { return !inner(t) }, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary parses a parenthesized expression or a single term
+func (p *queryParser) parsePrimary() (queryMatcher, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	tok := p.peek()
+	p.pos++
+	if tok.quoted {
+		return textMatcher(tok.text), nil
+	}
+
+	switch tok.text {
+	case "(":
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.done() || p.peek().text != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return inner, nil
+	case ")":
+		return nil, fmt.Errorf("unexpected %q", tok.text)
+	}
+	for _, keyword := range []string{"and", "or", "not"} {
+		if strings.EqualFold(tok.text, keyword) {
+			return nil, fmt.Errorf("unexpected %q", tok.text)
+		}
+	}
+
+	return parseQueryTerm(tok.text)
+}
+
+// parseQueryTerm parses a "<field><op><value>" term or free text
+func parseQueryTerm(term string) (queryMatcher, error) {
+	m := queryTermPattern.FindStringSubmatch(term)
+	if m == nil {
+		return textMatcher(term), nil
+	}
+	field, op, value := strings.ToLower(m[1]), m[2], m[3]
+	if canonical, ok := queryFieldAliases[field]; ok {
+		field = canonical
+	}
+	if value == "" {
+		return nil, fmt.Errorf("missing value in %q", term)
+	}
+
+	matcher, err := fieldMatcher(field, op, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid term %q: %w", term, err)
+	}
+	return matcher, nil
+}
+
+// fieldMatcher returns the matcher for a field comparison
+func fieldMatcher(field, op, value string) (queryMatcher, error) {
+	if op == ":" && field != queryFieldHas {
+		op = "="
+	}
+
+	switch field {
+	case queryFieldID:
+		return stringMatcher(op, value, func(t *Ticket) string { return t.ID })
+	case queryFieldSlug:
+		return stringMatcher(op, value, func(t *Ticket) string { return t.Slug })
+	case queryFieldDescription:
+		return stringMatcher(op, value, func(t *Ticket) string { return t.Description })
+	case queryFieldStatus:
+		return stringMatcher(op, value, func(t *Ticket) string { return string(t.State()) })
+	case queryFieldContent:
+		return stringMatcher(op, value, func(t *Ticket) string { return t.Content })
+	case queryFieldReason:
+		return stringMatcher(op, value, func(t *Ticket) string { return t.ClosureReason })
+	case queryFieldPriority:
+		return priorityMatcher(op, value)
+	case queryFieldCreated:
+		return dateMatcher(op, value, func(t *Ticket) *time.Time { return t.CreatedAt.ToTimePtr() })
+	case queryFieldStarted:
+		return dateMatcher(op, value, func(t *Ticket) *time.Time { return t.StartedAt.Time })
+	case queryFieldClosed:
+		return dateMatcher(op, value, func(t *Ticket) *time.Time { return t.ClosedAt.Time })
+	case queryFieldTag:
+		return tagMatcher(op, value)
+	case queryFieldHas:
+		if op != ":" {
+			return nil, fmt.Errorf("use has:<property>")
+		}
+		matcher, ok := queryHasValues[strings.ToLower(value)]
+		if !ok {
+			return nil, fmt.Errorf("unknown property %q, expected one of: %s", value, strings.Join(queryHasNames(), ", "))
+		}
+		return matcher, nil
+	}
+
+	if relType := RelationType(field); relType.IsValid() {
+		return relationMatcher(op, value, relType)
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// textMatcher matches free text against the ID, description, tags and content
+func textMatcher(text string) queryMatcher {
+	text = strings.ToLower(text)
+	return func(t *Ticket) bool {
+		return strings.Contains(strings.ToLower(t.ID), text) ||
+			strings.Contains(strings.ToLower(t.Description), text) ||
+			strings.Contains(strings.Join(t.Tags, " "), text) ||
+			strings.Contains(strings.ToLower(t.Content), text)
+	}
+}
+
+// stringMatcher compares a text field case-insensitively.
+// "=" requires equality, "~" a substring.
+func stringMatcher(op, value string, get func(t *Ticket) string) (queryMatcher, error) {
+	value = strings.ToLower(value)
+	switch op {
+	case "=":
+		return func(t *Ticket) bool { return strings.ToLower(get(t)) == value }, nil
+	case "!=":
+		return func(t *Ticket) bool { return strings.ToLower(get(t)) != value }, nil
+	case "~":
+		return func(t *Ticket) bool { return strings.Contains(strings.ToLower(get(t)), value) }, nil
+	case "!~":
+		return func(t *Ticket) bool { return !strings.Contains(strings.ToLower(get(t)), value) }, nil
+	}
+	return nil, fmt.Errorf("operator %s is not supported for text fields", op)
+}
+
+// priorityMatcher compares the priority numerically
+func priorityMatcher(op, value string) (queryMatcher, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("priority must be a number")
+	}
+	switch op {
+	case "=":
+		return func(t *Ticket) bool { return t.Priority == n }, nil
+	case "!=":
+		return func(t *Ticket) bool { return t.Priority != n }, nil
+	case "<":
+		return func(t *Ticket) bool { return t.Priority < n }, nil
+	case "<=":
+		return func(t *Ticket) bool { return t.Priority <= n }, nil
+	case ">":
+		return func(t *Ticket) bool { return t.Priority > n }, nil
+	case ">=":
+		return func(t *Ticket) bool { return t.Priority >= n }, nil
+	}
+	return nil, fmt.Errorf("operator %s is not supported for priority", op)
+}
+
+// dateMatcher compares a timestamp with a date.
+// A value covers a span of time (a whole day for YYYY-MM-DD): "=" matches
+// within the span, "<" before it and ">" after it. Tickets without the
+// timestamp only match "!=".
+func dateMatcher(op, value string, get func(t *Ticket) *time.Time) (queryMatcher, error) {
+	start, end, err := parseQueryDate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var cmp func(ts time.Time) bool
+	switch op {
+	case "=":
+		cmp = func(ts time.Time) bool { return !ts.Before(start) && ts.Before(end) }
+	case "!=":
+		cmp = func(ts time.Time) bool { return ts.Before(start) || !ts.Before(end) }
+	case "<":
+		cmp = func(ts time.Time) bool { return ts.Before(start) }
+	case "<=":
+		cmp = func(ts time.Time) bool { return ts.Before(end) }
+	case ">":
+		cmp = func(ts time.Time) bool { return !ts.Before(end) }
+	case ">=":
+		cmp = func(ts time.Time) bool { return !ts.Before(start) }
+	default:
+		return nil, fmt.Errorf("operator %s is not supported for dates", op)
+	}
+
+	return func(t *Ticket) bool {
+		ts := get(t)
+		if ts == nil {
+			return op == "!="
+		}
+		return cmp(*ts)
+	}, nil
+}
+
+// parseQueryDate parses a date value into the span of time it covers
+func parseQueryDate(value string) (time.Time, time.Time, error) {
+	for _, f := range queryDateLayouts {
+		if ts, err := time.ParseInLocation(f.layout, value, time.Local); err == nil {
+			return ts, ts.Add(f.span), nil
+		}
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("dates must be YYYY-MM-DD, YYYY-MM-DDTHH:MM or RFC3339")
+}
+
+// tagMatcher matches tickets by tag
+func tagMatcher(op, value string) (queryMatcher, error) {
+	value = NormalizeTag(value)
+	anyTag := func(t *Ticket, match func(tag string) bool) bool {
+		for _, tag := range t.Tags {
+			if match(tag) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch op {
+	case "=":
+		return func(t *Ticket) bool { return t.HasTag(value) }, nil
+	case "!=":
+		return func(t *Ticket) bool { return !t.HasTag(value) }, nil
+	case "~":
+		return func(t *Ticket) bool {
+			return anyTag(t, func(tag string) bool { return strings.Contains(tag, value) })
+		}, nil
+	case "!~":
+		return func(t *Ticket) bool {
+			return !anyTag(t, func(tag string) bool { return strings.Contains(tag, value) })
+		}, nil
+	}
+	return nil, fmt.Errorf("operator %s is not supported for tags", op)
+}
+
+// relationMatcher matches tickets with a relation of the given type to a ticket ID
+func relationMatcher(op, value string, relType RelationType) (queryMatcher, error) {
+	has := func(t *Ticket, match func(id string) bool) bool {
+		for _, id := range t.RelationsOfType(relType) {
+			if match(id) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch op {
+	case "=":
+		return func(t *Ticket) bool { return has(t, func(id string) bool { return id == value }) }, nil
+	case "!=":
+		return func(t *Ticket) bool { return !has(t, func(id string) bool { return id == value }) }, nil
+	case "~":
+		return func(t *Ticket) bool {
+			return has(t, func(id string) bool { return strings.Contains(id, value) })
+		}, nil
+	}
+	return nil, fmt.Errorf("operator %s is not supported for relations", op)
+}
+
+// queryHasNames returns the properties accepted by has: in sorted order
+func queryHasNames() []string {
+	names := make([]string, 0, len(queryHasValues))
+	for name := range queryHasValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,132 @@
+package ticket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func queryTestTickets() []Ticket {
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 12, 0, 0, 0, time.Local) }
+	started := day(5)
+	closed := day(6)
+
+	doing := Ticket{
+		ID: "250102-120000-auth-login", Slug: "auth-login", Priority: 1,
+		Description: "Fix login page", CreatedAt: NewRFC3339Time(day(2)), StartedAt: NewRFC3339TimePtr(&started),
+		Tags: []string{"backend", "security"}, Related: []string{"parent:250101-120000-epic"},
+		Content: "- [ ] Reproduce\n",
+	}
+	done := Ticket{
+		ID: "250103-120000-docs", Slug: "docs", Priority: 3,
+		Description: "Write docs", CreatedAt: NewRFC3339Time(day(3)),
+		StartedAt: NewRFC3339TimePtr(&started), ClosedAt: NewRFC3339TimePtr(&closed),
+		ClosureReason: "Obsolete", Related: []string{"blocks:250102-120000-auth-login"},
+	}
+	todo := Ticket{
+		ID: "250101-120000-epic", Slug: "epic", Priority: 2,
+		Description: "Auth epic", CreatedAt: NewRFC3339Time(day(1)), Tags: []string{"frontend"},
+	}
+	return []Ticket{doing, done, todo}
+}
+
+func TestQueryMatch(t *testing.T) {
+	t.Parallel()
+	tickets := queryTestTickets()
+
+	tests := []struct {
+		expr     string
+		expected []string
+	}{
+		{expr: "", expected: []string{"auth-login", "docs", "epic"}},
+		{expr: "priority<=1", expected: []string{"auth-login"}},
+		{expr: "priority>1 priority!=3", expected: []string{"epic"}},
+		{expr: "slug~auth", expected: []string{"auth-login"}},
+		{expr: "slug=EPIC", expected: []string{"epic"}},
+		{expr: "status=done", expected: []string{"docs"}},
+		{expr: "Status=done", expected: []string{"docs"}},
+		{expr: "PRIORITY<=1 Has:worktree", expected: []string{"auth-login"}},
+		{expr: "state:doing or state:todo", expected: []string{"auth-login", "epic"}},
+		{expr: "created>2025-01-01", expected: []string{"auth-login", "docs"}},
+		{expr: "created=2025-01-02", expected: []string{"auth-login"}},
+		{expr: "created<=2025-01-02", expected: []string{"auth-login", "epic"}},
+		{expr: "closed>=2025-01-06", expected: []string{"docs"}},
+		{expr: "closed!=2025-01-06", expected: []string{"auth-login", "epic"}},
+		{expr: "has:worktree", expected: []string{"auth-login"}},
+		{expr: "has:parent", expected: []string{"auth-login"}},
+		{expr: "has:tasks", expected: []string{"auth-login"}},
+		{expr: "has:reason", expected: []string{"docs"}},
+		{expr: "not has:tags", expected: []string{"docs"}},
+		{expr: "parent:250101-120000-epic", expected: []string{"auth-login"}},
+		{expr: "blocks~auth", expected: []string{"docs"}},
+		{expr: "tag:backend", expected: []string{"auth-login"}},
+		{expr: "tag!=backend", expected: []string{"docs", "epic"}},
+		{expr: "tags~end", expected: []string{"auth-login", "epic"}},
+		{expr: `description~"login page"`, expected: []string{"auth-login"}},
+		{expr: "desc!~auth", expected: []string{"auth-login", "docs"}},
+		{expr: "reason=obsolete", expected: []string{"docs"}},
+		{expr: "content~reproduce", expected: []string{"auth-login"}},
+		{expr: "auth", expected: []string{"auth-login", "epic"}},
+		{expr: "auth docs", expected: []string{}},
+		{expr: `"auth epic"`, expected: []string{"epic"}},
+		{expr: `"or"`, expected: []string{}},
+		{expr: "priority=2 or (tag:backend and not status=done)", expected: []string{"auth-login", "epic"}},
+		{expr: "NOT (priority=1 OR priority=3)", expected: []string{"epic"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			q, err := ParseQuery(tt.expr)
+			require.NoError(t, err)
+
+			matched := []string{}
+			for _, tk := range q.Filter(tickets) {
+				matched = append(matched, tk.Slug)
+			}
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		expr   string
+		errMsg string
+	}{
+		{expr: "priority<=high", errMsg: `invalid term "priority<=high": priority must be a number`},
+		{expr: "size=3", errMsg: `invalid term "size=3": unknown field "size"`},
+		{expr: "Size=3", errMsg: `invalid term "Size=3": unknown field "size"`},
+		{expr: "has:magic", errMsg: `unknown property "magic"`},
+		{expr: "created>yesterday", errMsg: "dates must be YYYY-MM-DD"},
+		{expr: "slug<auth", errMsg: "operator < is not supported for text fields"},
+		{expr: "tag>x", errMsg: "operator > is not supported for tags"},
+		{expr: "priority=", errMsg: `missing value in "priority="`},
+		{expr: "(priority=1", errMsg: "missing closing parenthesis"},
+		{expr: "priority=1)", errMsg: `unexpected ")"`},
+		{expr: "priority=1 and", errMsg: "unexpected end of expression"},
+		{expr: "or priority=1", errMsg: `unexpected "or"`},
+		{expr: `slug~"auth`, errMsg: "unterminated quote"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseQuery(tt.expr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestQueryNil(t *testing.T) {
+	t.Parallel()
+	var q *Query
+	assert.True(t, q.Match(&Ticket{}))
+	assert.Empty(t, q.String())
+
+	q, err := ParseQuery("  slug~auth  ")
+	require.NoError(t, err)
+	assert.Equal(t, "slug~auth", q.String())
+}
@@ -0,0 +1,147 @@
+package ticket
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RelationType represents the kind of relationship between two tickets.
+// Relations are stored in the Related field as "<type>:<ticket-id>".
+type RelationType string
+
+const (
+	RelationParent       RelationType = "parent"
+	RelationBlocks       RelationType = "blocks"
+	RelationBlockedBy    RelationType = "blocked_by"
+	RelationDuplicates   RelationType = "duplicates"
+	RelationDuplicatedBy RelationType = "duplicated_by"
+	RelationRelates      RelationType = "relates"
+)
+
+// relationTypeOrder defines the display order of relation types
+var relationTypeOrder = []RelationType{
+	RelationParent,
+	RelationBlocks,
+	RelationBlockedBy,
+	RelationDuplicates,
+	RelationDuplicatedBy,
+	RelationRelates,
+}
+
+// RelationTypes returns all known relation types in display order
+func RelationTypes() []RelationType {
+	types := make([]RelationType, len(relationTypeOrder))
+	copy(types, relationTypeOrder)
+	return types
+}
+
+// IsValid reports whether the relation type is known
+func (r RelationType) IsValid() bool {
+	for _, known := range relationTypeOrder {
+		if r == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Inverse returns the relation type stored on the other ticket.
+// The parent relation has no stored inverse, so an empty type is returned.
+func (r RelationType) Inverse() RelationType {
+	switch r {
+	case RelationBlocks:
+		return RelationBlockedBy
+	case RelationBlockedBy:
+		return RelationBlocks
+	case RelationDuplicates:
+		return RelationDuplicatedBy
+	case RelationDuplicatedBy:
+		return RelationDuplicates
+	case RelationRelates:
+		return RelationRelates
+	default:
+		return ""
+	}
+}
+
+// Relation is a typed link from a ticket to another ticket
+type Relation struct {
+	Type     RelationType
+	TicketID string
+}
+
+// String returns the relation in its stored "<type>:<ticket-id>" form
+func (r Relation) String() string {
+	return fmt.Sprintf("%s:%s", r.Type, r.TicketID)
+}
+
+// ParseRelation parses a "<type>:<ticket-id>" entry from the Related field.
+// It returns false for entries without a known relation type.
+func ParseRelation(s string) (Relation, bool) {
+	relType, id, found := strings.Cut(s, ":")
+	if !found || id == "" {
+		return Relation{}, false
+	}
+	rel := Relation{Type: RelationType(relType), TicketID: id}
+	if !rel.Type.IsValid() {
+		return Relation{}, false
+	}
+	return rel, true
+}
+
+// Relations returns the typed relations of the ticket.
+// Entries in Related that are not typed relations are skipped.
+func (t *Ticket) Relations() []Relation {
+	relations := make([]Relation, 0, len(t.Related))
+	for _, entry := range t.Related {
+		if rel, ok := ParseRelation(entry); ok {
+			relations = append(relations, rel)
+		}
+	}
+	return relations
+}
+
+// RelationsOfType returns the IDs of tickets linked with the given relation type
+func (t *Ticket) RelationsOfType(relType RelationType) []string {
+	var ids []string
+	for _, rel := range t.Relations() {
+		if rel.Type == relType {
+			ids = append(ids, rel.TicketID)
+		}
+	}
+	return ids
+}
+
+// HasRelation reports whether the ticket has the given relation
+func (t *Ticket) HasRelation(relType RelationType, ticketID string) bool {
+	entry := Relation{Type: relType, TicketID: ticketID}.String()
+	for _, existing := range t.Related {
+		if existing == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// AddRelation adds a typed relation to the ticket.
+// It returns false if the relation already existed.
+func (t *Ticket) AddRelation(relType RelationType, ticketID string) bool {
+	if t.HasRelation(relType, ticketID) {
+		return false
+	}
+	t.Related = append(t.Related, Relation{Type: relType, TicketID: ticketID}.String())
+	return true
+}
+
+// RemoveRelation removes a typed relation from the ticket.
+// It returns false if the relation did not exist.
+func (t *Ticket) RemoveRelation(relType RelationType, ticketID string) bool {
+	entry := Relation{Type: relType, TicketID: ticketID}.String()
+	for i, existing := range t.Related {
+		if existing == entry {
+			t.Related = append(t.Related[:i], t.Related[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package ticket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input    string
+		expected Relation
+		ok       bool
+	}{
+		{"parent:250101-120000-parent", Relation{RelationParent, "250101-120000-parent"}, true},
+		{"blocks:250101-120000-other", Relation{RelationBlocks, "250101-120000-other"}, true},
+		{"blocked_by:abc", Relation{RelationBlockedBy, "abc"}, true},
+		{"duplicates:abc", Relation{RelationDuplicates, "abc"}, true},
+		{"relates:abc", Relation{RelationRelates, "abc"}, true},
+		{"unknown:abc", Relation{}, false},
+		{"blocks:", Relation{}, false},
+		{"250101-120000-plain", Relation{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rel, ok := ParseRelation(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, rel)
+		})
+	}
+}
+
+func TestRelationTypeInverse(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, RelationBlockedBy, RelationBlocks.Inverse())
+	assert.Equal(t, RelationBlocks, RelationBlockedBy.Inverse())
+	assert.Equal(t, RelationDuplicatedBy, RelationDuplicates.Inverse())
+	assert.Equal(t, RelationDuplicates, RelationDuplicatedBy.Inverse())
+	assert.Equal(t, RelationRelates, RelationRelates.Inverse())
+	assert.Equal(t, RelationType(""), RelationParent.Inverse())
+}
+
+func TestTicketRelations(t *testing.T) {
+	t.Parallel()
+	tk := &Ticket{Related: []string{"parent:p1", "legacy-entry"}}
+
+	assert.True(t, tk.AddRelation(RelationBlocks, "b1"))
+	assert.False(t, tk.AddRelation(RelationBlocks, "b1"))
+	assert.True(t, tk.AddRelation(RelationBlocks, "b2"))
+	assert.True(t, tk.HasRelation(RelationBlocks, "b1"))
+
+	assert.Equal(t, []Relation{
+		{RelationParent, "p1"},
+		{RelationBlocks, "b1"},
+		{RelationBlocks, "b2"},
+	}, tk.Relations())
+	assert.Equal(t, []string{"b1", "b2"}, tk.RelationsOfType(RelationBlocks))
+
+	assert.True(t, tk.RemoveRelation(RelationBlocks, "b1"))
+	assert.False(t, tk.RemoveRelation(RelationBlocks, "b1"))
+	assert.Equal(t, []string{"parent:p1", "legacy-entry", "blocks:b2"}, tk.Related)
+}
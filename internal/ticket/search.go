@@ -0,0 +1,145 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Search result ranking weights. An ID match outranks a description match,
+// which outranks any number of matching content lines.
+const (
+	searchScoreID          = 100
+	searchScoreDescription = 10
+	searchMaxContentScore  = 9
+
+	// maxSnippetLength is the longest matched line shown in a snippet, in runes
+	maxSnippetLength = 120
+)
+
+// Search fields
+const (
+	SearchFieldID          = "id"
+	SearchFieldDescription = "description"
+	SearchFieldContent     = "content"
+)
+
+// SearchOptions configures a full-text search
+type SearchOptions struct {
+	Query string
+	Regex bool // Treat Query as a regular expression instead of literal text
+}
+
+// Searcher matches tickets against a case-insensitive search pattern
+type Searcher struct {
+	pattern *regexp.Regexp
+}
+
+// SearchMatch is a content line that matched the search
+type SearchMatch struct {
+	Line int    // Line number in the ticket file, starting at 1
+	Text string // The matched line, shortened around the match if long
+}
+
+// SearchResult is a ticket that matched the search
+type SearchResult struct {
+	Ticket  Ticket
+	Score   int
+	Fields  []string // The fields that matched, in SearchField* order
+	Matches []SearchMatch
+}
+
+// NewSearcher compiles the search options
+func NewSearcher(opts SearchOptions) (*Searcher, error) {
+	if strings.TrimSpace(opts.Query) == "" {
+		return nil, fmt.Errorf("search query cannot be empty")
+	}
+
+	expr := regexp.QuoteMeta(opts.Query)
+	if opts.Regex {
+		expr = opts.Query
+	}
+	pattern, err := regexp.Compile("(?i)" + expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return &Searcher{pattern: pattern}, nil
+}
+
+// Match searches a single ticket
+func (s *Searcher) Match(t *Ticket) (SearchResult, bool) {
+	result := SearchResult{Ticket: *t}
+
+	if s.pattern.MatchString(t.ID) {
+		result.Score += searchScoreID
+		result.Fields = append(result.Fields, SearchFieldID)
+	}
+	if s.pattern.MatchString(t.Description) {
+		result.Score += searchScoreDescription
+		result.Fields = append(result.Fields, SearchFieldDescription)
+	}
+
+	firstLine := t.contentLine
+	if firstLine == 0 {
+		firstLine = 1
+	}
+	for i, line := range strings.Split(t.Content, "\n") {
+		loc := s.pattern.FindStringIndex(line)
+		if loc == nil || loc[0] == loc[1] {
+			continue
+		}
+		result.Matches = append(result.Matches, SearchMatch{
+			Line: firstLine + i,
+			Text: snippet(line, loc[0]),
+		})
+	}
+	if len(result.Matches) > 0 {
+		result.Score += min(len(result.Matches), searchMaxContentScore)
+		result.Fields = append(result.Fields, SearchFieldContent)
+	}
+
+	return result, len(result.Fields) > 0
+}
+
+// Search returns the matching tickets, best match first.
+// Tickets with the same score keep their order.
+func (s *Searcher) Search(tickets []Ticket) []SearchResult {
+	results := make([]SearchResult, 0)
+	for i := range tickets {
+		if result, ok := s.Match(&tickets[i]); ok {
+			results = append(results, result)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results
+}
+
+// snippet trims a matched line and shortens it around the match at byte offset start
+func snippet(line string, start int) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	start -= len(line) - len(trimmed)
+	trimmed = strings.TrimRight(trimmed, " \t\r")
+
+	runes := []rune(trimmed)
+	if len(runes) <= maxSnippetLength {
+		return trimmed
+	}
+
+	// Keep some context before the match
+	start = min(max(start, 0), len(trimmed))
+	from := max(len([]rune(trimmed[:start]))-maxSnippetLength/4, 0)
+	to := min(from+maxSnippetLength, len(runes))
+	from = max(to-maxSnippetLength, 0)
+
+	text := string(runes[from:to])
+	if from > 0 {
+		text = "…" + text
+	}
+	if to < len(runes) {
+		text += "…"
+	}
+	return text
+}
@@ -0,0 +1,86 @@
+package ticket
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSearcher(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSearcher(SearchOptions{Query: "  "})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "search query cannot be empty")
+
+	_, err = NewSearcher(SearchOptions{Query: "login(", Regex: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid regular expression")
+
+	// Literal queries may contain regex metacharacters
+	_, err = NewSearcher(SearchOptions{Query: "login("})
+	assert.NoError(t, err)
+}
+
+func TestSearcherMatch(t *testing.T) {
+	t.Parallel()
+
+	tk, err := Parse([]byte("---\npriority: 2\ndescription: Fix the Login page\ncreated_at: 2025-01-01T10:00:00Z\n---\n\n# Overview\n\nUsers cannot log in.\n  The login form hangs.\n"))
+	require.NoError(t, err)
+	tk.ID = "250101-100000-auth-login"
+
+	s, err := NewSearcher(SearchOptions{Query: "login"})
+	require.NoError(t, err)
+	result, ok := s.Match(tk)
+	require.True(t, ok)
+	assert.Equal(t, []string{SearchFieldID, SearchFieldDescription, SearchFieldContent}, result.Fields)
+	assert.Equal(t, searchScoreID+searchScoreDescription+1, result.Score)
+	assert.Equal(t, []SearchMatch{{Line: 10, Text: "The login form hangs."}}, result.Matches)
+
+	s, err = NewSearcher(SearchOptions{Query: `log\s?in\b`, Regex: true})
+	require.NoError(t, err)
+	result, ok = s.Match(tk)
+	require.True(t, ok)
+	assert.Equal(t, []int{9, 10}, []int{result.Matches[0].Line, result.Matches[1].Line})
+
+	s, err = NewSearcher(SearchOptions{Query: "logout"})
+	require.NoError(t, err)
+	_, ok = s.Match(tk)
+	assert.False(t, ok)
+}
+
+func TestSearcherSearchRanking(t *testing.T) {
+	t.Parallel()
+	tickets := []Ticket{
+		{ID: "a", Content: "cache\ncache\ncache"},
+		{ID: "b", Description: "Cache invalidation"},
+		{ID: "c-cache"},
+		{ID: "d", Content: "cache"},
+		{ID: "e", Content: "nothing"},
+	}
+
+	s, err := NewSearcher(SearchOptions{Query: "cache"})
+	require.NoError(t, err)
+
+	var ids []string
+	for _, r := range s.Search(tickets) {
+		ids = append(ids, r.Ticket.ID)
+	}
+	assert.Equal(t, []string{"c-cache", "b", "a", "d"}, ids)
+}
+
+func TestSnippet(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "short line", snippet("\t short line  ", 2))
+
+	long := strings.Repeat("a", 200) + "MATCH" + strings.Repeat("b", 200)
+	text := snippet(long, 200)
+	assert.Contains(t, text, "MATCH")
+	assert.True(t, strings.HasPrefix(text, "…"))
+	assert.True(t, strings.HasSuffix(text, "…"))
+	assert.Equal(t, maxSnippetLength+2, len([]rune(text)))
+
+	assert.True(t, strings.HasPrefix(snippet(long, 0), "aaa"))
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/yshrsmz/ticketflow/internal/config"
 )
@@ -31,50 +32,123 @@ func BenchmarkManagerList(b *testing.B) {
 			manager := NewManager(cfg, tmpDir)
 			ctx := context.Background()
 
-			// Create ticket directory structure
-			todoDir := filepath.Join(tmpDir, cfg.Tickets.Dir, "todo")
-			doingDir := filepath.Join(tmpDir, cfg.Tickets.Dir, "doing")
-			doneDir := filepath.Join(tmpDir, cfg.Tickets.Dir, "done")
-			for _, dir := range []string{todoDir, doingDir, doneDir} {
-				if err := os.MkdirAll(dir, 0755); err != nil {
+			createBenchmarkTickets(b, manager, cfg, tmpDir, scenario.ticketCount)
+
+			b.ResetTimer()
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_, err := manager.List(ctx, scenario.statusFilter)
+				if err != nil {
 					b.Fatal(err)
 				}
 			}
+		})
+	}
+}
 
-			// Create tickets distributed across statuses
-			for i := 0; i < scenario.ticketCount; i++ {
-				slug := fmt.Sprintf("benchmark-ticket-%d", i)
-				ticket, err := manager.Create(ctx, slug)
-				if err != nil {
+// BenchmarkManagerListIndex compares listing with and without the ticket index.
+// "no-cache" parses every file, "index" starts each iteration from the index
+// file like a new CLI process would, and "index-in-memory" reuses one manager
+// like the TUI does when switching tabs.
+// Run with: go test -bench=BenchmarkManagerListIndex ./internal/ticket
+func BenchmarkManagerListIndex(b *testing.B) {
+	for _, ticketCount := range []int{1000, 5000} {
+		tmpDir := b.TempDir()
+		cfg := config.Default()
+		cfg.Tickets.Dir = "tickets"
+		ctx := context.Background()
+		createBenchmarkTickets(b, NewManager(cfg, tmpDir), cfg, tmpDir, ticketCount)
+
+		// Files written moments ago are never trusted from the index, so age them
+		ticketsDir := filepath.Join(tmpDir, cfg.Tickets.Dir)
+		old := time.Now().Add(-time.Hour)
+		err := filepath.WalkDir(ticketsDir, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			return os.Chtimes(path, old, old)
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		indexPath := filepath.Join(tmpDir, ".git", "ticketflow", "index.gob")
+		warm := NewManager(cfg, tmpDir)
+		warm.SetIndex(NewIndex(indexPath))
+		if _, err := warm.List(ctx, StatusFilterAll); err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(fmt.Sprintf("%d-tickets/no-cache", ticketCount), func(b *testing.B) {
+			manager := NewManager(cfg, tmpDir)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := manager.List(ctx, StatusFilterAll); err != nil {
 					b.Fatal(err)
 				}
+			}
+		})
 
-				// Move some tickets to different statuses
-				switch i % 3 {
-				case 1: // Move to doing
-					oldPath := filepath.Join(todoDir, ticket.ID+".md")
-					newPath := filepath.Join(doingDir, ticket.ID+".md")
-					if err := os.Rename(oldPath, newPath); err != nil {
-						b.Fatal(err)
-					}
-				case 2: // Move to done
-					oldPath := filepath.Join(todoDir, ticket.ID+".md")
-					newPath := filepath.Join(doneDir, ticket.ID+".md")
-					if err := os.Rename(oldPath, newPath); err != nil {
-						b.Fatal(err)
-					}
+		b.Run(fmt.Sprintf("%d-tickets/index", ticketCount), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				manager := NewManager(cfg, tmpDir)
+				manager.SetIndex(NewIndex(indexPath))
+				if _, err := manager.List(ctx, StatusFilterAll); err != nil {
+					b.Fatal(err)
 				}
 			}
+		})
 
-			b.ResetTimer()
+		b.Run(fmt.Sprintf("%d-tickets/index-in-memory", ticketCount), func(b *testing.B) {
 			b.ReportAllocs()
-
 			for i := 0; i < b.N; i++ {
-				_, err := manager.List(ctx, scenario.statusFilter)
-				if err != nil {
+				if _, err := warm.List(ctx, StatusFilterAll); err != nil {
 					b.Fatal(err)
 				}
 			}
 		})
 	}
 }
+
+// createBenchmarkTickets creates count tickets distributed across todo, doing and done
+func createBenchmarkTickets(b *testing.B, manager *Manager, cfg *config.Config, root string, count int) {
+	b.Helper()
+	ctx := context.Background()
+
+	// Create ticket directory structure
+	todoDir := filepath.Join(root, cfg.Tickets.Dir, "todo")
+	doingDir := filepath.Join(root, cfg.Tickets.Dir, "doing")
+	doneDir := filepath.Join(root, cfg.Tickets.Dir, "done")
+	for _, dir := range []string{todoDir, doingDir, doneDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	// Create tickets distributed across statuses
+	for i := 0; i < count; i++ {
+		slug := fmt.Sprintf("benchmark-ticket-%d", i)
+		ticket, err := manager.Create(ctx, slug)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		// Move some tickets to different statuses
+		switch i % 3 {
+		case 1: // Move to doing
+			oldPath := filepath.Join(todoDir, ticket.ID+".md")
+			newPath := filepath.Join(doingDir, ticket.ID+".md")
+			if err := os.Rename(oldPath, newPath); err != nil {
+				b.Fatal(err)
+			}
+		case 2: // Move to done
+			oldPath := filepath.Join(todoDir, ticket.ID+".md")
+			newPath := filepath.Join(doneDir, ticket.ID+".md")
+			if err := os.Rename(oldPath, newPath); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package ticket
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SortKey is one field of a ticket sort order
+type SortKey struct {
+	Field      string
+	Descending bool
+}
+
+// ticketComparators compare two tickets by a sortable field.
+// They return a negative number when a sorts before b in ascending order.
+var ticketComparators = map[string]func(a, b *Ticket) int{
+	"id":          func(a, b *Ticket) int { return strings.Compare(a.ID, b.ID) },
+	"slug":        func(a, b *Ticket) int { return strings.Compare(a.Slug, b.Slug) },
+	"description": func(a, b *Ticket) int { return strings.Compare(a.Description, b.Description) },
+	"status":      func(a, b *Ticket) int { return strings.Compare(string(a.State()), string(b.State())) },
+	"priority":    func(a, b *Ticket) int { return a.Priority - b.Priority },
+	"created":     func(a, b *Ticket) int { return compareTimes(a.CreatedAt.ToTimePtr(), b.CreatedAt.ToTimePtr()) },
+	"started":     func(a, b *Ticket) int { return compareTimes(a.StartedAt.Time, b.StartedAt.Time) },
+	"closed":      func(a, b *Ticket) int { return compareTimes(a.ClosedAt.Time, b.ClosedAt.Time) },
+}
+
+// ParseSortKeys parses a comma-separated sort specification such as
+// "priority,-created". A leading "-" sorts the field in descending order.
+func ParseSortKeys(spec string) ([]SortKey, error) {
+	var keys []SortKey
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key := SortKey{Field: strings.ToLower(part)}
+		if field, ok := strings.CutPrefix(key.Field, "-"); ok {
+			key.Field = field
+			key.Descending = true
+		}
+		if _, ok := ticketComparators[key.Field]; !ok {
+			return nil, fmt.Errorf("unknown sort field %q, expected one of: %s", key.Field, strings.Join(SortFields(), ", "))
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// SortFields returns the fields tickets can be sorted by
+func SortFields() []string {
+	fields := make([]string, 0, len(ticketComparators))
+	for field := range ticketComparators {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// SortTickets sorts tickets by the given keys in place.
+// The sort is stable, so tickets equal on every key keep their order.
+func SortTickets(tickets []Ticket, keys []SortKey) {
+	if len(keys) == 0 {
+		return
+	}
+	sort.SliceStable(tickets, func(i, j int) bool {
+		for _, key := range keys {
+			c := ticketComparators[key.Field](&tickets[i], &tickets[j])
+			if c == 0 {
+				continue
+			}
+			if key.Descending {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+// compareTimes orders timestamps, with missing timestamps last
+func compareTimes(a, b *time.Time) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return a.Compare(*b)
+}
@@ -0,0 +1,50 @@
+package ticket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSortKeys(t *testing.T) {
+	t.Parallel()
+
+	keys, err := ParseSortKeys("priority, -Created,")
+	require.NoError(t, err)
+	assert.Equal(t, []SortKey{{Field: "priority"}, {Field: "created", Descending: true}}, keys)
+
+	keys, err = ParseSortKeys("")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = ParseSortKeys("priority,size")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown sort field "size"`)
+}
+
+func TestSortTickets(t *testing.T) {
+	t.Parallel()
+
+	slugs := func(tickets []Ticket) []string {
+		var s []string
+		for _, tk := range tickets {
+			s = append(s, tk.Slug)
+		}
+		return s
+	}
+
+	tickets := queryTestTickets()
+	SortTickets(tickets, []SortKey{{Field: "priority", Descending: true}})
+	assert.Equal(t, []string{"docs", "epic", "auth-login"}, slugs(tickets))
+
+	SortTickets(tickets, []SortKey{{Field: "created"}})
+	assert.Equal(t, []string{"epic", "auth-login", "docs"}, slugs(tickets))
+
+	// Tickets without the timestamp sort last; ties keep their order
+	SortTickets(tickets, []SortKey{{Field: "started"}, {Field: "slug", Descending: true}})
+	assert.Equal(t, []string{"docs", "auth-login", "epic"}, slugs(tickets))
+
+	SortTickets(tickets, nil)
+	assert.Equal(t, []string{"docs", "auth-login", "epic"}, slugs(tickets))
+}
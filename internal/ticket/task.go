@@ -0,0 +1,115 @@
+package ticket
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// taskPattern matches Markdown checklist items such as "- [ ] Write tests" or "* [x] Done".
+// The first group is the checkbox mark, the second the item text.
+var taskPattern = regexp.MustCompile(`^\s*[-*+] \[([ xX])\](?:\s+(.*))?$`)
+
+// Task is a checklist item found in the ticket content
+type Task struct {
+	Number int    // 1-based position among the ticket's tasks
+	Text   string // Item text without the checkbox
+	Done   bool
+}
+
+// TaskProgress summarizes how many checklist items are done
+type TaskProgress struct {
+	Done  int
+	Total int
+}
+
+// Percent returns the completed share of tasks in the range 0-100
+func (p TaskProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+// ParseTasks returns the checklist items in Markdown content.
+// Items inside fenced code blocks are ignored.
+func ParseTasks(content string) []Task {
+	var tasks []Task
+	forEachTaskLine(strings.Split(content, "\n"), func(_ int, match []string) {
+		tasks = append(tasks, Task{
+			Number: len(tasks) + 1,
+			Text:   strings.TrimSpace(match[2]),
+			Done:   match[1] != " ",
+		})
+	})
+	return tasks
+}
+
+// Tasks returns the checklist items in the ticket content
+func (t *Ticket) Tasks() []Task {
+	return ParseTasks(t.Content)
+}
+
+// TaskProgress returns the number of done and total checklist items
+func (t *Ticket) TaskProgress() TaskProgress {
+	var progress TaskProgress
+	for _, task := range t.Tasks() {
+		progress.Total++
+		if task.Done {
+			progress.Done++
+		}
+	}
+	return progress
+}
+
+// SetTaskDone checks or unchecks the n-th checklist item (1-based) in the content.
+// Only the checkbox is rewritten; the rest of the content is left untouched.
+// It reports whether the item changed.
+func (t *Ticket) SetTaskDone(n int, done bool) (bool, error) {
+	lines := strings.Split(t.Content, "\n")
+	lineIndex := -1
+	count := 0
+	forEachTaskLine(lines, func(i int, _ []string) {
+		count++
+		if count == n {
+			lineIndex = i
+		}
+	})
+	if n < 1 || lineIndex < 0 {
+		return false, fmt.Errorf("task %d not found: ticket has %d task(s)", n, count)
+	}
+
+	line := lines[lineIndex]
+	loc := taskPattern.FindStringSubmatchIndex(line)
+	mark := " "
+	if done {
+		mark = "x"
+	}
+	current := line[loc[2]:loc[3]]
+	if (current != " ") == done {
+		return false, nil
+	}
+
+	lines[lineIndex] = line[:loc[2]] + mark + line[loc[3]:]
+	t.Content = strings.Join(lines, "\n")
+	return true, nil
+}
+
+// forEachTaskLine calls fn with the line index and submatches of every checklist
+// item outside fenced code blocks
+func forEachTaskLine(lines []string, fn func(i int, match []string)) {
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if match := taskPattern.FindStringSubmatch(line); match != nil {
+			fn(i, match)
+		}
+	}
+}
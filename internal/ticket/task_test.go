@@ -0,0 +1,72 @@
+package ticket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const taskContent = `# Summary
+
+## Tasks
+- [ ] Write parser
+- [x] Add tests
+  * [X] Nested item
+- [ ]
+
+` + "```markdown\n- [ ] Not a task\n```" + `
+
+Not a task: [ ] inline
+`
+
+func TestParseTasks(t *testing.T) {
+	t.Parallel()
+	tasks := ParseTasks(taskContent)
+
+	assert.Equal(t, []Task{
+		{Number: 1, Text: "Write parser", Done: false},
+		{Number: 2, Text: "Add tests", Done: true},
+		{Number: 3, Text: "Nested item", Done: true},
+		{Number: 4, Text: "", Done: false},
+	}, tasks)
+	assert.Empty(t, ParseTasks("# No tasks here\n"))
+}
+
+func TestTicketTaskProgress(t *testing.T) {
+	t.Parallel()
+	tk := &Ticket{Content: taskContent}
+
+	progress := tk.TaskProgress()
+	assert.Equal(t, TaskProgress{Done: 2, Total: 4}, progress)
+	assert.Equal(t, 50, progress.Percent())
+	assert.Equal(t, 0, TaskProgress{}.Percent())
+}
+
+func TestTicketSetTaskDone(t *testing.T) {
+	t.Parallel()
+	tk := &Ticket{Content: taskContent}
+
+	changed, err := tk.SetTaskDone(1, true)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Contains(t, tk.Content, "- [x] Write parser")
+
+	// Already checked items are left alone
+	changed, err = tk.SetTaskDone(2, true)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = tk.SetTaskDone(3, false)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Contains(t, tk.Content, "  * [ ] Nested item")
+
+	// Content outside the checkbox is untouched, including fenced examples
+	assert.Contains(t, tk.Content, "```markdown\n- [ ] Not a task\n```")
+
+	_, err = tk.SetTaskDone(0, true)
+	assert.Error(t, err)
+	_, err = tk.SetTaskDone(5, true)
+	assert.ErrorContains(t, err, "ticket has 4 task(s)")
+}
@@ -0,0 +1,179 @@
+package ticket
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/log"
+)
+
+// DefaultTemplateName is the template used by 'new' when no template is given.
+// Without a template of this name, tickets.template is used verbatim.
+const DefaultTemplateName = "default"
+
+// Template is a named blueprint for new tickets.
+// Description and Content are Go templates rendered with TemplateData.
+type Template struct {
+	Name        string
+	Path        string // File the template was loaded from; empty for configured templates
+	Priority    int    // Default priority; 0 keeps the ticket default
+	Description string
+	Content     string
+}
+
+// TemplateData holds the variables available to ticket templates
+type TemplateData struct {
+	Slug     string
+	ID       string
+	ParentID string // Empty when the ticket has no parent
+	Date     string // Creation date as YYYY-MM-DD
+	Author   string // git user.name, empty when unset
+}
+
+// RenderedTemplate is a template with its variables filled in
+type RenderedTemplate struct {
+	Priority    int
+	Description string
+	Content     string
+}
+
+// templateFrontmatter holds the default values a template file may declare
+type templateFrontmatter struct {
+	Priority    int    `yaml:"priority"`
+	Description string `yaml:"description"`
+}
+
+// LoadTemplates returns the templates in the tickets template directory and the
+// configuration, sorted by name. A configured template replaces a file of the same name.
+func LoadTemplates(cfg *config.Config, projectRoot string) ([]Template, error) {
+	byName := make(map[string]Template)
+
+	dir := cfg.GetTemplatesPath(projectRoot)
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read template directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".md" {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".md")
+		if !config.IsValidTemplateName(name) {
+			log.Global().Debug("skipping template file with invalid name", "file", entry.Name())
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		tmpl, err := loadTemplateFile(name, path)
+		if err != nil {
+			return nil, err
+		}
+		byName[name] = tmpl
+	}
+
+	for _, tc := range cfg.Tickets.Templates {
+		byName[tc.Name] = Template{
+			Name:        tc.Name,
+			Priority:    tc.Priority,
+			Description: tc.Description,
+			Content:     tc.Content,
+		}
+	}
+
+	templates := make([]Template, 0, len(byName))
+	for _, tmpl := range byName {
+		templates = append(templates, tmpl)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// loadTemplateFile reads a template file with optional priority/description frontmatter
+func loadTemplateFile(name, path string) (Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Template{}, fmt.Errorf("failed to read template %s: %w", name, err)
+	}
+
+	tmpl := Template{Name: name, Path: path, Content: string(data)}
+	if !bytes.HasPrefix(data, []byte("---\n")) {
+		return tmpl, nil
+	}
+
+	parts := bytes.SplitN(data, []byte("---\n"), 3)
+	if len(parts) < 3 {
+		return Template{}, fmt.Errorf("invalid template %s: unterminated frontmatter", name)
+	}
+	var fm templateFrontmatter
+	if err := yaml.Unmarshal(parts[1], &fm); err != nil {
+		return Template{}, fmt.Errorf("invalid template %s: failed to parse frontmatter: %w", name, err)
+	}
+	if fm.Priority < 0 || fm.Priority > 3 {
+		return Template{}, fmt.Errorf("invalid template %s: priority must be between 1 and 3", name)
+	}
+
+	tmpl.Priority = fm.Priority
+	tmpl.Description = fm.Description
+	tmpl.Content = strings.TrimPrefix(string(parts[2]), "\n")
+	return tmpl, nil
+}
+
+// FindTemplate returns the template with the given name
+func FindTemplate(templates []Template, name string) (*Template, bool) {
+	for i := range templates {
+		if templates[i].Name == name {
+			return &templates[i], true
+		}
+	}
+	return nil, false
+}
+
+// TemplateNames returns the names of the given templates
+func TemplateNames(templates []Template) []string {
+	names := make([]string, len(templates))
+	for i, t := range templates {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// Render fills in the template variables of the description and content
+func (t Template) Render(data TemplateData) (*RenderedTemplate, error) {
+	description, err := t.execute("description", t.Description, data)
+	if err != nil {
+		return nil, err
+	}
+	content, err := t.execute("content", t.Content, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedTemplate{
+		Priority:    t.Priority,
+		Description: strings.TrimSpace(description),
+		Content:     content,
+	}, nil
+}
+
+// execute renders one field of the template
+func (t Template) execute(field, text string, data TemplateData) (string, error) {
+	parsed, err := template.New(t.Name + "." + field).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template %s: %w", t.Name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", t.Name, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,105 @@
+package ticket
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/config"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	cfg := config.Default()
+
+	dir := cfg.GetTemplatesPath(root)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bug.md"),
+		[]byte("---\npriority: 1\ndescription: \"Bug: {{.Slug}}\"\n---\n\n# Bug report\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "spike.md"), []byte("# Spike {{.ID}}\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "feature.md"), []byte("# From file\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Not Valid.md"), []byte("ignored"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0644))
+
+	cfg.Tickets.Templates = []config.TemplateConfig{
+		{Name: "feature", Priority: 2, Content: "# Feature\n"},
+	}
+
+	templates, err := LoadTemplates(cfg, root)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bug", "feature", "spike"}, TemplateNames(templates))
+
+	bug, ok := FindTemplate(templates, "bug")
+	require.True(t, ok)
+	assert.Equal(t, 1, bug.Priority)
+	assert.Equal(t, "Bug: {{.Slug}}", bug.Description)
+	assert.Equal(t, "# Bug report\n", bug.Content)
+	assert.Equal(t, filepath.Join(dir, "bug.md"), bug.Path)
+
+	feature, ok := FindTemplate(templates, "feature")
+	require.True(t, ok)
+	assert.Equal(t, "# Feature\n", feature.Content, "configured template replaces the file")
+	assert.Empty(t, feature.Path)
+
+	spike, ok := FindTemplate(templates, "spike")
+	require.True(t, ok)
+	assert.Equal(t, 0, spike.Priority)
+	assert.Equal(t, "# Spike {{.ID}}\n", spike.Content)
+
+	_, ok = FindTemplate(templates, "missing")
+	assert.False(t, ok)
+}
+
+func TestLoadTemplatesWithoutDirectory(t *testing.T) {
+	t.Parallel()
+	templates, err := LoadTemplates(config.Default(), t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+}
+
+func TestLoadTemplatesInvalidFrontmatter(t *testing.T) {
+	t.Parallel()
+	root := t.TempDir()
+	cfg := config.Default()
+	dir := cfg.GetTemplatesPath(root)
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.md"), []byte("---\npriority: 9\n---\nbody\n"), 0644))
+
+	_, err := LoadTemplates(cfg, root)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "priority must be between 1 and 3")
+}
+
+func TestTemplateRender(t *testing.T) {
+	t.Parallel()
+	data := TemplateData{
+		Slug:     "login-crash",
+		ID:       "260101-120000-login-crash",
+		ParentID: "260101-110000-auth",
+		Date:     "2026-01-01",
+		Author:   "Alice",
+	}
+
+	tmpl := Template{
+		Name:        "bug",
+		Priority:    1,
+		Description: "Bug: {{.Slug}} ",
+		Content:     "# {{.ID}}\n\nReported by {{.Author}} on {{.Date}}{{if .ParentID}} (parent {{.ParentID}}){{end}}\n",
+	}
+	rendered, err := tmpl.Render(data)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rendered.Priority)
+	assert.Equal(t, "Bug: login-crash", rendered.Description)
+	assert.Equal(t, "# 260101-120000-login-crash\n\nReported by Alice on 2026-01-01 (parent 260101-110000-auth)\n", rendered.Content)
+
+	_, err = Template{Name: "broken", Content: "{{.Slug"}.Render(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid template broken")
+
+	_, err = Template{Name: "unknown", Content: "{{.Assignee}}"}.Render(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to render template unknown")
+}
@@ -17,6 +17,10 @@ const (
 	StatusTodo  Status = "todo"
 	StatusDoing Status = "doing"
 	StatusDone  Status = "done"
+
+	// StatusCancelled is the workflow state of tickets abandoned without completion.
+	// Cancelled tickets are closed, so their lifecycle Status() is StatusDone.
+	StatusCancelled Status = "cancelled"
 )
 
 // Ticket represents a ticket with metadata and content
@@ -29,12 +33,32 @@ type Ticket struct {
 	ClosedAt      RFC3339TimePtr `yaml:"closed_at"`
 	ClosureReason string         `yaml:"closure_reason,omitempty"`
 	Related       []string       `yaml:"related,omitempty"`
+	Tags          []string       `yaml:"tags,omitempty"`
+
+	// Branch is the git branch the ticket is worked on, recorded when the
+	// ticket is started. Tickets started without it use their ID.
+	Branch string `yaml:"branch,omitempty"`
 
 	// Computed fields
 	ID      string `yaml:"-"`
 	Slug    string `yaml:"-"`
 	Path    string `yaml:"-"`
 	Content string `yaml:"-"`
+
+	// state is the workflow state derived from the ticket's directory.
+	// Empty means the state follows the lifecycle status.
+	state Status
+	// archived is set for tickets loaded from the archive directory
+	archived bool
+	// frontmatter is the YAML document the ticket was parsed from.
+	// It keeps unknown keys, comments and key order for ToBytes.
+	frontmatter *yaml.Node
+	// rawFrontmatter is the frontmatter text the ticket was parsed from.
+	// Tickets loaded from the index keep only the text; frontmatterDoc
+	// parses it when the document is needed.
+	rawFrontmatter []byte
+	// contentLine is the line of the file on which Content starts (0 if unknown)
+	contentLine int
 }
 
 // Status returns the current status of the ticket
@@ -48,6 +72,68 @@ func (t *Ticket) Status() Status {
 	return StatusTodo
 }
 
+// State returns the workflow state of the ticket.
+// For tickets loaded from a configured state directory this is the state name;
+// otherwise it is the lifecycle status derived from the timestamps.
+func (t *Ticket) State() Status {
+	if t.state != "" {
+		return t.state
+	}
+	return t.Status()
+}
+
+// SetState sets the workflow state of the ticket.
+// A ticket placed in a state directory is no longer archived.
+func (t *Ticket) SetState(state Status) {
+	t.state = state
+	t.archived = false
+}
+
+// BranchName returns the git branch the ticket is worked on
+func (t *Ticket) BranchName() string {
+	if t.Branch != "" {
+		return t.Branch
+	}
+	return t.ID
+}
+
+// IsArchived reports whether the ticket was loaded from the archive directory
+func (t *Ticket) IsArchived() bool {
+	return t.archived
+}
+
+// IsCancelled reports whether the ticket is in the cancelled state
+func (t *Ticket) IsCancelled() bool {
+	return t.State() == StatusCancelled
+}
+
+// IsClosed reports whether the ticket is done or cancelled.
+// Cancelled tickets count as closed even when they lack a closed_at timestamp.
+func (t *Ticket) IsClosed() bool {
+	return t.ClosedAt.Time != nil || t.IsCancelled()
+}
+
+// Extra returns the frontmatter keys that ticketflow does not manage itself,
+// such as metadata stored by other tools. The map is empty, never nil.
+func (t *Ticket) Extra() map[string]interface{} {
+	return extraFrontmatter(t.frontmatterDoc())
+}
+
+// frontmatterDoc returns the YAML document the ticket was parsed from, if any
+func (t *Ticket) frontmatterDoc() *yaml.Node {
+	if t.frontmatter != nil || len(t.rawFrontmatter) == 0 {
+		return t.frontmatter
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(t.rawFrontmatter, &doc); err != nil {
+		return nil
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	return &doc
+}
+
 // HasWorktree checks if the ticket has an associated worktree
 func (t *Ticket) HasWorktree() bool {
 	return t.Status() == StatusDoing
@@ -62,26 +148,42 @@ func Parse(content []byte) (*Ticket, error) {
 
 	// Parse YAML frontmatter
 	var ticket Ticket
-	if err := yaml.Unmarshal(parts[1], &ticket); err != nil {
+	frontmatter, err := parseFrontmatter(parts[1], &ticket)
+	if err != nil {
 		return nil, fmt.Errorf("failed to parse frontmatter: %w", err)
 	}
+	ticket.frontmatter = frontmatter
+	ticket.rawFrontmatter = parts[1]
 
 	// Set content (remove leading newline if present)
 	ticket.Content = strings.TrimPrefix(string(parts[2]), "\n")
+	header := content[:len(content)-len(ticket.Content)]
+	ticket.contentLine = bytes.Count(header, []byte("\n")) + 1
 
 	return &ticket, nil
 }
 
-// ToBytes converts the ticket to file content
+// ToBytes converts the ticket to file content.
+// For parsed tickets the original frontmatter is updated in place, so unknown
+// keys, comments and key order survive the round trip.
 func (t *Ticket) ToBytes() ([]byte, error) {
 	var buf bytes.Buffer
 
 	// Write frontmatter
 	buf.WriteString("---\n")
 
+	var frontmatter interface{} = t
+	if doc := t.frontmatterDoc(); doc != nil {
+		merged, err := mergeFrontmatter(doc, t)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+		}
+		frontmatter = merged
+	}
+
 	encoder := yaml.NewEncoder(&buf)
 	encoder.SetIndent(0)
-	if err := encoder.Encode(t); err != nil {
+	if err := encoder.Encode(frontmatter); err != nil {
 		return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
 	}
 
@@ -139,6 +241,55 @@ func IsValidSlug(slug string) bool {
 	return true
 }
 
+// IsValidTag checks if a tag is valid.
+// Tags follow the same rules as slugs: lowercase letters, digits and hyphens.
+func IsValidTag(tag string) bool {
+	return IsValidSlug(tag)
+}
+
+// NormalizeTag trims surrounding whitespace and lowercases a tag
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// HasTag reports whether the ticket has the given tag
+func (t *Ticket) HasTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for _, existing := range t.Tags {
+		if existing == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTag adds a tag to the ticket.
+// It returns false if the ticket already had the tag.
+func (t *Ticket) AddTag(tag string) (bool, error) {
+	tag = NormalizeTag(tag)
+	if !IsValidTag(tag) {
+		return false, fmt.Errorf("invalid tag %q: use only lowercase letters, numbers, and hyphens", tag)
+	}
+	if t.HasTag(tag) {
+		return false, nil
+	}
+	t.Tags = append(t.Tags, tag)
+	return true, nil
+}
+
+// RemoveTag removes a tag from the ticket.
+// It returns false if the ticket did not have the tag.
+func (t *Ticket) RemoveTag(tag string) bool {
+	tag = NormalizeTag(tag)
+	for i, existing := range t.Tags {
+		if existing == tag {
+			t.Tags = append(t.Tags[:i], t.Tags[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
 // New creates a new ticket with defaults
 func New(slug, description string) *Ticket {
 	now := time.Now()
@@ -163,6 +314,7 @@ func (t *Ticket) Start() error {
 
 	now := time.Now()
 	t.StartedAt = NewRFC3339TimePtr(&now)
+	t.state = ""
 	return nil
 }
 
@@ -177,6 +329,7 @@ func (t *Ticket) Close() error {
 
 	now := time.Now()
 	t.ClosedAt = NewRFC3339TimePtr(&now)
+	t.state = ""
 	return nil
 }
 
@@ -197,6 +350,7 @@ func (t *Ticket) CloseWithReason(reason string) error {
 	now := time.Now()
 	t.ClosedAt = NewRFC3339TimePtr(&now)
 	t.ClosureReason = trimmedReason
+	t.state = ""
 
 	// Add closure note to content
 	closureNote := fmt.Sprintf("\n\n## Closure Note\n**Closed on**: %s\n**Reason**: %s\n",
@@ -206,3 +360,31 @@ func (t *Ticket) CloseWithReason(reason string) error {
 
 	return nil
 }
+
+// Reopen returns a closed or cancelled ticket to the todo status.
+// The start and close timestamps and the closure reason are cleared,
+// and a note recording the reopen is appended to the content.
+func (t *Ticket) Reopen() error {
+	if !t.IsClosed() {
+		return ticketerrors.ErrTicketNotDone
+	}
+
+	now := time.Now()
+	var note strings.Builder
+	fmt.Fprintf(&note, "\n\n## Reopen Note\n**Reopened on**: %s\n", now.Format("2006-01-02"))
+	if t.ClosedAt.Time != nil {
+		fmt.Fprintf(&note, "**Previously closed on**: %s\n", t.ClosedAt.Time.Format("2006-01-02"))
+	}
+	if t.ClosureReason != "" {
+		fmt.Fprintf(&note, "**Previous closure reason**: %s\n", t.ClosureReason)
+	}
+	// Ensure proper formatting by trimming trailing newlines before appending
+	t.Content = strings.TrimRight(t.Content, "\n") + note.String()
+
+	t.StartedAt = RFC3339TimePtr{}
+	t.ClosedAt = RFC3339TimePtr{}
+	t.ClosureReason = ""
+	t.state = ""
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	ticketerrors "github.com/yshrsmz/ticketflow/internal/errors"
 )
 
 func TestTicketStatus(t *testing.T) {
@@ -397,3 +398,170 @@ func TestTicketCloseWithReason(t *testing.T) {
 		assert.Equal(t, 2, len(parts))
 	})
 }
+
+func TestTicketReopen(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reopen done ticket", func(t *testing.T) {
+		ticket := New("test", "Test ticket")
+		ticket.Content = "# Test Ticket\n"
+		require.NoError(t, ticket.Start())
+		require.NoError(t, ticket.CloseWithReason("Not needed"))
+
+		require.NoError(t, ticket.Reopen())
+
+		assert.Equal(t, StatusTodo, ticket.Status())
+		assert.Nil(t, ticket.StartedAt.Time)
+		assert.Nil(t, ticket.ClosedAt.Time)
+		assert.Empty(t, ticket.ClosureReason)
+		assert.Contains(t, ticket.Content, "## Reopen Note")
+		assert.Contains(t, ticket.Content, "**Previously closed on**:")
+		assert.Contains(t, ticket.Content, "**Previous closure reason**: Not needed")
+		assert.NotContains(t, ticket.Content, "\n\n\n")
+	})
+
+	t.Run("reopen cancelled ticket without closed_at", func(t *testing.T) {
+		ticket := New("test", "Test ticket")
+		ticket.SetState(StatusCancelled)
+		require.True(t, ticket.IsClosed())
+
+		require.NoError(t, ticket.Reopen())
+		assert.Equal(t, StatusTodo, ticket.State())
+		assert.NotContains(t, ticket.Content, "Previously closed on")
+	})
+
+	t.Run("cannot reopen open ticket", func(t *testing.T) {
+		ticket := New("test", "Test ticket")
+		assert.ErrorIs(t, ticket.Reopen(), ticketerrors.ErrTicketNotDone)
+
+		require.NoError(t, ticket.Start())
+		assert.ErrorIs(t, ticket.Reopen(), ticketerrors.ErrTicketNotDone)
+	})
+}
+
+func TestTicketTags(t *testing.T) {
+	t.Parallel()
+	tk := New("test", "Test ticket")
+
+	added, err := tk.AddTag("Backend")
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Equal(t, []string{"backend"}, tk.Tags)
+	assert.True(t, tk.HasTag("backend"))
+
+	// Adding the same tag again is a no-op
+	added, err = tk.AddTag(" backend ")
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Len(t, tk.Tags, 1)
+
+	_, err = tk.AddTag("bad tag")
+	assert.Error(t, err)
+
+	_, err = tk.AddTag("ui")
+	require.NoError(t, err)
+	assert.True(t, tk.RemoveTag("backend"))
+	assert.False(t, tk.RemoveTag("backend"))
+	assert.Equal(t, []string{"ui"}, tk.Tags)
+
+	// Tags survive a round trip through the file format
+	data, err := tk.ToBytes()
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "tags:")
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ui"}, parsed.Tags)
+}
+
+func TestTicketBranchName(t *testing.T) {
+	t.Parallel()
+	tk := New("test", "Test ticket")
+
+	// Tickets without a recorded branch are worked on in a branch named after their ID
+	assert.Equal(t, tk.ID, tk.BranchName())
+	data, err := tk.ToBytes()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "branch:")
+
+	tk.Branch = "feature/test"
+	assert.Equal(t, "feature/test", tk.BranchName())
+
+	// The branch survives a round trip through the file format
+	data, err = tk.ToBytes()
+	require.NoError(t, err)
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, "feature/test", parsed.Branch)
+	assert.Empty(t, parsed.Extra())
+}
+
+func TestToBytesPreservesFrontmatter(t *testing.T) {
+	t.Parallel()
+	content := `---
+# Managed by ticketflow; jira_key is synced by our tracker bot
+priority: 2 # bump when customers complain
+jira_key: PROJ-42
+description: Original
+created_at: 2026-01-01T10:00:00Z
+estimate:
+    points: 3
+    confidence: low
+started_at: null
+closed_at: null
+tags: [backend, api]
+---
+
+# Body
+`
+	tk, err := Parse([]byte(content))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"jira_key": "PROJ-42",
+		"estimate": map[string]interface{}{"points": 3, "confidence": "low"},
+	}, tk.Extra())
+
+	// An unchanged ticket is written back verbatim
+	data, err := tk.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data))
+
+	started := time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)
+	tk.Priority = 1
+	tk.StartedAt = NewRFC3339TimePtr(&started)
+	tk.Tags = nil
+	tk.Related = []string{"parent:250101-000000-epic"}
+
+	data, err = tk.ToBytes()
+	require.NoError(t, err)
+	assert.Equal(t, `---
+# Managed by ticketflow; jira_key is synced by our tracker bot
+priority: 1 # bump when customers complain
+jira_key: PROJ-42
+description: Original
+created_at: 2026-01-01T10:00:00Z
+estimate:
+    points: 3
+    confidence: low
+started_at: "2026-01-02T09:00:00Z"
+closed_at: null
+related:
+    - parent:250101-000000-epic
+---
+
+# Body
+`, string(data))
+
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, tk.Extra(), parsed.Extra())
+	assert.Equal(t, 1, parsed.Priority)
+	assert.Equal(t, started, *parsed.StartedAt.Time)
+}
+
+func TestExtraWithoutFrontmatterNode(t *testing.T) {
+	t.Parallel()
+	tk := New("test", "Test ticket")
+	assert.NotNil(t, tk.Extra())
+	assert.Empty(t, tk.Extra())
+}
@@ -0,0 +1,163 @@
+package ticket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/yshrsmz/ticketflow/internal/config"
+)
+
+// defaultSortKeys is the order tickets are listed in: by priority, newest first
+var defaultSortKeys = []SortKey{{Field: "priority"}, {Field: "created", Descending: true}}
+
+// View is a saved ticket list compiled from its configuration
+type View struct {
+	Name        string
+	Description string
+
+	// States are the workflow states the view includes; empty for active tickets
+	States []string
+	// Scope selects the directories the view lists tickets from
+	Scope StatusFilter
+	// Expression is the filter expression equivalent to the view's states,
+	// match, parent, tags and filter settings
+	Expression string
+	Query      *Query
+	Sort       []SortKey
+	// Count limits the number of tickets (0 for no limit)
+	Count           int
+	IncludeArchived bool
+}
+
+// NewView compiles a view declared in the configuration
+func NewView(vc config.ViewConfig) (*View, error) {
+	expr, err := viewExpression(vc)
+	if err != nil {
+		return nil, fmt.Errorf("view %q: %w", vc.Name, err)
+	}
+	query, err := ParseQuery(expr)
+	if err != nil {
+		return nil, fmt.Errorf("view %q: invalid filter: %w", vc.Name, err)
+	}
+	sortKeys, err := ParseSortKeys(vc.Sort)
+	if err != nil {
+		return nil, fmt.Errorf("view %q: invalid sort: %w", vc.Name, err)
+	}
+
+	return &View{
+		Name:            vc.Name,
+		Description:     vc.Description,
+		States:          vc.Status,
+		Scope:           viewScope(vc.Status),
+		Expression:      expr,
+		Query:           query,
+		Sort:            sortKeys,
+		Count:           vc.Count,
+		IncludeArchived: vc.IncludeArchived,
+	}, nil
+}
+
+// List returns the tickets of the view
+func (v *View) List(ctx context.Context, manager TicketManager) ([]Ticket, error) {
+	tickets, err := manager.List(ctx, v.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Archived tickets are done tickets, so only add them when done tickets are listed
+	if v.IncludeArchived && (v.Scope == StatusFilterAll || v.Scope == StatusFilterDone) {
+		archived, err := manager.List(ctx, StatusFilterArchived)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, archived...)
+		SortTickets(tickets, defaultSortKeys)
+	}
+
+	return v.Apply(tickets), nil
+}
+
+// Apply filters, sorts and limits tickets listed from the view's scope
+func (v *View) Apply(tickets []Ticket) []Ticket {
+	tickets = v.Query.Filter(tickets)
+	SortTickets(tickets, v.Sort)
+	if v.Count > 0 && len(tickets) > v.Count {
+		tickets = tickets[:v.Count]
+	}
+	return tickets
+}
+
+// viewScope returns the status filter covering the view's states.
+// Several states are listed from every directory and narrowed by the expression.
+func viewScope(states []string) StatusFilter {
+	switch {
+	case len(states) == 0:
+		return StatusFilterActive
+	case len(states) == 1:
+		return StatusFilter(states[0])
+	default:
+		return StatusFilterAll
+	}
+}
+
+// viewExpression builds the filter expression for a view
+func viewExpression(vc config.ViewConfig) (string, error) {
+	var terms []string
+
+	if len(vc.Status) > 1 {
+		states := make([]string, len(vc.Status))
+		for i, state := range vc.Status {
+			states[i] = "status=" + state
+		}
+		terms = append(terms, "("+strings.Join(states, " or ")+")")
+	}
+
+	if vc.Match != "" {
+		value, err := quoteQueryValue(vc.Match)
+		if err != nil {
+			return "", fmt.Errorf("invalid match: %w", err)
+		}
+		terms = append(terms, fmt.Sprintf("(slug~%s or description~%s)", value, value))
+	}
+
+	if vc.Parent != "" {
+		value, err := quoteQueryValue(vc.Parent)
+		if err != nil {
+			return "", fmt.Errorf("invalid parent: %w", err)
+		}
+		terms = append(terms, "parent="+value)
+	}
+
+	for _, tag := range vc.Tags {
+		value, err := quoteQueryValue(tag)
+		if err != nil {
+			return "", fmt.Errorf("invalid tag: %w", err)
+		}
+		terms = append(terms, "tag="+value)
+	}
+
+	if filter := strings.TrimSpace(vc.Filter); filter != "" {
+		if len(terms) == 0 {
+			return filter, nil
+		}
+		terms = append(terms, "("+filter+")")
+	}
+
+	return strings.Join(terms, " and "), nil
+}
+
+// quoteQueryValue quotes a value for use in a filter expression term when it
+// contains spaces, parentheses or quotes
+func quoteQueryValue(value string) (string, error) {
+	if !strings.ContainsAny(value, " \t\n()\"'") {
+		return value, nil
+	}
+	if !strings.Contains(value, `"`) {
+		return `"` + value + `"`, nil
+	}
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'", nil
+	}
+	return "", fmt.Errorf("value %q cannot contain both single and double quotes", value)
+}
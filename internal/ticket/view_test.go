@@ -0,0 +1,114 @@
+package ticket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yshrsmz/ticketflow/internal/config"
+)
+
+func TestNewView(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		view       config.ViewConfig
+		scope      StatusFilter
+		expression string
+		wantErr    string
+	}{
+		{
+			name:  "defaults to active tickets",
+			view:  config.ViewConfig{Name: "v"},
+			scope: StatusFilterActive,
+		},
+		{
+			name:  "single state",
+			view:  config.ViewConfig{Name: "v", Status: []string{"doing"}},
+			scope: StatusFilter("doing"),
+		},
+		{
+			name:  "all states",
+			view:  config.ViewConfig{Name: "v", Status: []string{"all"}},
+			scope: StatusFilterAll,
+		},
+		{
+			name:       "several states",
+			view:       config.ViewConfig{Name: "v", Status: []string{"todo", "review"}},
+			scope:      StatusFilterAll,
+			expression: "(status=todo or status=review)",
+		},
+		{
+			name:       "match, parent, tags and filter",
+			view:       config.ViewConfig{Name: "v", Match: "log in", Parent: "250101-120000-epic", Tags: []string{"backend"}, Filter: "priority<=1 or has:worktree"},
+			scope:      StatusFilterActive,
+			expression: `(slug~"log in" or description~"log in") and parent=250101-120000-epic and tag=backend and (priority<=1 or has:worktree)`,
+		},
+		{
+			name:       "filter only",
+			view:       config.ViewConfig{Name: "v", Filter: "priority<=1"},
+			scope:      StatusFilterActive,
+			expression: "priority<=1",
+		},
+		{
+			name:       "match with double quotes",
+			view:       config.ViewConfig{Name: "v", Match: `say "hi"`},
+			scope:      StatusFilterActive,
+			expression: `(slug~'say "hi"' or description~'say "hi"')`,
+		},
+		{
+			name:    "invalid filter",
+			view:    config.ViewConfig{Name: "v", Filter: "priority<=high"},
+			wantErr: `view "v": invalid filter`,
+		},
+		{
+			name:    "invalid sort",
+			view:    config.ViewConfig{Name: "v", Sort: "size"},
+			wantErr: `view "v": invalid sort`,
+		},
+		{
+			name:    "match with both quotes",
+			view:    config.ViewConfig{Name: "v", Match: `it's "odd"`},
+			wantErr: `view "v": invalid match`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, err := NewView(tt.view)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.scope, view.Scope)
+			assert.Equal(t, tt.expression, view.Expression)
+		})
+	}
+}
+
+func TestViewList(t *testing.T) {
+	t.Parallel()
+	manager, _ := setupTestManager(t)
+	ctx := context.Background()
+
+	for _, slug := range []string{"auth-login", "auth-logout", "docs"} {
+		_, err := manager.Create(ctx, slug)
+		require.NoError(t, err)
+	}
+
+	view, err := NewView(config.ViewConfig{Name: "auth", Match: "auth", Sort: "-slug", Count: 1})
+	require.NoError(t, err)
+
+	tickets, err := view.List(ctx, manager)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Equal(t, "auth-logout", tickets[0].Slug)
+
+	view.Count = 0
+	tickets, err = view.List(ctx, manager)
+	require.NoError(t, err)
+	assert.Len(t, tickets, 2)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-shellwords"
+	"github.com/yshrsmz/ticketflow/internal/cli"
 	"github.com/yshrsmz/ticketflow/internal/config"
 	"github.com/yshrsmz/ticketflow/internal/git"
 	"github.com/yshrsmz/ticketflow/internal/log"
@@ -85,6 +87,11 @@ type ticketClosedMsg struct {
 	worktreePath string
 }
 
+// ticketReopenedMsg is sent when a closed ticket is successfully reopened
+type ticketReopenedMsg struct {
+	ticket *ticket.Ticket
+}
+
 // ticketEditedMsg is sent when a ticket has been edited
 type ticketEditedMsg struct {
 	ticket *ticket.Ticket
@@ -143,9 +150,9 @@ func New(cfg *config.Config, manager ticket.TicketManager, gitClient git.GitClie
 		repoRoot:     repoRoot,
 		view:         ViewTicketList,
 		previousView: ViewTicketList,
-		ticketList:   views.NewTicketListModel(manager),
-		ticketDetail: views.NewTicketDetailModel(manager),
-		newTicket:    views.NewNewTicketModel(manager),
+		ticketList:   views.NewTicketListModel(manager, cfg),
+		ticketDetail: views.NewTicketDetailModel(manager, gitClient, cfg),
+		newTicket:    views.NewNewTicketModel(manager, gitClient, cfg, projectRoot),
 		worktreeList: views.NewWorktreeListModel(gitClient, cfg),
 		closeDialog:  components.NewCloseDialogModel(),
 		help:         components.NewHelpModel(),
@@ -282,6 +289,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, m.ticketList.Refresh())
 		return m, tea.Batch(cmds...)
 
+	case ticketReopenedMsg:
+		// Go back to list and refresh so the ticket shows up under todo
+		if m.view == ViewTicketDetail {
+			m.view = m.previousView
+		}
+		cmds = append(cmds, m.ticketList.Refresh())
+		return m, tea.Batch(cmds...)
+
 	case ticketEditedMsg:
 		// Ticket was edited, update detail view if showing
 		if m.view == ViewTicketDetail {
@@ -369,6 +384,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if t != nil {
 				cmds = append(cmds, m.startTicket(t))
 			}
+
+		case views.DetailActionReopen:
+			t := m.ticketDetail.SelectedTicket()
+			if t != nil {
+				cmds = append(cmds, m.reopenTicket(t))
+			}
 		}
 
 	case ViewNewTicket:
@@ -472,14 +493,25 @@ func (m *Model) startTicket(t *ticket.Ticket) tea.Cmd {
 			return err
 		}
 
+		// Name the ticket's branch
+		if err := m.cliApp().AssignTicketBranch(context.Background(), t); err != nil {
+			return err
+		}
+
 		// Get current branch
 		currentBranch, err := m.git.CurrentBranch(context.Background())
 		if err != nil {
 			return fmt.Errorf("failed to get current branch: %w", err)
 		}
 
+		// Choose where the ticket's branch starts
+		startPoint, err := m.cliApp().TicketStartPoint(context.Background(), t, currentBranch)
+		if err != nil {
+			return err
+		}
+
 		// Setup branch or worktree
-		worktreePath, initErr := m.setupTicketBranchOrWorktree(t)
+		worktreePath, initErr := m.setupTicketBranchOrWorktree(t, startPoint)
 		if initErr != nil && !IsInitCommandError(initErr) {
 			// If it's not an init command error, it's a fatal error
 			return initErr
@@ -490,6 +522,18 @@ func (m *Model) startTicket(t *ticket.Ticket) tea.Cmd {
 			return err
 		}
 
+		// The worktree was created before the start commit, so a branch started
+		// from another base gets the started ticket committed separately
+		if worktreePath != "" && startPoint != "" {
+			if err := m.cliApp().CommitStartedTicketInWorktree(context.Background(), worktreePath, t); err != nil {
+				// Rollback the worktree and the start commit
+				m.rollbackTicketStart(worktreePath, currentBranch)
+				_ = m.manager.SetCurrentTicket(context.Background(), nil)
+				_, _ = m.git.Exec(context.Background(), git.SubcmdReset, git.FlagHard, "HEAD^")
+				return err
+			}
+		}
+
 		// Return success message with any init warning
 		msg := ticketStartedMsg{
 			ticket:       t,
@@ -502,18 +546,30 @@ func (m *Model) startTicket(t *ticket.Ticket) tea.Cmd {
 	}
 }
 
+// reopenTicket moves a done or cancelled ticket back to todo and commits the
+// move. The displayed ticket is left untouched; the list is refreshed from disk.
+func (m *Model) reopenTicket(t *ticket.Ticket) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.cliApp().ReopenTicket(context.Background(), t.ID, false, false)
+		if err != nil {
+			return err
+		}
+		return ticketReopenedMsg{ticket: result.Ticket}
+	}
+}
+
 // isCurrentTicket checks if the given ticket is the current active ticket
 func isCurrentTicket(current, target *ticket.Ticket) bool {
 	return current != nil && target != nil && current.ID == target.ID
 }
 
 // checkBranchMerged checks if a branch has been merged to the default branch
-func (m *Model) checkBranchMerged(ctx context.Context, ticketID string) (bool, error) {
+func (m *Model) checkBranchMerged(ctx context.Context, branch string) (bool, error) {
 	if m.config.Git.DefaultBranch == "" {
 		// If no default branch configured, we can't determine merge status
 		return false, fmt.Errorf("default branch not configured in .ticketflow.yaml")
 	}
-	return m.git.IsBranchMerged(ctx, ticketID, m.config.Git.DefaultBranch)
+	return m.git.IsBranchMerged(ctx, branch, m.config.Git.DefaultBranch)
 }
 
 // checkCloseRequirements checks if a ticket can be closed and determines requirements
@@ -533,7 +589,7 @@ func (m *Model) checkCloseRequirements(t *ticket.Ticket) tea.Cmd {
 			requireReason = false
 		} else {
 			// Not current ticket - check if branch is merged
-			merged, err := m.checkBranchMerged(context.Background(), t.ID)
+			merged, err := m.checkBranchMerged(context.Background(), t.BranchName())
 			if err != nil {
 				// If we can't check merge status, assume not merged (safer)
 				requireReason = true
@@ -579,7 +635,7 @@ func (m *Model) closeTicketWithReason(t *ticket.Ticket, reason string) tea.Cmd {
 
 		// If not current ticket and no reason provided, check if branch is merged
 		if !isCurrent && reason == "" {
-			merged, err := m.checkBranchMerged(ctx, t.ID)
+			merged, err := m.checkBranchMerged(ctx, t.BranchName())
 			if err != nil {
 				logger.WithError(err).Warn("failed to check if branch is merged, requiring reason as safety fallback")
 				merged = false
@@ -656,6 +712,20 @@ func (m *Model) validateTicketForStart(t *ticket.Ticket) error {
 	return nil
 }
 
+// cliApp returns the CLI application over the TUI's configuration, ticket
+// manager and git client, so that both run the same ticket operations
+func (m *Model) cliApp() *cli.App {
+	return &cli.App{
+		Config:       m.config,
+		Git:          m.git,
+		Manager:      m.manager,
+		ProjectRoot:  m.projectRoot,
+		RepoRoot:     m.repoRoot,
+		Output:       cli.NewOutputWriter(io.Discard, io.Discard, cli.FormatText),
+		StatusWriter: cli.NewNullStatusWriter(),
+	}
+}
+
 // checkWorkspaceForStart checks if the workspace is ready to start a ticket
 func (m *Model) checkWorkspaceForStart() error {
 	// Check for uncommitted changes (only if not using worktrees)
@@ -672,17 +742,17 @@ func (m *Model) checkWorkspaceForStart() error {
 }
 
 // setupTicketBranchOrWorktree creates a branch or worktree for the ticket
-func (m *Model) setupTicketBranchOrWorktree(t *ticket.Ticket) (string, error) {
+func (m *Model) setupTicketBranchOrWorktree(t *ticket.Ticket, startPoint string) (string, error) {
 	logger := log.Global().WithTicket(t.ID)
 	var worktreePath string
 
 	if m.config.Worktree.Enabled {
 		// Check if worktree already exists
-		if exists, err := m.git.HasWorktree(context.Background(), t.ID); err != nil {
+		if exists, err := m.git.HasWorktree(context.Background(), t.BranchName()); err != nil {
 			logger.WithError(err).Error("failed to check worktree")
 			return "", fmt.Errorf("failed to check worktree: %w", err)
 		} else if exists {
-			worktreePath := worktree.GetPath(context.Background(), m.git, m.config, m.repoRoot, t.ID)
+			worktreePath := worktree.GetPath(context.Background(), m.git, m.config, m.repoRoot, t.ID, t.BranchName())
 			logger.Debug("worktree already exists", "path", worktreePath)
 			return "", fmt.Errorf("worktree for ticket %s already exists at: %s", t.ID, worktreePath)
 		}
@@ -691,7 +761,7 @@ func (m *Model) setupTicketBranchOrWorktree(t *ticket.Ticket) (string, error) {
 		baseDir := m.config.GetWorktreePath(m.repoRoot)
 		worktreePath = filepath.Join(baseDir, t.ID)
 
-		if err := m.git.AddWorktree(context.Background(), worktreePath, t.ID); err != nil {
+		if err := m.git.AddWorktreeFrom(context.Background(), worktreePath, t.BranchName(), startPoint); err != nil {
 			logger.WithError(err).Error("failed to create worktree", "path", worktreePath)
 			return "", fmt.Errorf("failed to create worktree: %w", err)
 		}
@@ -704,7 +774,7 @@ func (m *Model) setupTicketBranchOrWorktree(t *ticket.Ticket) (string, error) {
 		}
 	} else {
 		// Original behavior: create and checkout branch
-		if err := m.git.CreateBranch(context.Background(), t.ID); err != nil {
+		if err := m.git.CreateBranchFrom(context.Background(), t.BranchName(), startPoint); err != nil {
 			return "", fmt.Errorf("failed to create branch: %w", err)
 		}
 	}
@@ -858,7 +928,7 @@ func (m *Model) checkWorkspaceForClose(t *ticket.Ticket) (string, bool, error) {
 
 	if m.config.Worktree.Enabled {
 		// Check if a worktree exists for this ticket
-		wt, err := m.git.FindWorktreeByBranch(context.Background(), t.ID)
+		wt, err := m.git.FindWorktreeByBranch(context.Background(), t.BranchName())
 		if err != nil {
 			return "", false, fmt.Errorf("failed to find worktree: %w", err)
 		}
@@ -905,8 +975,8 @@ func (m *Model) checkWorkspaceForClose(t *ticket.Ticket) (string, bool, error) {
 			}
 
 			// Ensure we're on the ticket branch
-			if currentBranch != t.ID {
-				return "", false, fmt.Errorf("not on ticket branch, expected %s but on %s", t.ID, currentBranch)
+			if currentBranch != t.BranchName() {
+				return "", false, fmt.Errorf("not on ticket branch, expected %s but on %s", t.BranchName(), currentBranch)
 			}
 		} else {
 			// For non-current tickets in non-worktree mode, we can still close them
@@ -38,20 +38,22 @@ func NewHelpModel() HelpModel {
 				{Key: "n", Desc: "New ticket"},
 				{Key: "s", Desc: "Start ticket"},
 				{Key: "c", Desc: "Close ticket (with optional reason)"},
+				{Key: "r", Desc: "Reopen closed ticket (detail view)"},
 				{Key: "w", Desc: "Worktree view"},
 			},
 			// View controls
 			{
 				{Key: "tab", Desc: "Next tab"},
 				{Key: "shift+tab", Desc: "Previous tab"},
-				{Key: "1/2/3", Desc: "Jump to TODO/DOING/DONE"},
+				{Key: "tab", Desc: "Content/History (detail view)"},
+				{Key: "1-9", Desc: "Jump to state or saved view tab"},
 				{Key: "a", Desc: "Show all tickets"},
 				{Key: "esc", Desc: "Back/Cancel"},
 				{Key: "r", Desc: "Refresh"},
 			},
 			// General
 			{
-				{Key: "/", Desc: "Search"},
+				{Key: "/", Desc: "Search (text or filter, e.g. tag:api priority<=1)"},
 				{Key: "?", Desc: "Toggle help"},
 				{Key: "q", Desc: "Quit"},
 			},
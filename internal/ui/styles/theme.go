@@ -1,6 +1,8 @@
 package styles
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -57,6 +59,10 @@ var (
 			Foreground(successColor).
 			Bold(true)
 
+	CancelledStyle = lipgloss.NewStyle().
+			Foreground(errorColor).
+			Strikethrough(true)
+
 	// Priority styles
 	Priority1Style = lipgloss.NewStyle().
 			Foreground(errorColor).
@@ -147,6 +153,8 @@ func GetStatusStyle(status string) lipgloss.Style {
 		return DoingStyle
 	case "done":
 		return DoneStyle
+	case "cancelled":
+		return CancelledStyle
 	default:
 		return BaseStyle
 	}
@@ -163,3 +171,16 @@ func GetPriorityStyle(priority int) lipgloss.Style {
 		return Priority3Style
 	}
 }
+
+// RenderProgressBar renders a bar of the given width filled in proportion to done/total
+func RenderProgressBar(done, total, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	filled := 0
+	if total > 0 {
+		filled = width * done / total
+	}
+	return lipgloss.NewStyle().Foreground(successColor).Render(strings.Repeat("█", filled)) +
+		MutedStyle.Render(strings.Repeat("░", width-filled))
+}
@@ -3,11 +3,14 @@ package views
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 	"github.com/yshrsmz/ticketflow/internal/ui/styles"
 )
@@ -16,6 +19,8 @@ const (
 	// UI layout constants for content height calculation
 	baseMetadataLines = 7  // Status, priority, created, description label, borders
 	baseUIChrome      = 15 // Title, borders, padding, help text, spacing
+
+	detailProgressBarWidth = 20 // Width of the checklist progress bar
 )
 
 // DetailAction represents an action from the detail view
@@ -26,25 +31,43 @@ const (
 	DetailActionClose
 	DetailActionEdit
 	DetailActionStart
+	DetailActionReopen
+)
+
+// detailTab identifies the panel shown below the metadata section
+type detailTab int
+
+const (
+	detailTabContent detailTab = iota
+	detailTabHistory
 )
 
 // TicketDetailModel represents the ticket detail view
 type TicketDetailModel struct {
 	manager    ticket.TicketManager
+	git        git.GitClient
+	config     *config.Config
 	ticket     *ticket.Ticket
 	content    string
-	scrollY    int
-	width      int
-	height     int
-	shouldBack bool
-	action     DetailAction
-	err        error
+	tab        detailTab
+	history    []git.CommitInfo
+	historyErr error
+	// historyLoaded is set once the history of the current ticket has been fetched
+	historyLoaded bool
+	scrollY       int
+	width         int
+	height        int
+	shouldBack    bool
+	action        DetailAction
+	err           error
 }
 
 // NewTicketDetailModel creates a new ticket detail model
-func NewTicketDetailModel(manager ticket.TicketManager) TicketDetailModel {
+func NewTicketDetailModel(manager ticket.TicketManager, gitClient git.GitClient, cfg *config.Config) TicketDetailModel {
 	return TicketDetailModel{
 		manager: manager,
+		git:     gitClient,
+		config:  cfg,
 	}
 }
 
@@ -67,6 +90,17 @@ func (m TicketDetailModel) Update(msg tea.Msg) (TicketDetailModel, tea.Cmd) {
 		case "q", "esc":
 			m.shouldBack = true
 
+		case "tab":
+			m.scrollY = 0
+			if m.tab == detailTabContent {
+				m.tab = detailTabHistory
+				if !m.historyLoaded && m.ticket != nil {
+					return m, m.loadHistory()
+				}
+			} else {
+				m.tab = detailTabContent
+			}
+
 		case "c":
 			if m.ticket != nil && (m.ticket.Status() == ticket.StatusDoing || m.ticket.Status() == ticket.StatusTodo) {
 				m.action = DetailActionClose
@@ -82,6 +116,11 @@ func (m TicketDetailModel) Update(msg tea.Msg) (TicketDetailModel, tea.Cmd) {
 				m.action = DetailActionStart
 			}
 
+		case "r":
+			if m.ticket != nil && m.ticket.IsClosed() {
+				m.action = DetailActionReopen
+			}
+
 		case "up", "k":
 			if m.scrollY > 0 {
 				m.scrollY--
@@ -117,6 +156,14 @@ func (m TicketDetailModel) Update(msg tea.Msg) (TicketDetailModel, tea.Cmd) {
 		m.content = msg.content
 		m.err = msg.err
 
+	case historyLoadedMsg:
+		// Ignore results for a ticket that is no longer displayed
+		if m.ticket != nil && msg.ticketID == m.ticket.ID {
+			m.history = msg.commits
+			m.historyErr = msg.err
+			m.historyLoaded = true
+		}
+
 	case error:
 		m.err = msg
 	}
@@ -143,14 +190,14 @@ func (m TicketDetailModel) View() string {
 	// Metadata section
 	metaStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(styles.GetStatusStyle(string(m.ticket.Status())).GetForeground()).
+		BorderForeground(styles.GetStatusStyle(string(m.ticket.State())).GetForeground()).
 		Padding(1, 2).
 		Width(m.width - 4)
 
 	var meta strings.Builder
 	meta.WriteString(fmt.Sprintf("%s %s\n",
 		styles.SubtitleStyle.Render("Status:"),
-		styles.GetStatusStyle(string(m.ticket.Status())).Render(string(m.ticket.Status()))))
+		styles.GetStatusStyle(string(m.ticket.State())).Render(string(m.ticket.State()))))
 
 	meta.WriteString(fmt.Sprintf("%s %s\n",
 		styles.SubtitleStyle.Render("Priority:"),
@@ -178,10 +225,23 @@ func (m TicketDetailModel) View() string {
 			styles.WarningStyle.Render(m.ticket.ClosureReason)))
 	}
 
-	if len(m.ticket.Related) > 0 {
+	for _, line := range relationLines(m.ticket) {
 		meta.WriteString(fmt.Sprintf("%s %s\n",
-			styles.SubtitleStyle.Render("Related:"),
-			styles.InfoStyle.Render(strings.Join(m.ticket.Related, ", "))))
+			styles.SubtitleStyle.Render(line.label),
+			styles.InfoStyle.Render(line.value)))
+	}
+
+	if len(m.ticket.Tags) > 0 {
+		meta.WriteString(fmt.Sprintf("%s %s\n",
+			styles.SubtitleStyle.Render("Tags:"),
+			styles.InfoStyle.Render(strings.Join(m.ticket.Tags, ", "))))
+	}
+
+	if progress := m.ticket.TaskProgress(); progress.Total > 0 {
+		meta.WriteString(fmt.Sprintf("%s %s %s\n",
+			styles.SubtitleStyle.Render("Tasks:"),
+			styles.RenderProgressBar(progress.Done, progress.Total, detailProgressBarWidth),
+			styles.InfoStyle.Render(fmt.Sprintf("%d/%d (%d%%)", progress.Done, progress.Total, progress.Percent()))))
 	}
 
 	meta.WriteString(fmt.Sprintf("\n%s\n%s",
@@ -191,15 +251,14 @@ func (m TicketDetailModel) View() string {
 	s.WriteString(metaStyle.Render(meta.String()))
 	s.WriteString("\n\n")
 
-	// Content section
-	if m.content != "" {
-		contentHeight := m.getContentHeight()
+	// Content and history tabs
+	s.WriteString(m.renderTabs())
+	s.WriteString("\n")
 
-		s.WriteString(styles.SubtitleStyle.Render("Content:"))
-		s.WriteString("\n")
+	if lines := m.panelLines(); len(lines) > 0 {
+		contentHeight := m.getContentHeight()
 
 		// Apply scrolling
-		lines := strings.Split(m.content, "\n")
 		visibleLines := lines
 
 		if len(lines) > contentHeight {
@@ -239,10 +298,17 @@ func (m TicketDetailModel) View() string {
 			helpItems = append(helpItems, "s: start", "c: close")
 		} else if m.ticket.Status() == ticket.StatusDoing {
 			helpItems = append(helpItems, "c: close")
+		} else if m.ticket.IsClosed() {
+			helpItems = append(helpItems, "r: reopen")
 		}
 		helpItems = append(helpItems, "e: edit")
 	}
-	if m.content != "" && strings.Count(m.content, "\n")+1 > m.getContentHeight() {
+	if m.tab == detailTabContent {
+		helpItems = append(helpItems, "tab: history")
+	} else {
+		helpItems = append(helpItems, "tab: content")
+	}
+	if len(m.panelLines()) > m.getContentHeight() {
 		helpItems = append(helpItems, "↑/↓/j/k: scroll", "g/G: top/bottom")
 	}
 	s.WriteString(styles.HelpStyle.Render(strings.Join(helpItems, " • ")))
@@ -260,6 +326,10 @@ func (m *TicketDetailModel) SetSize(width, height int) {
 func (m *TicketDetailModel) SetTicket(t *ticket.Ticket) {
 	m.ticket = t
 	m.content = ""
+	m.tab = detailTabContent
+	m.history = nil
+	m.historyErr = nil
+	m.historyLoaded = false
 	m.scrollY = 0
 	m.err = nil
 }
@@ -281,7 +351,7 @@ func (m TicketDetailModel) SelectedTicket() *ticket.Ticket {
 
 // getMaxScroll calculates the maximum scroll position
 func (m TicketDetailModel) getMaxScroll() int {
-	lines := strings.Count(m.content, "\n") + 1
+	lines := len(m.panelLines())
 	contentHeight := m.getContentHeight()
 	maxScroll := lines - contentHeight
 	if maxScroll < 0 {
@@ -301,7 +371,11 @@ func (m TicketDetailModel) getContentHeight() int {
 		if m.ticket.ClosedAt.Time != nil {
 			metaLines++
 		}
-		if len(m.ticket.Related) > 0 {
+		metaLines += len(relationLines(m.ticket))
+		if len(m.ticket.Tags) > 0 {
+			metaLines++
+		}
+		if m.ticket.TaskProgress().Total > 0 {
 			metaLines++
 		}
 		// Add lines for description wrapping
@@ -321,6 +395,37 @@ func (m TicketDetailModel) getContentHeight() int {
 	return contentHeight
 }
 
+// metadataLine is a label/value pair in the metadata section
+type metadataLine struct {
+	label string
+	value string
+}
+
+// relationLines returns one metadata line per relation type, followed by
+// any related entries that are not typed relations
+func relationLines(t *ticket.Ticket) []metadataLine {
+	var lines []metadataLine
+	for _, relType := range ticket.RelationTypes() {
+		if ids := t.RelationsOfType(relType); len(ids) > 0 {
+			label := strings.ReplaceAll(string(relType), "_", " ")
+			label = strings.ToUpper(label[:1]) + label[1:] + ":"
+			lines = append(lines, metadataLine{label: label, value: strings.Join(ids, ", ")})
+		}
+	}
+
+	var untyped []string
+	for _, entry := range t.Related {
+		if _, ok := ticket.ParseRelation(entry); !ok {
+			untyped = append(untyped, entry)
+		}
+	}
+	if len(untyped) > 0 {
+		lines = append(lines, metadataLine{label: "Related:", value: strings.Join(untyped, ", ")})
+	}
+
+	return lines
+}
+
 // contentLoadedMsg is sent when content is loaded
 type contentLoadedMsg struct {
 	content string
@@ -341,3 +446,101 @@ func (m TicketDetailModel) loadContent() tea.Cmd {
 		}
 	}
 }
+
+// renderTabs renders the tab bar, highlighting the active tab
+func (m TicketDetailModel) renderTabs() string {
+	tabs := []struct {
+		tab   detailTab
+		label string
+	}{
+		{detailTabContent, "Content"},
+		{detailTabHistory, "History"},
+	}
+
+	rendered := make([]string, len(tabs))
+	for i, t := range tabs {
+		if t.tab == m.tab {
+			rendered[i] = styles.SubtitleStyle.Render("[" + t.label + "]")
+		} else {
+			rendered[i] = styles.MutedStyle.Render(" " + t.label + " ")
+		}
+	}
+	return strings.Join(rendered, " ")
+}
+
+// panelLines returns the lines of the active tab
+func (m TicketDetailModel) panelLines() []string {
+	if m.tab == detailTabContent {
+		if m.content == "" {
+			return nil
+		}
+		return strings.Split(m.content, "\n")
+	}
+
+	switch {
+	case !m.historyLoaded:
+		return []string{styles.MutedStyle.Render("Loading history...")}
+	case m.historyErr != nil:
+		return []string{styles.ErrorStyle.Render(fmt.Sprintf("Failed to load history: %v", m.historyErr))}
+	case len(m.history) == 0:
+		return []string{styles.MutedStyle.Render("No commits found for this ticket")}
+	}
+
+	lines := make([]string, 0, len(m.history)+2)
+	for _, c := range m.history {
+		merged := styles.MutedStyle.Render("·")
+		if c.Merged {
+			merged = styles.SuccessStyle.Render("✓")
+		}
+		source := "file"
+		switch {
+		case c.TouchesFile && c.OnBranch:
+			source = "both"
+		case c.OnBranch:
+			source = "branch"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s %-6s %s %s",
+			merged,
+			styles.WarningStyle.Render(c.ShortSHA()),
+			styles.InfoStyle.Render(c.Date.Format("2006-01-02 15:04")),
+			source,
+			c.Subject,
+			styles.MutedStyle.Render("("+c.Author+")")))
+	}
+	lines = append(lines, "", styles.MutedStyle.Render(fmt.Sprintf("✓ = merged into %s", m.config.Git.DefaultBranch)))
+	return lines
+}
+
+// historyLoadedMsg is sent when the git history of a ticket is loaded
+type historyLoadedMsg struct {
+	ticketID string
+	commits  []git.CommitInfo
+	err      error
+}
+
+// loadHistory loads the git history of the ticket file and branch
+func (m TicketDetailModel) loadHistory() tea.Cmd {
+	t := m.ticket
+	return func() tea.Msg {
+		root, err := m.git.RootPath()
+		if err != nil {
+			return historyLoadedMsg{ticketID: t.ID, err: err}
+		}
+		relPath, err := filepath.Rel(root, t.Path)
+		if err != nil {
+			return historyLoadedMsg{ticketID: t.ID, err: err}
+		}
+
+		opts := git.HistoryOptions{
+			Path:       filepath.ToSlash(relPath),
+			Branch:     t.ID,
+			BaseBranch: m.config.Git.DefaultBranch,
+		}
+		if t.StartedAt.Time != nil {
+			opts.Since = *t.StartedAt.Time
+		}
+
+		commits, err := git.History(context.Background(), m.git, opts)
+		return historyLoadedMsg{ticketID: t.ID, commits: commits, err: err}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yshrsmz/ticketflow/internal/config"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 	"github.com/yshrsmz/ticketflow/internal/ui/components"
 	"github.com/yshrsmz/ticketflow/internal/ui/styles"
@@ -16,6 +17,9 @@ const (
 	idColumnWidthPercentage = 0.30
 	minIDColumnWidth        = 20
 	maxIDColumnWidth        = 40
+	tagsColumnWidth         = 16
+	tasksBarWidth           = 6
+	tasksColumnWidth        = tasksBarWidth + 6 // bar, space and "dd/dd"
 )
 
 // Action represents an action to take from the list view
@@ -39,17 +43,36 @@ type TicketListModel struct {
 	err             error
 	action          Action
 	statusFilter    ticket.StatusFilter
-	activeTab       int // 0=ALL, 1=TODO, 2=DOING, 3=DONE
+	states          []string    // Workflow states, one tab each after ALL
+	views           []savedView // Saved views, one tab each after the states
+	activeTab       int         // 0=ALL, then one tab per workflow state, then one per saved view
 	searchMode      bool
 	searchQuery     string
+	searchErr       error // Set when searchQuery is not a valid filter expression
 	width           int
 	height          int
 }
 
+// savedView is a saved view shown as a tab.
+// Views that fail to compile keep their tab and show the error when selected.
+type savedView struct {
+	name string
+	view *ticket.View
+	err  error
+}
+
 // NewTicketListModel creates a new ticket list model
-func NewTicketListModel(manager ticket.TicketManager) TicketListModel {
+func NewTicketListModel(manager ticket.TicketManager, cfg *config.Config) TicketListModel {
+	views := make([]savedView, len(cfg.Views))
+	for i, vc := range cfg.Views {
+		view, err := ticket.NewView(vc)
+		views[i] = savedView{name: vc.Name, view: view, err: err}
+	}
+
 	return TicketListModel{
 		manager:         manager,
+		states:          cfg.GetStateNames(),
+		views:           views,
 		selected:        make(map[string]bool),
 		action:          ActionNone,
 		activeTab:       0, // Start with ALL tab
@@ -137,52 +160,25 @@ func (m TicketListModel) Update(msg tea.Msg) (TicketListModel, tea.Cmd) {
 
 		case "tab", "shift+tab":
 			// Navigate tabs
+			tabCount := m.tabCount()
 			if msg.String() == "tab" {
-				m.activeTab = (m.activeTab + 1) % 4
+				m.setTab((m.activeTab + 1) % tabCount)
 			} else {
-				m.activeTab = (m.activeTab - 1 + 4) % 4
-			}
-			// Update status filter based on tab
-			switch m.activeTab {
-			case 0:
-				m.statusFilter = ticket.StatusFilterActive
-			case 1:
-				m.statusFilter = ticket.StatusFilterTodo
-			case 2:
-				m.statusFilter = ticket.StatusFilterDoing
-			case 3:
-				m.statusFilter = ticket.StatusFilterDone
+				m.setTab((m.activeTab - 1 + tabCount) % tabCount)
 			}
-			m.cursor = 0
 			return m, m.loadTickets()
 
-		case "1", "2", "3":
-			// Jump to tab by number (1=TODO, 2=DOING, 3=DONE)
-			tabMap := map[string]int{
-				"1": 1,
-				"2": 2,
-				"3": 3,
-			}
-			if tab, ok := tabMap[msg.String()]; ok {
-				m.activeTab = tab
-				// Update status filter
-				switch m.activeTab {
-				case 1:
-					m.statusFilter = ticket.StatusFilterTodo
-				case 2:
-					m.statusFilter = ticket.StatusFilterDoing
-				case 3:
-					m.statusFilter = ticket.StatusFilterDone
-				}
-				m.cursor = 0
+		case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			// Jump to a workflow state or saved view tab by number (1=TODO, 2=DOING, ...)
+			tab := int(msg.String()[0] - '0')
+			if tab < m.tabCount() {
+				m.setTab(tab)
 				return m, m.loadTickets()
 			}
 
 		case "a":
 			// Show all tickets
-			m.activeTab = 0
-			m.statusFilter = ticket.StatusFilterActive
-			m.cursor = 0
+			m.setTab(0)
 			return m, m.loadTickets()
 		}
 
@@ -213,7 +209,14 @@ func (m TicketListModel) View() string {
 	var s strings.Builder
 
 	// Tabs
-	tabs := []string{"ALL", "TODO", "DOING", "DONE"}
+	tabs := make([]string, 0, m.tabCount())
+	tabs = append(tabs, "ALL")
+	for _, state := range m.states {
+		tabs = append(tabs, strings.ToUpper(state))
+	}
+	for _, v := range m.views {
+		tabs = append(tabs, "★ "+v.name)
+	}
 	var tabBar strings.Builder
 	for i, tab := range tabs {
 		style := styles.ButtonStyle
@@ -236,6 +239,10 @@ func (m TicketListModel) View() string {
 		}
 		s.WriteString(styles.InputStyle.Render(searchBar))
 		s.WriteString("\n")
+		if m.searchErr != nil {
+			s.WriteString(styles.WarningStyle.Render(fmt.Sprintf("⚠ %v", m.searchErr)))
+			s.WriteString("\n")
+		}
 	}
 
 	s.WriteString("\n")
@@ -249,16 +256,44 @@ func (m TicketListModel) View() string {
 	if idWidth > maxIDColumnWidth {
 		idWidth = maxIDColumnWidth // Maximum width
 	}
-	statusWidth := 7
+	statusWidth := 9 // fits "cancelled"
 	priorityWidth := 3
+	// Only reserve space for tags when a visible ticket has them
+	tagsWidth := 0
+	for _, t := range m.filteredTickets {
+		if len(t.Tags) > 0 {
+			tagsWidth = tagsColumnWidth
+			break
+		}
+	}
+	// Only reserve space for checklist progress when a visible ticket has tasks
+	tasksWidth := 0
+	for _, t := range m.filteredTickets {
+		if t.TaskProgress().Total > 0 {
+			tasksWidth = tasksColumnWidth
+			break
+		}
+	}
 	descWidth := m.width - idWidth - statusWidth - priorityWidth - 8 // padding and borders
+	if tasksWidth > 0 {
+		descWidth -= tasksWidth + 1
+	}
+	if tagsWidth > 0 {
+		descWidth -= tagsWidth + 1
+	}
 
 	// Header
-	header := fmt.Sprintf("%-*s %-*s %-*s %s",
+	header := fmt.Sprintf("%-*s %-*s %-*s ",
 		idWidth, "ID",
 		statusWidth, "Status",
-		priorityWidth, "Pri",
-		"Description")
+		priorityWidth, "Pri")
+	if tasksWidth > 0 {
+		header += fmt.Sprintf("%-*s ", tasksWidth, "Tasks")
+	}
+	if tagsWidth > 0 {
+		header += fmt.Sprintf("%-*s ", tagsWidth, "Tags")
+	}
+	header += "Description"
 	s.WriteString(styles.SubtitleStyle.Render(header))
 	s.WriteString("\n")
 	separatorWidth := m.width - 4
@@ -273,6 +308,8 @@ func (m TicketListModel) View() string {
 		emptyMsg := "No tickets found."
 		if m.searchQuery != "" {
 			emptyMsg = fmt.Sprintf("No tickets matching '%s'", m.searchQuery)
+		} else if v := m.activeView(); v != nil {
+			emptyMsg = fmt.Sprintf("No tickets in view '%s'.", v.name)
 		} else if m.statusFilter != "" {
 			emptyMsg = fmt.Sprintf("No %s tickets found.", m.statusFilter)
 		}
@@ -289,6 +326,9 @@ func (m TicketListModel) View() string {
 		if m.searchMode || m.searchQuery != "" {
 			maxVisible -= 3 // Account for search bar
 		}
+		if m.searchErr != nil {
+			maxVisible-- // Account for search error
+		}
 		contentHeight := 3 // Empty message takes about 3 lines
 		remainingLines := maxVisible - contentHeight
 		if remainingLines > 0 {
@@ -302,6 +342,9 @@ func (m TicketListModel) View() string {
 		if m.searchMode || m.searchQuery != "" {
 			maxVisible -= 3 // Account for search bar
 		}
+		if m.searchErr != nil {
+			maxVisible-- // Account for search error
+		}
 
 		if len(m.filteredTickets) > maxVisible {
 			// Scroll to keep cursor visible
@@ -318,7 +361,7 @@ func (m TicketListModel) View() string {
 			t := m.filteredTickets[i]
 
 			// Format row
-			statusStyle := styles.GetStatusStyle(string(t.Status()))
+			statusStyle := styles.GetStatusStyle(string(t.State()))
 			priorityStyle := styles.GetPriorityStyle(t.Priority)
 
 			// Add abandoned indicator for closed tickets with reason
@@ -342,16 +385,23 @@ func (m TicketListModel) View() string {
 			} else {
 				id = truncate(t.ID, idWidth)
 			}
-			status := statusStyle.Render(fmt.Sprintf("%-*s", statusWidth, t.Status()))
+			status := statusStyle.Render(fmt.Sprintf("%-*s", statusWidth, truncate(string(t.State()), statusWidth)))
 			priority := priorityStyle.Render(fmt.Sprintf("%d", t.Priority))
 
 			desc := truncate(t.Description, descWidth)
 
-			row := fmt.Sprintf("%-*s %s %s %s",
+			row := fmt.Sprintf("%-*s %s %s ",
 				idWidth, id,
 				status,
-				priority,
-				desc)
+				priority)
+			if tasksWidth > 0 {
+				row += renderTaskProgress(t.TaskProgress()) + " "
+			}
+			if tagsWidth > 0 {
+				tags := truncate(strings.Join(t.Tags, ","), tagsWidth)
+				row += styles.InfoStyle.Render(fmt.Sprintf("%-*s", tagsWidth, tags)) + " "
+			}
+			row += desc
 
 			// Apply selection/cursor styling
 			if i == m.cursor {
@@ -381,6 +431,34 @@ func (m TicketListModel) View() string {
 	return s.String()
 }
 
+// tabCount returns the number of tabs: ALL, the workflow states and the saved views
+func (m TicketListModel) tabCount() int {
+	return 1 + len(m.states) + len(m.views)
+}
+
+// activeView returns the saved view of the active tab, or nil for other tabs
+func (m TicketListModel) activeView() *savedView {
+	i := m.activeTab - 1 - len(m.states)
+	if i < 0 || i >= len(m.views) {
+		return nil
+	}
+	return &m.views[i]
+}
+
+// setTab activates a tab and updates the status filter accordingly
+func (m *TicketListModel) setTab(tab int) {
+	m.activeTab = tab
+	switch {
+	case tab > 0 && tab <= len(m.states):
+		m.statusFilter = ticket.StatusFilter(m.states[tab-1])
+	case m.activeView() != nil && m.activeView().view != nil:
+		m.statusFilter = m.activeView().view.Scope
+	default:
+		m.statusFilter = ticket.StatusFilterActive
+	}
+	m.cursor = 0
+}
+
 // SetSize sets the view size
 func (m *TicketListModel) SetSize(width, height int) {
 	m.width = width
@@ -416,8 +494,21 @@ type ticketsLoadedMsg struct {
 	err     error
 }
 
-// loadTickets loads tickets from the manager
+// loadTickets loads tickets from the manager, or the tickets of the active saved view
 func (m TicketListModel) loadTickets() tea.Cmd {
+	if v := m.activeView(); v != nil {
+		return func() tea.Msg {
+			if v.err != nil {
+				return ticketsLoadedMsg{err: v.err}
+			}
+			tickets, err := v.view.List(context.Background(), m.manager)
+			return ticketsLoadedMsg{
+				tickets: tickets,
+				err:     err,
+			}
+		}
+	}
+
 	return func() tea.Msg {
 		tickets, err := m.manager.List(context.Background(), m.statusFilter)
 		return ticketsLoadedMsg{
@@ -427,6 +518,16 @@ func (m TicketListModel) loadTickets() tea.Cmd {
 	}
 }
 
+// renderTaskProgress renders a fixed-width checklist progress cell for the list
+func renderTaskProgress(p ticket.TaskProgress) string {
+	if p.Total == 0 {
+		return strings.Repeat(" ", tasksColumnWidth)
+	}
+	counts := fmt.Sprintf("%d/%d", p.Done, p.Total)
+	return styles.RenderProgressBar(p.Done, p.Total, tasksBarWidth) + " " +
+		fmt.Sprintf("%-*s", tasksColumnWidth-tasksBarWidth-1, truncate(counts, tasksColumnWidth-tasksBarWidth-1))
+}
+
 // truncate truncates a string to a maximum width
 func truncate(s string, maxWidth int) string {
 	if len(s) <= maxWidth {
@@ -438,25 +539,16 @@ func truncate(s string, maxWidth int) string {
 	return s[:maxWidth-3] + "..."
 }
 
-// applyFilter applies the search query filter to tickets
+// applyFilter applies the search query filter to tickets.
+// The query uses the same expression language as 'list --filter'; free text is
+// matched against ID, description, tags, and content. While the query is not a
+// valid expression, for example halfway through typing a term, all tickets are shown.
 func (m *TicketListModel) applyFilter() {
-	// Pre-allocate filteredTickets with capacity of original tickets
-	// In worst case, all tickets match the filter
-	m.filteredTickets = make([]ticket.Ticket, 0, len(m.tickets))
-	query := strings.ToLower(m.searchQuery)
-
-	for _, t := range m.tickets {
-		// If no search query, include all tickets
-		if query == "" {
-			m.filteredTickets = append(m.filteredTickets, t)
-			continue
-		}
-
-		// Search in ID, description, and content
-		if strings.Contains(strings.ToLower(t.ID), query) ||
-			strings.Contains(strings.ToLower(t.Description), query) ||
-			strings.Contains(strings.ToLower(t.Content), query) {
-			m.filteredTickets = append(m.filteredTickets, t)
-		}
+	query, err := ticket.ParseQuery(m.searchQuery)
+	m.searchErr = err
+	if err != nil {
+		m.filteredTickets = append(make([]ticket.Ticket, 0, len(m.tickets)), m.tickets...)
+		return
 	}
+	m.filteredTickets = query.Filter(m.tickets)
 }
@@ -3,12 +3,16 @@ package views
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yshrsmz/ticketflow/internal/config"
+	"github.com/yshrsmz/ticketflow/internal/git"
 	"github.com/yshrsmz/ticketflow/internal/ticket"
 	"github.com/yshrsmz/ticketflow/internal/ui/styles"
 )
@@ -24,14 +28,33 @@ const (
 	NewTicketStateError
 )
 
+// Form fields in focus order
+const (
+	newTicketFieldSlug = iota
+	newTicketFieldTemplate
+	newTicketFieldPriority
+	newTicketFieldDescription
+	newTicketFieldContent
+
+	newTicketFieldCount
+)
+
 // NewTicketModel represents the new ticket creation view
 type NewTicketModel struct {
-	manager    ticket.TicketManager
-	state      NewTicketState
-	err        error
-	width      int
-	height     int
-	focusIndex int
+	manager     ticket.TicketManager
+	git         git.GitClient
+	config      *config.Config
+	projectRoot string
+	state       NewTicketState
+	err         error
+	width       int
+	height      int
+	focusIndex  int
+
+	// Template selection; templateIndex 0 means no template
+	templates     []ticket.Template
+	templateIndex int
+	templateErr   error
 
 	// Form inputs
 	slugInput     textinput.Model
@@ -41,7 +64,7 @@ type NewTicketModel struct {
 }
 
 // NewNewTicketModel creates a new ticket creation model
-func NewNewTicketModel(manager ticket.TicketManager) NewTicketModel {
+func NewNewTicketModel(manager ticket.TicketManager, gitClient git.GitClient, cfg *config.Config, projectRoot string) NewTicketModel {
 	// Slug input
 	slugInput := textinput.New()
 	slugInput.Placeholder = "feature-name"
@@ -74,6 +97,9 @@ func NewNewTicketModel(manager ticket.TicketManager) NewTicketModel {
 
 	return NewTicketModel{
 		manager:       manager,
+		git:           gitClient,
+		config:        cfg,
+		projectRoot:   projectRoot,
 		state:         NewTicketStateInput,
 		slugInput:     slugInput,
 		priorityInput: priorityInput,
@@ -90,7 +116,7 @@ func (m NewTicketModel) Init() tea.Cmd {
 
 // Update handles messages
 func (m NewTicketModel) Update(msg tea.Msg) (NewTicketModel, tea.Cmd) {
-	var cmds = make([]tea.Cmd, 4) // Initialize with size 4 for the 4 input fields
+	var cmds = make([]tea.Cmd, newTicketFieldCount) // One command slot per form field
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -105,15 +131,26 @@ func (m NewTicketModel) Update(msg tea.Msg) (NewTicketModel, tea.Cmd) {
 			if m.state == NewTicketStateInput {
 				// Cycle through inputs
 				if msg.String() == "tab" {
-					m.focusIndex = (m.focusIndex + 1) % 4
+					m.focusIndex = (m.focusIndex + 1) % newTicketFieldCount
 				} else {
-					m.focusIndex = (m.focusIndex - 1 + 4) % 4
+					m.focusIndex = (m.focusIndex - 1 + newTicketFieldCount) % newTicketFieldCount
 				}
 
 				// Update focus
 				m.updateFocus()
 			}
 
+		case "left", "right":
+			if m.state == NewTicketStateInput && m.focusIndex == newTicketFieldTemplate {
+				options := len(m.templates) + 1
+				if msg.String() == "right" {
+					m.selectTemplate((m.templateIndex + 1) % options)
+				} else {
+					m.selectTemplate((m.templateIndex - 1 + options) % options)
+				}
+				return m, nil
+			}
+
 		case "ctrl+s":
 			if m.state == NewTicketStateInput {
 				m.state = NewTicketStateCreating
@@ -139,14 +176,14 @@ func (m NewTicketModel) Update(msg tea.Msg) (NewTicketModel, tea.Cmd) {
 	// Update the focused input
 	if m.state == NewTicketStateInput {
 		switch m.focusIndex {
-		case 0:
-			m.slugInput, cmds[0] = m.slugInput.Update(msg)
-		case 1:
-			m.priorityInput, cmds[1] = m.priorityInput.Update(msg)
-		case 2:
-			m.descArea, cmds[2] = m.descArea.Update(msg)
-		case 3:
-			m.contentArea, cmds[3] = m.contentArea.Update(msg)
+		case newTicketFieldSlug:
+			m.slugInput, cmds[newTicketFieldSlug] = m.slugInput.Update(msg)
+		case newTicketFieldPriority:
+			m.priorityInput, cmds[newTicketFieldPriority] = m.priorityInput.Update(msg)
+		case newTicketFieldDescription:
+			m.descArea, cmds[newTicketFieldDescription] = m.descArea.Update(msg)
+		case newTicketFieldContent:
+			m.contentArea, cmds[newTicketFieldContent] = m.contentArea.Update(msg)
 		}
 	}
 
@@ -191,17 +228,32 @@ func (m NewTicketModel) View() string {
 	// Slug field
 	form.WriteString(styles.SubtitleStyle.Render("Slug:"))
 	form.WriteString("\n")
-	if m.focusIndex == 0 {
+	if m.focusIndex == newTicketFieldSlug {
 		form.WriteString(styles.FocusedInputStyle.Render(m.slugInput.View()))
 	} else {
 		form.WriteString(styles.InputStyle.Render(m.slugInput.View()))
 	}
 	form.WriteString("\n\n")
 
+	// Template field
+	form.WriteString(styles.SubtitleStyle.Render("Template:"))
+	form.WriteString("\n")
+	templateView := fmt.Sprintf("◀ %s ▶", m.templateLabel())
+	if m.focusIndex == newTicketFieldTemplate {
+		form.WriteString(styles.FocusedInputStyle.Render(templateView))
+	} else {
+		form.WriteString(styles.InputStyle.Render(templateView))
+	}
+	if m.templateErr != nil {
+		form.WriteString("\n")
+		form.WriteString(styles.ErrorStyle.Render(fmt.Sprintf("Failed to load templates: %v", m.templateErr)))
+	}
+	form.WriteString("\n\n")
+
 	// Priority field
 	form.WriteString(styles.SubtitleStyle.Render("Priority (1-3):"))
 	form.WriteString("\n")
-	if m.focusIndex == 1 {
+	if m.focusIndex == newTicketFieldPriority {
 		form.WriteString(styles.FocusedInputStyle.Render(m.priorityInput.View()))
 	} else {
 		form.WriteString(styles.InputStyle.Render(m.priorityInput.View()))
@@ -211,7 +263,7 @@ func (m NewTicketModel) View() string {
 	// Description field
 	form.WriteString(styles.SubtitleStyle.Render("Description:"))
 	form.WriteString("\n")
-	if m.focusIndex == 2 {
+	if m.focusIndex == newTicketFieldDescription {
 		descStyle := styles.FocusedInputStyle.UnsetBorderStyle()
 		form.WriteString(descStyle.Render(m.descArea.View()))
 	} else {
@@ -223,7 +275,7 @@ func (m NewTicketModel) View() string {
 	// Content field
 	form.WriteString(styles.SubtitleStyle.Render("Content:"))
 	form.WriteString("\n")
-	if m.focusIndex == 3 {
+	if m.focusIndex == newTicketFieldContent {
 		contentStyle := styles.FocusedInputStyle.UnsetBorderStyle()
 		form.WriteString(contentStyle.Render(m.contentArea.View()))
 	} else {
@@ -237,6 +289,7 @@ func (m NewTicketModel) View() string {
 	s.WriteString("\n\n")
 	help := []string{
 		fmt.Sprintf("%s navigate", styles.HelpKeyStyle.Render("tab")),
+		fmt.Sprintf("%s template", styles.HelpKeyStyle.Render("←/→")),
 		fmt.Sprintf("%s save", styles.HelpKeyStyle.Render("ctrl+s")),
 		fmt.Sprintf("%s cancel", styles.HelpKeyStyle.Render("esc")),
 		fmt.Sprintf("%s then %s for help", styles.HelpKeyStyle.Render("esc"), styles.HelpKeyStyle.Render("?")),
@@ -273,9 +326,46 @@ func (m *NewTicketModel) Reset() {
 	m.descArea.Reset()
 	m.contentArea.Reset()
 
+	// Reload templates so that new template files show up without restarting
+	m.templates, m.templateErr = ticket.LoadTemplates(m.config, m.projectRoot)
+	m.selectTemplate(0)
+	for i, t := range m.templates {
+		if t.Name == ticket.DefaultTemplateName {
+			m.selectTemplate(i + 1)
+		}
+	}
+
 	m.updateFocus()
 }
 
+// selectTemplate selects the template option at index (0 for none) and
+// applies the template's default priority
+func (m *NewTicketModel) selectTemplate(index int) {
+	m.templateIndex = index
+	priority := 3
+	if t := m.selectedTemplate(); t != nil && t.Priority != 0 {
+		priority = t.Priority
+	}
+	m.priorityInput.SetValue(strconv.Itoa(priority))
+}
+
+// selectedTemplate returns the selected template, or nil when none is selected
+func (m NewTicketModel) selectedTemplate() *ticket.Template {
+	if m.templateIndex < 1 || m.templateIndex > len(m.templates) {
+		return nil
+	}
+	return &m.templates[m.templateIndex-1]
+}
+
+// templateLabel describes the selected template option
+func (m NewTicketModel) templateLabel() string {
+	t := m.selectedTemplate()
+	if t == nil {
+		return "(none)"
+	}
+	return t.Name
+}
+
 // State returns the current state
 func (m NewTicketModel) State() NewTicketState {
 	return m.state
@@ -289,13 +379,13 @@ func (m *NewTicketModel) updateFocus() {
 	m.contentArea.Blur()
 
 	switch m.focusIndex {
-	case 0:
+	case newTicketFieldSlug:
 		m.slugInput.Focus()
-	case 1:
+	case newTicketFieldPriority:
 		m.priorityInput.Focus()
-	case 2:
+	case newTicketFieldDescription:
 		m.descArea.Focus()
-	case 3:
+	case newTicketFieldContent:
 		m.contentArea.Focus()
 	}
 }
@@ -328,8 +418,18 @@ func (m NewTicketModel) createTicket() tea.Cmd {
 			}
 		}
 
+		ctx := context.Background()
+		tmpl := m.selectedTemplate()
+
+		// Render the template before creating the ticket so that errors leave nothing behind
+		if tmpl != nil {
+			if _, err := tmpl.Render(m.templateData(ctx, ticket.GenerateID(slug), slug)); err != nil {
+				return ticketCreatedMsg{err: err}
+			}
+		}
+
 		// Create ticket
-		t, err := m.manager.Create(context.Background(), slug)
+		t, err := m.manager.Create(ctx, slug)
 		if err != nil {
 			return ticketCreatedMsg{err: err}
 		}
@@ -338,6 +438,18 @@ func (m NewTicketModel) createTicket() tea.Cmd {
 		t.Priority = priority
 		t.Description = strings.TrimSpace(m.descArea.Value())
 
+		// Fields left empty fall back to the rendered template
+		if tmpl != nil {
+			rendered, err := tmpl.Render(m.templateData(ctx, t.ID, slug))
+			if err != nil {
+				return ticketCreatedMsg{err: err}
+			}
+			t.Content = rendered.Content
+			if t.Description == "" {
+				t.Description = rendered.Description
+			}
+		}
+
 		// Save ticket
 		err = m.manager.Update(context.Background(), t)
 		if err != nil {
@@ -356,6 +468,19 @@ func (m NewTicketModel) createTicket() tea.Cmd {
 	}
 }
 
+// templateData collects the variables available to a ticket template
+func (m NewTicketModel) templateData(ctx context.Context, id, slug string) ticket.TemplateData {
+	// A missing user.name is not an error; templates just see an empty author
+	author, _ := m.git.Exec(ctx, "config", "user.name")
+
+	return ticket.TemplateData{
+		Slug:   slug,
+		ID:     id,
+		Date:   time.Now().Format(time.DateOnly),
+		Author: author,
+	}
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
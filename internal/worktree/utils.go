@@ -9,7 +9,8 @@ import (
 	"github.com/yshrsmz/ticketflow/internal/log"
 )
 
-// GetPath attempts to get the actual worktree path for a ticket, or falls back to calculating it.
+// GetPath attempts to get the actual worktree path for a ticket's branch, or falls back to
+// calculating it from the ticket ID.
 // The repoRoot should point to the primary repository root (not a linked worktree) so calculated
 // paths are anchored correctly even when called from within a worktree.
 //
@@ -18,14 +19,14 @@ import (
 // - The git worktree state is inconsistent
 // - The worktree reference exists but cannot be queried
 // - During error handling when we know a worktree exists but can't get its details
-func GetPath(ctx context.Context, gitClient git.GitClient, cfg *config.Config, repoRoot, ticketID string) string {
+func GetPath(ctx context.Context, gitClient git.GitClient, cfg *config.Config, repoRoot, ticketID, branch string) string {
 	logger := log.Global().WithTicket(ticketID)
 
 	// Try to get the actual worktree path from git
-	wt, err := gitClient.FindWorktreeByBranch(ctx, ticketID)
+	wt, err := gitClient.FindWorktreeByBranch(ctx, branch)
 	if err != nil {
 		// Log the error for debugging purposes
-		logger.Debug("failed to find worktree by branch", "error", err, "ticketID", ticketID)
+		logger.Debug("failed to find worktree by branch", "error", err, "branch", branch)
 	} else if wt != nil {
 		logger.Debug("found worktree path from git", "path", wt.Path)
 		return wt.Path
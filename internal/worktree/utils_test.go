@@ -18,6 +18,7 @@ func TestGetPath(t *testing.T) {
 
 	tests := []struct {
 		name         string
+		branch       string // defaults to ticketID
 		setupMock    func(*mocks.MockGitClient)
 		cfg          *config.Config
 		expectedPath string
@@ -62,6 +63,19 @@ func TestGetPath(t *testing.T) {
 			},
 			expectedPath: filepath.Join(projectRoot, "../custom-worktrees", ticketID),
 		},
+		{
+			name:   "looks up worktree by a custom branch name",
+			branch: "feature/test-ticket",
+			setupMock: func(m *mocks.MockGitClient) {
+				m.On("FindWorktreeByBranch", ctx, "feature/test-ticket").Return(nil, nil)
+			},
+			cfg: &config.Config{
+				Worktree: config.WorktreeConfig{
+					BaseDir: ".worktrees",
+				},
+			},
+			expectedPath: filepath.Join(projectRoot, ".worktrees", ticketID),
+		},
 	}
 
 	for _, tt := range tests {
@@ -69,7 +83,12 @@ func TestGetPath(t *testing.T) {
 			mockGit := new(mocks.MockGitClient)
 			tt.setupMock(mockGit)
 
-			path := GetPath(ctx, mockGit, tt.cfg, projectRoot, ticketID)
+			branch := tt.branch
+			if branch == "" {
+				branch = ticketID
+			}
+
+			path := GetPath(ctx, mockGit, tt.cfg, projectRoot, ticketID, branch)
 			assert.Equal(t, tt.expectedPath, path)
 
 			mockGit.AssertExpectations(t)